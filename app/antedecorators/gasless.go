@@ -122,7 +122,13 @@ func IsTxGasless(tx sdk.Tx, ctx sdk.Context, oracleKeeper oraclekeeper.Keeper, e
 				return false, err
 			}
 		case *evmtypes.MsgAssociate:
-			if !evmAssociateIsGasless(m, ctx, evmKeeper) {
+			if !evmAssociateIsGasless(m.Sender, ctx, evmKeeper) {
+				return false, nil
+			}
+			// ddos prevention
+			return len(tx.GetMsgs()) == 1, nil
+		case *evmtypes.MsgAssociatePubkey:
+			if !evmAssociateIsGasless(m.Sender, ctx, evmKeeper) {
 				return false, nil
 			}
 			// ddos prevention
@@ -162,9 +168,9 @@ func oracleVoteIsGasless(msg *oracletypes.MsgAggregateExchangeRateVote, ctx sdk.
 	return true, nil
 }
 
-func evmAssociateIsGasless(msg *evmtypes.MsgAssociate, ctx sdk.Context, keeper *evmkeeper.Keeper) bool {
+func evmAssociateIsGasless(sender string, ctx sdk.Context, keeper *evmkeeper.Keeper) bool {
 	// not gasless if already associated
-	seiAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	seiAddr := sdk.MustAccAddressFromBech32(sender)
 	_, associated := keeper.GetEVMAddress(ctx, seiAddr)
 	return !associated
 }
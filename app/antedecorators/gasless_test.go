@@ -8,7 +8,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/sei-protocol/sei-chain/app/antedecorators"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 	oraclekeeper "github.com/sei-protocol/sei-chain/x/oracle/keeper"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 	"github.com/stretchr/testify/require"
@@ -157,3 +159,21 @@ func TestNonGaslessMsg(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, gasless)
 }
+
+func TestAssociatePubkeyGasless(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	msg := evmtypes.NewMsgAssociatePubkey(seiAddr, nil)
+	isGasless, err := antedecorators.IsTxGasless(FakeTx{FakeMsgs: []sdk.Msg{msg}}, ctx, oraclekeeper.Keeper{}, k)
+	require.NoError(t, err)
+	require.True(t, isGasless)
+	// ddos prevention
+	isGasless, err = antedecorators.IsTxGasless(FakeTx{FakeMsgs: []sdk.Msg{msg, msg}}, ctx, oraclekeeper.Keeper{}, k)
+	require.NoError(t, err)
+	require.False(t, isGasless)
+
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	isGasless, err = antedecorators.IsTxGasless(FakeTx{FakeMsgs: []sdk.Msg{msg}}, ctx, oraclekeeper.Keeper{}, k)
+	require.NoError(t, err)
+	require.False(t, isGasless)
+}
@@ -126,6 +126,7 @@ import (
 	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/querier"
 	"github.com/sei-protocol/sei-chain/x/evm/replay"
+	"github.com/sei-protocol/sei-chain/x/evm/retention"
 	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/sei-protocol/sei-chain/x/mint"
 	mintclient "github.com/sei-protocol/sei-chain/x/mint/client/cli"
@@ -551,6 +552,8 @@ func New(
 		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
 		app.DistrKeeper,
 	)
+	// the EVM keeper is constructed further down, but only used at runtime
+	app.TokenFactoryKeeper.SetEVMKeeper(&app.EvmKeeper)
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -608,9 +611,13 @@ func New(
 	}
 	app.EvmKeeper = *evmkeeper.NewKeeper(keys[evmtypes.StoreKey],
 		tkeys[evmtypes.TransientStoreKey], app.GetSubspace(evmtypes.ModuleName), app.receiptStore, app.BankKeeper,
-		&app.AccountKeeper, &app.StakingKeeper, app.TransferKeeper,
+		&app.AccountKeeper, &app.StakingKeeper, &app.DistrKeeper, app.TransferKeeper,
 		wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper), &app.WasmKeeper)
 	app.BankKeeper.RegisterRecipientChecker(app.EvmKeeper.CanAddressReceive)
+	// modules that react to new address associations or to pointer registry
+	// changes register their hooks here, with
+	// app.EvmKeeper.SetHooks(evmtypes.NewMultiAssociationHooks(...)) and
+	// app.EvmKeeper.SetPointerHooks(evmtypes.NewMultiPointerHooks(...))
 
 	bApp.SetPreCommitHandler(app.HandlePreCommit)
 	bApp.SetCloseHandler(app.HandleClose)
@@ -624,6 +631,8 @@ func New(
 		panic(fmt.Sprintf("error reading evm query config due to %s", err))
 	}
 	app.EvmKeeper.QueryConfig = &evmQueryConfig
+	app.EvmKeeper.HistoricalMultiStore = app.CommitMultiStore()
+	app.EvmKeeper.ParamsKeeper = &app.ParamsKeeper
 	ethReplayConfig, err := replay.ReadConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error reading eth replay config due to %s", err))
@@ -634,6 +643,11 @@ func New(
 		panic(fmt.Sprintf("error reading eth block test config due to %s", err))
 	}
 	app.EvmKeeper.EthBlockTestConfig = ethBlockTestConfig
+	receiptRetentionConfig, err := retention.ReadConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error reading receipt retention config due to %s", err))
+	}
+	app.EvmKeeper.ReceiptRetentionConfig = receiptRetentionConfig
 	if ethReplayConfig.Enabled {
 		rpcclient, err := ethrpc.Dial(ethReplayConfig.EthRPC)
 		if err != nil {
@@ -691,7 +705,7 @@ func New(
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, evm.NewIBCMiddleware(transferIBCModule, &app.EvmKeeper, app.TransferKeeper))
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
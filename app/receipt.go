@@ -10,8 +10,11 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw1155"
 	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
@@ -30,6 +33,11 @@ var ERC1155TransferSingleTopic = common.HexToHash("0xc3d58168c5ae7397731d063d5bb
 var ERC1155TransferBatchTopic = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
 var ERC1155ApprovalForAllTopic = common.HexToHash("0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
 var ERC1155URITopic = common.HexToHash("0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b")
+
+// PointerRegisteredTopic is the topic of the synthetic log emitted from the
+// address of a newly registered ERC pointer, with the pointer type, pointee
+// and version as data.
+var PointerRegisteredTopic = crypto.Keccak256Hash([]byte("PointerRegistered(uint8,string,uint16)"))
 var EmptyHash = common.HexToHash("0x0")
 var TrueHash = common.HexToHash("0x1")
 
@@ -45,7 +53,8 @@ func getOwnerEventKey(contractAddr string, tokenID string) string {
 func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.Tx, checksum [32]byte, response sdk.DeliverTxHookInput) {
 	// hooks will only be called if DeliverTx is successful
 	wasmEvents := GetEventsOfType(response, wasmtypes.WasmModuleEventType)
-	if len(wasmEvents) == 0 {
+	pointerEvents := GetEventsOfType(response, proto.MessageName(&evmtypes.EventPointerRegistered{}))
+	if len(wasmEvents) == 0 && len(pointerEvents) == 0 {
 		return
 	}
 	logs := []*ethtypes.Log{}
@@ -105,6 +114,10 @@ func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.
 			continue
 		}
 	}
+	for _, log := range translatePointerRegisteredEvents(ctx, pointerEvents) {
+		log.Index = uint(len(logs))
+		logs = append(logs, log)
+	}
 	if len(logs) == 0 {
 		return
 	}
@@ -147,6 +160,42 @@ func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.
 	}
 }
 
+var pointerRegisteredLogArgs = func() abi.Arguments {
+	uint8Type, _ := abi.NewType("uint8", "", nil)
+	stringType, _ := abi.NewType("string", "", nil)
+	uint16Type, _ := abi.NewType("uint16", "", nil)
+	return abi.Arguments{{Type: uint8Type}, {Type: stringType}, {Type: uint16Type}}
+}()
+
+// translatePointerRegisteredEvents returns a synthetic log for each newly
+// registered ERC pointer, emitted from the pointer address so that EVM
+// indexers can discover pointers from logs alone. CW pointers have no EVM
+// address and are skipped.
+func translatePointerRegisteredEvents(ctx sdk.Context, events []abci.Event) (res []*ethtypes.Log) {
+	for _, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to parse pointer registration event: %s", err))
+			continue
+		}
+		registered, ok := msg.(*evmtypes.EventPointerRegistered)
+		if !ok || !common.IsHexAddress(registered.Pointer) {
+			continue
+		}
+		data, err := pointerRegisteredLogArgs.Pack(uint8(registered.PointerType), registered.Pointee, uint16(registered.NewVersion))
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to encode pointer registration log: %s", err))
+			continue
+		}
+		res = append(res, &ethtypes.Log{
+			Address: common.HexToAddress(registered.Pointer),
+			Topics:  []common.Hash{PointerRegisteredTopic},
+			Data:    data,
+		})
+	}
+	return
+}
+
 func (app *App) translateCW20Event(ctx sdk.Context, wasmEvent abci.Event, pointerAddr common.Address, contractAddr string) (res []*ethtypes.Log) {
 	defer func() {
 		if r := recover(); r != nil {
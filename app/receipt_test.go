@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sei-protocol/sei-chain/app"
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
 	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -939,6 +940,53 @@ func TestEvmEventsForCw1155(t *testing.T) {
 	require.Equal(t, expectedData, receipt.Logs[0].Data)
 }
 
+func TestEvmEventsForPointerRegistration(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).WithChainID("sei-test").WithBlockHeight(1)
+	privKey := testkeeper.MockPrivateKey()
+	creator, _ := testkeeper.PrivateKeyToAddresses(privKey)
+	amt := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000000000)))
+	k.BankKeeper().MintCoins(ctx, "evm", amt)
+	k.BankKeeper().SendCoinsFromModuleToAccount(ctx, "evm", creator, amt)
+	_, pointerAddr := testkeeper.MockAddressPair()
+
+	msg := &evmtypes.MsgRegisterPointer{Sender: creator.String(), PointerType: evmtypes.PointerType_ERC20, ErcAddress: pointerAddr.Hex()}
+	txBuilder := testkeeper.EVMTestApp.GetTxConfig().NewTxBuilder()
+	txBuilder.SetMsgs(msg)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000))))
+	txBuilder.SetGasLimit(300000)
+	tx := signTx(txBuilder, privKey, k.AccountKeeper().GetAccount(ctx, creator))
+	txbz, err := testkeeper.EVMTestApp.GetTxConfig().TxEncoder()(tx)
+	require.Nil(t, err)
+	sum := sha256.Sum256(txbz)
+
+	registered, err := sdk.TypedEventToEvent(&evmtypes.EventPointerRegistered{
+		PointerType: evmtypes.PointerType_NATIVE, Pointee: "ufoo", Pointer: pointerAddr.Hex(), NewVersion: 2, Actor: creator.String(),
+	})
+	require.Nil(t, err)
+	// CW pointers have no EVM address to emit a log from
+	cwRegistered, err := sdk.TypedEventToEvent(&evmtypes.EventPointerRegistered{
+		PointerType: evmtypes.PointerType_ERC20, Pointee: pointerAddr.Hex(), Pointer: creator.String(), NewVersion: 1, Actor: creator.String(),
+	})
+	require.Nil(t, err)
+	testkeeper.EVMTestApp.AddCosmosEventsToEVMReceiptIfApplicable(ctx, tx, sum, sdk.DeliverTxHookInput{
+		Events: []abci.Event{abci.Event(registered), abci.Event(cwRegistered)},
+	})
+
+	receipt, err := k.GetTransientReceipt(ctx, common.BytesToHash(sum[:]))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(receipt.Logs))
+	require.NotEmpty(t, receipt.LogsBloom)
+	require.Equal(t, pointerAddr.Hex(), receipt.Logs[0].Address)
+	require.Equal(t, []string{app.PointerRegisteredTopic.Hex()}, receipt.Logs[0].Topics)
+	uint8Type, _ := eabi.NewType("uint8", "", nil)
+	stringType, _ := eabi.NewType("string", "", nil)
+	uint16Type, _ := eabi.NewType("uint16", "", nil)
+	args, err := eabi.Arguments{{Type: uint8Type}, {Type: stringType}, {Type: uint16Type}}.Unpack(receipt.Logs[0].Data)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{uint8(evmtypes.PointerType_NATIVE), "ufoo", uint16(2)}, args)
+}
+
 func signTx(txBuilder client.TxBuilder, privKey cryptotypes.PrivKey, acc authtypes.AccountI) sdk.Tx {
 	var sigsV2 []signing.SignatureV2
 	sigV2 := signing.SignatureV2{
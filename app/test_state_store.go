@@ -29,21 +29,12 @@ func (s *InMemoryStateStore) Get(storeKey string, version int64, key []byte) ([]
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	store, ok := s.data[storeKey]
-	if !ok {
-		return nil, errors.New("not found")
-	}
-
-	versionData, ok := store[version]
-	if !ok {
-		return nil, errors.New("not found")
-	}
-
-	value, ok := versionData[string(key)]
-	if !ok {
-		return nil, errors.New("not found")
+	if version < s.earliestVersion {
+		return nil, errors.New("version pruned")
 	}
 
+	// like the PebbleDB store, missing keys read as nil
+	value, _ := valueAt(s.data[storeKey], version, string(key))
 	return value, nil
 }
 
@@ -52,16 +43,11 @@ func (s *InMemoryStateStore) Has(storeKey string, version int64, key []byte) (bo
 	defer s.mu.RUnlock()
 
 	store, ok := s.data[storeKey]
-	if !ok {
+	if !ok || version < s.earliestVersion {
 		return false, nil
 	}
 
-	versionData, ok := store[version]
-	if !ok {
-		return false, nil
-	}
-
-	_, ok = versionData[string(key)]
+	_, ok = valueAt(store, version, string(key))
 	return ok, nil
 }
 
@@ -74,12 +60,11 @@ func (s *InMemoryStateStore) Iterator(storeKey string, version int64, start, end
 		return nil, errors.New("store not found")
 	}
 
-	versionData, ok := store[version]
-	if !ok {
+	if version < s.earliestVersion {
 		return nil, errors.New("version not found")
 	}
 
-	return NewInMemoryIterator(versionData, start, end), nil
+	return NewInMemoryIterator(snapshotAt(store, version), start, end), nil
 }
 
 func (s *InMemoryStateStore) ReverseIterator(storeKey string, version int64, start, end []byte) (types.DBIterator, error) {
@@ -91,12 +76,11 @@ func (s *InMemoryStateStore) ReverseIterator(storeKey string, version int64, sta
 		return nil, errors.New("store not found")
 	}
 
-	versionData, ok := store[version]
-	if !ok {
+	if version < s.earliestVersion {
 		return nil, errors.New("version not found")
 	}
 
-	iter := NewInMemoryIterator(versionData, start, end)
+	iter := NewInMemoryIterator(snapshotAt(store, version), start, end)
 
 	// Reverse the keys for reverse iteration
 	for i, j := 0, len(iter.keys)-1; i < j; i, j = i+1, j-1 {
@@ -106,12 +90,53 @@ func (s *InMemoryStateStore) ReverseIterator(storeKey string, version int64, sta
 	return iter, nil
 }
 
+// valueAt returns the value of key as of version, i.e. as last written at or
+// before version. Deletes are recorded as nil values.
+func valueAt(store map[int64]map[string][]byte, version int64, key string) ([]byte, bool) {
+	var value []byte
+	found := int64(-1)
+	for ver, versionData := range store {
+		if ver > version || ver < found {
+			continue
+		}
+		if v, ok := versionData[key]; ok {
+			value, found = v, ver
+		}
+	}
+	return value, value != nil
+}
+
+// snapshotAt returns all keys and values as of version.
+func snapshotAt(store map[int64]map[string][]byte, version int64) map[string][]byte {
+	versions := make([]int64, 0, len(store))
+	for ver := range store {
+		if ver <= version {
+			versions = append(versions, ver)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	snapshot := make(map[string][]byte)
+	for _, ver := range versions {
+		for key, value := range store[ver] {
+			if value == nil {
+				delete(snapshot, key)
+			} else {
+				snapshot[key] = value
+			}
+		}
+	}
+	return snapshot
+}
+
 func (s *InMemoryStateStore) RawIterate(storeKey string, fn func([]byte, []byte, int64) bool) (bool, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	for version, versionData := range s.data[storeKey] {
 		for key, value := range versionData {
+			if value == nil {
+				continue
+			}
 			if !fn([]byte(key), value, version) {
 				return false, nil
 			}
@@ -169,7 +194,8 @@ func (s *InMemoryStateStore) ApplyChangeset(version int64, cs *seidbproto.NamedC
 		}
 
 		if pair.Delete {
-			delete(s.data[storeKey][version], string(key))
+			// recorded so that reads at later versions don't see older values
+			s.data[storeKey][version][string(key)] = nil
 		} else {
 			s.data[storeKey][version][string(key)] = value
 		}
@@ -269,11 +295,16 @@ func (s *InMemoryStateStore) Prune(version int64) error {
 	defer s.mu.Unlock()
 
 	for storeKey, store := range s.data {
+		// keep the values as of version, which later versions read through
+		snapshot := snapshotAt(store, version)
 		for ver := range store {
 			if ver <= version {
 				delete(store, ver)
 			}
 		}
+		if len(snapshot) > 0 {
+			store[version] = snapshot
+		}
 		if len(store) == 0 {
 			delete(s.data, storeKey)
 		}
@@ -112,6 +112,59 @@ func TestReverseIterator(t *testing.T) {
 	iter.Close()
 }
 
+func TestReadsAcrossVersions(t *testing.T) {
+	store := NewInMemoryStateStore()
+
+	err := store.ApplyChangeset(1, &seidbproto.NamedChangeSet{
+		Changeset: iavl.ChangeSet{
+			Pairs: []*iavl.KVPair{
+				{Key: []byte("key1"), Value: []byte("value1")},
+				{Key: []byte("key2"), Value: []byte("value2")},
+			},
+		},
+		Name: "exampleStore",
+	})
+	assert.NoError(t, err)
+	err = store.ApplyChangeset(2, &seidbproto.NamedChangeSet{
+		Changeset: iavl.ChangeSet{
+			Pairs: []*iavl.KVPair{
+				{Key: []byte("key1"), Delete: true},
+				{Key: []byte("key3"), Value: []byte("value3")},
+			},
+		},
+		Name: "exampleStore",
+	})
+	assert.NoError(t, err)
+
+	value, err := store.Get("exampleStore", 2, []byte("key2"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	value, err = store.Get("exampleStore", 2, []byte("key1"))
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = store.Get("exampleStore", 1, []byte("key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	iter, err := store.Iterator("exampleStore", 2, nil, nil)
+	assert.NoError(t, err)
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Close()
+	assert.Equal(t, []string{"key2", "key3"}, keys)
+
+	// pruning keeps the values later versions read
+	err = store.Prune(1)
+	assert.NoError(t, err)
+	value, err = store.Get("exampleStore", 2, []byte("key2"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+}
+
 func TestGetLatestVersionAndSetLatestVersion(t *testing.T) {
 	store := NewInMemoryStateStore()
 
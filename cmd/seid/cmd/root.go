@@ -42,6 +42,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/blocktest"
 	"github.com/sei-protocol/sei-chain/x/evm/querier"
 	"github.com/sei-protocol/sei-chain/x/evm/replay"
+	"github.com/sei-protocol/sei-chain/x/evm/retention"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	tmcfg "github.com/tendermint/tendermint/config"
@@ -427,6 +428,8 @@ func initAppConfig() (string, interface{}) {
 
 		EvmQuery querier.Config `mapstructure:"evm_query"`
 
+		ReceiptRetention retention.Config `mapstructure:"receipt_retention"`
+
 		LightInvariance app.LightInvarianceConfig `mapstructure:"light_invariance"`
 	}
 
@@ -467,11 +470,12 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
-		EVM:             evmrpc.DefaultConfig,
-		ETHReplay:       replay.DefaultConfig,
-		ETHBlockTest:    blocktest.DefaultConfig,
-		EvmQuery:        querier.DefaultConfig,
-		LightInvariance: app.DefaultLightInvarianceConfig,
+		EVM:              evmrpc.DefaultConfig,
+		ETHReplay:        replay.DefaultConfig,
+		ETHBlockTest:     blocktest.DefaultConfig,
+		EvmQuery:         querier.DefaultConfig,
+		ReceiptRetention: retention.DefaultConfig,
+		LightInvariance:  app.DefaultLightInvarianceConfig,
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -564,6 +568,20 @@ eth_blocktest_test_data_path = "{{ .ETHBlockTest.TestDataPath }}"
 
 [evm_query]
 evm_query_gas_limit = {{ .EvmQuery.GasLimit }}
+evm_query_max_page_limit = {{ .EvmQuery.MaxPageLimit }}
+evm_query_disable_tracing = {{ .EvmQuery.DisableTracing }}
+evm_query_max_trace_size = {{ .EvmQuery.MaxTraceSize }}
+evm_query_max_trace_struct_logs = {{ .EvmQuery.MaxTraceStructLogs }}
+evm_query_max_logs_block_range = {{ .EvmQuery.MaxLogsBlockRange }}
+evm_query_max_contract_tx_participants_block_range = {{ .EvmQuery.MaxContractTxParticipantsBlockRange }}
+
+[receipt_retention]
+# Number of most recent blocks whose EVM receipts are kept. Older receipts are
+# pruned, and queries for them return a "receipt pruned" error. 0 keeps all
+# receipts, as archive nodes serving historical JSON-RPC need.
+receipt_retain_blocks = {{ .ReceiptRetention.RetainBlocks }}
+# Maximum number of receipts pruned per block
+receipt_prune_batch_size = {{ .ReceiptRetention.PruneBatchSize }}
 
 [light_invariance]
 supply_enabled = {{ .LightInvariance.SupplyEnabled }}
@@ -107,6 +107,47 @@ contract MockERC1155Receiver is IERC1155Receiver {
     }
 }
 
+contract MockBadERC1155Receiver is IERC1155Receiver {
+    function onERC1155Received(address, address, uint256, uint256, bytes calldata) external pure returns (bytes4) {
+        return 0xdeadbeef;
+    }
+
+    function onERC1155BatchReceived(address, address, uint256[] calldata, uint256[] calldata, bytes calldata) external pure returns (bytes4) {
+        return 0xdeadbeef;
+    }
+
+    function supportsInterface(bytes4 interfaceId) public pure override(IERC165) returns (bool) {
+        return
+            interfaceId == type(IERC1155Receiver).interfaceId ||
+            interfaceId == type(IERC165).interfaceId;
+    }
+}
+
+// MockReentrantERC1155Receiver re-enters the pointer from inside the receiver
+// hook, sending what it received straight back.
+contract MockReentrantERC1155Receiver is IERC1155Receiver {
+    CW1155ERC1155Pointer immutable pointer;
+
+    constructor(CW1155ERC1155Pointer pointer_) {
+        pointer = pointer_;
+    }
+
+    function onERC1155Received(address, address from, uint256 id, uint256 value, bytes calldata) external returns (bytes4) {
+        pointer.safeTransferFrom(address(this), from, id, value, bytes(""));
+        return IERC1155Receiver.onERC1155Received.selector;
+    }
+
+    function onERC1155BatchReceived(address, address, uint256[] calldata, uint256[] calldata, bytes calldata) external pure returns (bytes4) {
+        return IERC1155Receiver.onERC1155BatchReceived.selector;
+    }
+
+    function supportsInterface(bytes4 interfaceId) public pure override(IERC165) returns (bool) {
+        return
+            interfaceId == type(IERC1155Receiver).interfaceId ||
+            interfaceId == type(IERC165).interfaceId;
+    }
+}
+
 contract CW1155ERC1155PointerTest is Test {
 
     event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value);
@@ -396,6 +437,32 @@ contract CW1155ERC1155PointerTest is Test {
         vm.stopPrank();
     }
 
+    function testSafeTransferFromToBadReceiver() public {
+        bytes memory queryCall = bytes("{\"balance_of\":{\"owner\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"token_id\":\"1\"}}");
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("query(string,bytes)", MockCWContractAddress, queryCall),
+            abi.encode("{\"balance\":\"1\"}")
+        );
+        vm.mockCall(
+            JSON_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("extractAsUint256(bytes,string)", bytes("{\"balance\":\"1\"}"), "balance"),
+            abi.encode(1)
+        );
+        bytes memory executeCall = bytes("{\"send\":{\"from\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"to\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"token_id\":\"1\",\"amount\":\"1\"}}");
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("execute(string,bytes,bytes)", MockCWContractAddress, executeCall),
+            abi.encode(bytes(""))
+        );
+        vm.etch(MockOperatorEVMAddr, address(new MockBadERC1155Receiver()).code);
+
+        vm.startPrank(MockCallerEVMAddr);
+        vm.expectRevert("unsafe transfer");
+        pointer.safeTransferFrom(MockCallerEVMAddr, MockOperatorEVMAddr, 1, 1, bytes(""));
+        vm.stopPrank();
+    }
+
     function testSafeTransferFromWithOperator() public {
         bytes memory queryCall1 = bytes("{\"balance_of\":{\"owner\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"token_id\":\"1\"}}");
         vm.mockCall(
@@ -644,4 +711,66 @@ contract CW1155ERC1155PointerTest is Test {
         pointer.burnBatch(MockCallerEVMAddr, ids, amounts);
         vm.stopPrank();
     }
-}
\ No newline at end of file
+
+    function mockReentrantSend(string memory receiverBalance) internal {
+        bytes memory callerBalanceCall = bytes("{\"balance_of\":{\"owner\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"token_id\":\"1\"}}");
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("query(string,bytes)", MockCWContractAddress, callerBalanceCall),
+            abi.encode("{\"balance\":\"1\"}")
+        );
+        vm.mockCall(
+            JSON_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("extractAsUint256(bytes,string)", bytes("{\"balance\":\"1\"}"), "balance"),
+            abi.encode(1)
+        );
+        // the hook runs after the CW send, so the receiver's balance already
+        // reflects it when it re-enters
+        bytes memory receiverBalanceCall = bytes("{\"balance_of\":{\"owner\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"token_id\":\"1\"}}");
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("query(string,bytes)", MockCWContractAddress, receiverBalanceCall),
+            abi.encode(receiverBalance)
+        );
+        bytes memory sendCall = bytes("{\"send\":{\"from\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"to\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"token_id\":\"1\",\"amount\":\"1\"}}");
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("execute(string,bytes,bytes)", MockCWContractAddress, sendCall),
+            abi.encode(bytes(""))
+        );
+        bytes memory sendBackCall = bytes("{\"send\":{\"from\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"to\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"token_id\":\"1\",\"amount\":\"1\"}}");
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("execute(string,bytes,bytes)", MockCWContractAddress, sendBackCall),
+            abi.encode(bytes(""))
+        );
+        vm.etch(MockOperatorEVMAddr, address(new MockReentrantERC1155Receiver(pointer)).code);
+    }
+
+    function testSafeTransferFromToReentrantReceiver() public {
+        mockReentrantSend("{\"balance\":\"1\"}");
+        vm.expectCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("execute(string,bytes,bytes)", MockCWContractAddress, bytes("{\"send\":{\"from\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"to\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"token_id\":\"1\",\"amount\":\"1\"}}"), bytes("[]"))
+        );
+        vm.expectCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("execute(string,bytes,bytes)", MockCWContractAddress, bytes("{\"send\":{\"from\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"to\":\"sei19zhelek4q5lt4zam8mcarmgv92vzgqd3ux32jw\",\"token_id\":\"1\",\"amount\":\"1\"}}"), bytes("[]"))
+        );
+
+        vm.startPrank(MockCallerEVMAddr);
+        pointer.safeTransferFrom(MockCallerEVMAddr, MockOperatorEVMAddr, 1, 1, bytes(""));
+        vm.stopPrank();
+    }
+
+    function testSafeTransferFromToRejectedReentrantReceiver() public {
+        // a receiver can't send on more than the CW contract says it holds,
+        // and the failed send reverts the outer transfer too
+        mockReentrantSend("{\"balance\":\"0\"}");
+
+        vm.startPrank(MockCallerEVMAddr);
+        vm.expectRevert("ERC1155: insufficient balance for transfer");
+        pointer.safeTransferFrom(MockCallerEVMAddr, MockOperatorEVMAddr, 1, 1, bytes(""));
+        vm.stopPrank();
+    }
+}
@@ -6,6 +6,8 @@ import {CW721ERC721Pointer} from "../src/CW721ERC721Pointer.sol";
 import {IWasmd} from "../src/precompiles/IWasmd.sol";
 import {IJson} from "../src/precompiles/IJson.sol";
 import {IAddr} from "../src/precompiles/IAddr.sol";
+import "@openzeppelin/contracts/interfaces/draft-IERC6093.sol";
+import "@openzeppelin/contracts/token/ERC721/IERC721Receiver.sol";
 
 address constant WASMD_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001002;
 address constant JSON_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001003;
@@ -75,6 +77,35 @@ contract MockAddr is IAddr {
     }
 }
 
+contract MockERC721Receiver is IERC721Receiver {
+    function onERC721Received(address, address, uint256, bytes calldata) external pure returns (bytes4) {
+        return IERC721Receiver.onERC721Received.selector;
+    }
+}
+
+contract MockBadERC721Receiver is IERC721Receiver {
+    function onERC721Received(address, address, uint256, bytes calldata) external pure returns (bytes4) {
+        return 0xdeadbeef;
+    }
+}
+
+// MockReentrantERC721Receiver re-enters the pointer from inside the receiver
+// hook, transferring tokenId from the sender to itself.
+contract MockReentrantERC721Receiver is IERC721Receiver {
+    CW721ERC721Pointer immutable pointer;
+    uint256 immutable tokenId;
+
+    constructor(CW721ERC721Pointer pointer_, uint256 tokenId_) {
+        pointer = pointer_;
+        tokenId = tokenId_;
+    }
+
+    function onERC721Received(address, address from, uint256, bytes calldata) external returns (bytes4) {
+        pointer.transferFrom(from, address(this), tokenId);
+        return IERC721Receiver.onERC721Received.selector;
+    }
+}
+
 contract CW721ERC721PointerTest is Test {
     event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
     event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId);
@@ -328,4 +359,90 @@ contract CW721ERC721PointerTest is Test {
         pointer.setApprovalForAll(0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, false);
         vm.stopPrank();
     }
-}
\ No newline at end of file
+
+    function mockTransferNFT() internal {
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("query(string,bytes)", MockCWContractAddress, bytes("{\"owner_of\":{\"token_id\":\"1\"}}")),
+            abi.encode("{\"owner\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\"}")
+        );
+        vm.mockCall(
+            JSON_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("extractAsBytes(bytes,string)", bytes("{\"owner\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\"}"), "owner"),
+            abi.encode(bytes("sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe"))
+        );
+        vm.mockCall(
+            ADDR_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("getEvmAddr(string)", "sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe"),
+            abi.encode(address(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266))
+        );
+        vm.mockCall(
+            WASMD_PRECOMPILE_ADDRESS,
+            abi.encodeWithSignature("execute(string,bytes,bytes)", MockCWContractAddress, bytes("{\"transfer_nft\":{\"recipient\":\"sei1vldxw5dy5k68hqr4d744rpg9w8cqs54x4asdqe\",\"token_id\":\"1\"}}"), bytes("[]")),
+            abi.encode(bytes(""))
+        );
+    }
+
+    function testSafeTransferFromToEOA() public {
+        mockTransferNFT();
+        vm.startPrank(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266);
+        pointer.safeTransferFrom(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, 0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, 1);
+        vm.stopPrank();
+    }
+
+    function testSafeTransferFromToReceiver() public {
+        mockTransferNFT();
+        vm.etch(MockOperatorEVMAddr, address(new MockERC721Receiver()).code);
+        vm.expectCall(
+            MockOperatorEVMAddr,
+            abi.encodeWithSignature("onERC721Received(address,address,uint256,bytes)", MockCallerEVMAddr, MockCallerEVMAddr, 1, bytes("data"))
+        );
+        vm.startPrank(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266);
+        pointer.safeTransferFrom(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, 0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, 1, bytes("data"));
+        vm.stopPrank();
+    }
+
+    function testSafeTransferFromToBadReceiver() public {
+        mockTransferNFT();
+        vm.etch(MockOperatorEVMAddr, address(new MockBadERC721Receiver()).code);
+        vm.startPrank(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266);
+        vm.expectRevert(abi.encodeWithSelector(IERC721Errors.ERC721InvalidReceiver.selector, MockOperatorEVMAddr));
+        pointer.safeTransferFrom(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, 0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, 1);
+        vm.stopPrank();
+    }
+
+    function testSafeTransferFromToNonReceiver() public {
+        mockTransferNFT();
+        // a contract without onERC721Received can't take the token
+        vm.etch(MockOperatorEVMAddr, address(mockJson).code);
+        vm.startPrank(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266);
+        vm.expectRevert(abi.encodeWithSelector(IERC721Errors.ERC721InvalidReceiver.selector, MockOperatorEVMAddr));
+        pointer.safeTransferFrom(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, 0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, 1);
+        vm.stopPrank();
+    }
+
+    function testSafeTransferFromToReentrantReceiver() public {
+        mockTransferNFT();
+        // the pointer keeps no state of its own across the hook, so a
+        // reentrant transfer is decided by the CW contract like any other
+        vm.etch(MockOperatorEVMAddr, address(new MockReentrantERC721Receiver(pointer, 1)).code);
+        vm.expectCall(
+            address(pointer),
+            abi.encodeWithSignature("transferFrom(address,address,uint256)", MockCallerEVMAddr, MockOperatorEVMAddr, 1)
+        );
+        vm.startPrank(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266);
+        pointer.safeTransferFrom(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, 0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, 1);
+        vm.stopPrank();
+    }
+
+    function testSafeTransferFromToRejectedReentrantReceiver() public {
+        mockTransferNFT();
+        // token 2 isn't owned by the sender, so the reentrant transfer fails
+        // and takes the outer transfer down with it
+        vm.etch(MockOperatorEVMAddr, address(new MockReentrantERC721Receiver(pointer, 2)).code);
+        vm.startPrank(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266);
+        vm.expectRevert("`from` must be the owner");
+        pointer.safeTransferFrom(0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, 0xF39fD6e51Aad88F6f4CE6AB8827279CFffb92267, 1);
+        vm.stopPrank();
+    }
+}
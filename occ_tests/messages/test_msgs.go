@@ -78,10 +78,32 @@ func EVMTransferConflicting(tCtx *utils.TestContext, count int) []*utils.TestMes
 	return msgs
 }
 
-// EVMTransferNonConflicting generates a list of EVM transfer messages that do not conflict with each other
-// each message will have a brand new address
+// EVMTransferTo generates a list of EVM transfer messages from brand new addresses to the given address
+func EVMTransferTo(to common.Address, count int) []*utils.TestMessage {
+	var msgs []*utils.TestMessage
+	for i := 0; i < count; i++ {
+		msgs = append(msgs, evmTransfer(utils.NewSigner(), to, "EVMTransferTo"))
+	}
+	return msgs
+}
+
+// EVMSelfDestructingDeploy generates a contract creation from the deployer's first nonce whose
+// init code self-destructs to the beneficiary, removing any association held by the new address
+func EVMSelfDestructingDeploy(deployer utils.TestAcct, beneficiary common.Address) []*utils.TestMessage {
+	// PUSH20 <beneficiary> SELFDESTRUCT
+	initCode := append(append([]byte{0x73}, beneficiary.Bytes()...), 0xff)
+	return []*utils.TestMessage{evmMessage(deployer, &ethtypes.DynamicFeeTx{
+		GasFeeCap: new(big.Int).SetUint64(100000000000),
+		GasTipCap: new(big.Int).SetUint64(100000000000),
+		Gas:       100000,
+		ChainID:   big.NewInt(config.DefaultChainID),
+		Data:      initCode,
+		Nonce:     0,
+	}, "EVMSelfDestructingDeploy")}
+}
+
 func evmTransfer(testAcct utils.TestAcct, to common.Address, scenario string) *utils.TestMessage {
-	signedTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+	return evmMessage(testAcct, &ethtypes.DynamicFeeTx{
 		GasFeeCap: new(big.Int).SetUint64(100000000000),
 		GasTipCap: new(big.Int).SetUint64(100000000000),
 		Gas:       21000,
@@ -89,8 +111,11 @@ func evmTransfer(testAcct utils.TestAcct, to common.Address, scenario string) *u
 		To:        &to,
 		Value:     big.NewInt(1),
 		Nonce:     0,
-	}), testAcct.EvmSigner, testAcct.EvmPrivateKey)
+	}, scenario)
+}
 
+func evmMessage(testAcct utils.TestAcct, tx *ethtypes.DynamicFeeTx, scenario string) *utils.TestMessage {
+	signedTx, err := ethtypes.SignTx(ethtypes.NewTx(tx), testAcct.EvmSigner, testAcct.EvmPrivateKey)
 	if err != nil {
 		panic(err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/sei-protocol/sei-chain/occ_tests/messages"
 	"github.com/sei-protocol/sei-chain/occ_tests/utils"
 	"github.com/stretchr/testify/require"
@@ -101,6 +102,11 @@ func assertEqualExecTxResults(t *testing.T, expected, actual []*types.ExecTxResu
 // between both parallel and sequential executions
 func TestParallelTransactions(t *testing.T) {
 	runs := 3
+	// the deployer's first contract self-destructs on creation, deleting the
+	// association seeded for its address while transfers to it are in flight
+	deployer := utils.NewSigner()
+	selfDestructed := crypto.CreateAddress(deployer.EvmAddress, 0)
+	associated := utils.NewSigner()
 	tests := []struct {
 		name    string
 		runs    int
@@ -153,6 +159,21 @@ func TestParallelTransactions(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "Test evm transfers around a removed association",
+			runs: runs,
+			before: func(tCtx *utils.TestContext) {
+				seeded := tCtx.Ctx.WithBlockHeight(tCtx.Ctx.BlockHeight() - 1)
+				tCtx.TestApp.EvmKeeper.SetAddressMapping(seeded, associated.AccountAddress, selfDestructed)
+			},
+			txs: func(tCtx *utils.TestContext) []*utils.TestMessage {
+				return utils.JoinMsgs(
+					messages.EVMTransferTo(selfDestructed, 10),
+					messages.EVMSelfDestructingDeploy(deployer, tCtx.TestAccounts[2].EvmAddress),
+					messages.EVMTransferTo(selfDestructed, 10),
+				)
+			},
+		},
 		{
 			name:    "Test combinations",
 			runs:    runs,
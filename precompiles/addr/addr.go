@@ -243,7 +243,7 @@ func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, method *abi.Meth
 
 	// Associate Addresses:
 	associationHelper := helpers.NewAssociationHelper(p.evmKeeper, p.bankKeeper, p.accountKeeper)
-	err = associationHelper.AssociateAddresses(ctx, seiAddr, evmAddr, pubkey)
+	err = associationHelper.AssociateAddresses(ctx, seiAddr, evmAddr, pubkey, types.AssociationMechanism_PRECOMPILE)
 	if err != nil {
 		return nil, 0, err
 	}
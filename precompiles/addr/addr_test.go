@@ -157,7 +157,7 @@ func TestAssociatePubKey(t *testing.T) {
 			require.Nil(t, err)
 
 			// Make the call to associate.
-			suppliedGas := uint64(40000)
+			suppliedGas := uint64(60000)
 			if tt.args.suppliedGas != 0 {
 				suppliedGas = tt.args.suppliedGas
 			}
@@ -352,7 +352,7 @@ func TestAssociate(t *testing.T) {
 			require.Nil(t, err)
 
 			// Make the call to associate.
-			ret, _, err := p.RunAndCalculateGas(tt.args.evm, tt.args.caller, tt.args.caller, append(p.GetExecutor().(*addr.PrecompileExecutor).AssociateID, inputs...), 40000, tt.args.value, nil, tt.args.readOnly, false)
+			ret, _, err := p.RunAndCalculateGas(tt.args.evm, tt.args.caller, tt.args.caller, append(p.GetExecutor().(*addr.PrecompileExecutor).AssociateID, inputs...), 60000, tt.args.value, nil, tt.args.readOnly, false)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Run() error = %v, wantErr %v %v", err, tt.wantErr, string(ret))
 				return
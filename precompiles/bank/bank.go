@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
 	"github.com/sei-protocol/sei-chain/utils"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -111,7 +112,9 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 	}()
 	switch method.Name {
 	case SendMethod:
-		return p.send(ctx, caller, method, args, value, readOnly)
+		ret, remainingGas, err = p.send(ctx, caller, method, args, value, readOnly)
+		p.evmKeeper.RecordPointerOperation(evmtypes.PointerType_NATIVE, evmtypes.PointerOpTransfer, err)
+		return
 	case SendNativeMethod:
 		return p.sendNative(ctx, method, args, caller, callingContract, value, readOnly)
 	case BalanceMethod:
@@ -149,6 +152,9 @@ func (p PrecompileExecutor) send(ctx sdk.Context, caller common.Address, method
 	if !exists || pointer.Cmp(caller) != 0 {
 		return nil, 0, fmt.Errorf("only pointer %s can send %s but got %s", pointer.Hex(), denom, caller.Hex())
 	}
+	if p.evmKeeper.IsPointerPaused(ctx, pointer) {
+		return nil, 0, evmtypes.ErrPointerPaused
+	}
 	amount := args[3].(*big.Int)
 	if amount.Cmp(utils.Big0) == 0 {
 		// short circuit
@@ -299,6 +299,14 @@ func TestSendForUnlinkedReceiver(t *testing.T) {
 	require.Nil(t, err)
 	args, err := send.Inputs.Pack(senderEVMAddr, evmAddr, "ufoo", big.NewInt(100))
 	require.Nil(t, err)
+	_, err = k.SetPointerPaused(ctx, "", types.PointerType_NATIVE, "ufoo", true)
+	require.Nil(t, err)
+	_, _, err = p.RunAndCalculateGas(&evm, pointerAddr, pointerAddr, append(p.GetExecutor().(*bank.PrecompileExecutor).SendID, args...), 100000, nil, nil, false, false) // should error because the pointer is paused
+	require.NotNil(t, err)
+	require.ErrorIs(t, statedb.GetPrecompileError(), types.ErrPointerPaused)
+	statedb.SetPrecompileError(nil)
+	_, err = k.SetPointerPaused(ctx, "", types.PointerType_NATIVE, "ufoo", false)
+	require.Nil(t, err)
 	_, _, err = p.RunAndCalculateGas(&evm, pointerAddr, pointerAddr, append(p.GetExecutor().(*bank.PrecompileExecutor).SendID, args...), 100000, nil, nil, false, false) // should not error
 	require.Nil(t, err)
 
@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/sei-protocol/sei-chain/utils"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
@@ -41,6 +42,7 @@ type EVMKeeper interface {
 	GetSeiAddressOrDefault(ctx sdk.Context, evmAddress common.Address) sdk.AccAddress // only used for getting precompile Sei addresses
 	GetEVMAddress(sdk.Context, sdk.AccAddress) (common.Address, bool)
 	SetAddressMapping(sdk.Context, sdk.AccAddress, common.Address)
+	SetAddressMappingWithMechanism(sdk.Context, sdk.AccAddress, common.Address, evmtypes.AssociationMechanism)
 	GetCodeHash(sdk.Context, common.Address) common.Hash
 	GetPriorityNormalizer(ctx sdk.Context) sdk.Dec
 	GetBaseDenom(ctx sdk.Context) string
@@ -67,6 +69,11 @@ type EVMKeeper interface {
 	) (contractAddr common.Address, err error)
 	GetEVMGasLimitFromCtx(ctx sdk.Context) uint64
 	GetCosmosGasLimitFromEVMGas(ctx sdk.Context, evmGas uint64) uint64
+	CheckPointerRegistrationAllowed(ctx sdk.Context, sender sdk.AccAddress) error
+	CheckNoPointerOfOtherType(ctx sdk.Context, pointerType evmtypes.PointerType, pointee string) error
+	IsPointerPaused(ctx sdk.Context, addr common.Address) bool
+	GetCW20MarketingInfo(ctx sdk.Context, cw20Addr string) (utils.CW20MarketingInfo, error)
+	RecordPointerOperation(pointerType evmtypes.PointerType, operation string, err error)
 }
 
 type AccountKeeper interface {
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
 	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
 const (
@@ -74,6 +75,9 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *ethabi.Method, call
 	if ctx.EVMPrecompileCalledFromDelegateCall() {
 		return nil, 0, errors.New("cannot delegatecall pointer")
 	}
+	if err := p.evmKeeper.CheckPointerRegistrationAllowed(ctx, p.evmKeeper.GetSeiAddressOrDefault(ctx, caller)); err != nil {
+		return nil, 0, err
+	}
 
 	switch method.Name {
 	case AddNativePointer:
@@ -140,6 +144,9 @@ func (p PrecompileExecutor) AddCW20(ctx sdk.Context, method *ethabi.Method, call
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := p.evmKeeper.CheckNoPointerOfOtherType(ctx, types.PointerType_CW20, cwAddr); err != nil {
+		return nil, 0, err
+	}
 	res, err := p.wasmdKeeper.QuerySmartSafe(ctx, cwAddress, []byte("{\"token_info\":{}}"))
 	if err != nil {
 		return nil, 0, err
@@ -171,6 +178,9 @@ func (p PrecompileExecutor) AddCW721(ctx sdk.Context, method *ethabi.Method, cal
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := p.evmKeeper.CheckNoPointerOfOtherType(ctx, types.PointerType_CW721, cwAddr); err != nil {
+		return nil, 0, err
+	}
 	res, err := p.wasmdKeeper.QuerySmartSafe(ctx, cwAddress, []byte("{\"contract_info\":{}}"))
 	if err != nil {
 		return nil, 0, err
@@ -202,6 +212,9 @@ func (p PrecompileExecutor) AddCW1155(ctx sdk.Context, method *ethabi.Method, ca
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := p.evmKeeper.CheckNoPointerOfOtherType(ctx, types.PointerType_CW1155, cwAddr); err != nil {
+		return nil, 0, err
+	}
 	res, err := p.wasmdKeeper.QuerySmartSafe(ctx, cwAddress, []byte("{\"contract_info\":{}}"))
 	if err != nil {
 		return nil, 0, err
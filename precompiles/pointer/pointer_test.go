@@ -57,7 +57,7 @@ func TestAddNative(t *testing.T) {
 	evm = vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{}, testApp.EvmKeeper.CustomPrecompiles())
 	ret, g, err := p.RunAndCalculateGas(evm, caller, caller, append(p.GetExecutor().(*pointer.PrecompileExecutor).AddNativePointerID, args...), suppliedGas, nil, nil, false, false)
 	require.Nil(t, err)
-	require.Equal(t, uint64(8889527), g)
+	require.Equal(t, uint64(8843178), g)
 	outputs, err := m.Outputs.Unpack(ret)
 	require.Nil(t, err)
 	addr := outputs[0].(common.Address)
@@ -92,3 +92,32 @@ func TestAddNative(t *testing.T) {
 	require.Equal(t, addr, pointerAddr)
 	require.Equal(t, newAddr, pointerAddr) // address should stay the same as before
 }
+
+func TestAddPointerAllowlist(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	p, err := pointer.NewPrecompile(&testApp.EvmKeeper, testApp.BankKeeper, testApp.WasmKeeper)
+	require.Nil(t, err)
+	ctx, _ := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	allowed, _ := testkeeper.MockAddressPair()
+	_, caller := testkeeper.MockAddressPair()
+	suppliedGas := uint64(10000000)
+	cfg := types.DefaultChainConfig().EthereumConfig(testApp.EvmKeeper.ChainID(ctx))
+	testApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: "allowlisted", Name: "allowlisted", Symbol: "allowlisted"})
+	params := testApp.EvmKeeper.GetParams(ctx)
+	params.PointerRegistrationAllowlist = []string{allowed.String()}
+	testApp.EvmKeeper.SetParams(ctx, params)
+
+	m, err := p.ABI.MethodById(p.GetExecutor().(*pointer.PrecompileExecutor).AddNativePointerID)
+	require.Nil(t, err)
+	args, err := m.Inputs.Pack("allowlisted")
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, &testApp.EvmKeeper, true)
+	blockCtx, _ := testApp.EvmKeeper.GetVMBlockContext(ctx, core.GasPool(suppliedGas))
+	evm := vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{}, testApp.EvmKeeper.CustomPrecompiles())
+	_, _, err = p.RunAndCalculateGas(evm, caller, caller, append(p.GetExecutor().(*pointer.PrecompileExecutor).AddNativePointerID, args...), suppliedGas, nil, nil, false, false)
+	require.NotNil(t, err)
+	require.ErrorContains(t, statedb.GetPrecompileError(), "pointer registration allowlist")
+	_, _, exists := testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), "allowlisted")
+	require.False(t, exists)
+}
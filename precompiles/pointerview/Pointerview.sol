@@ -21,4 +21,8 @@ interface IPointerview {
     function getCW1155Pointer(
         string memory cwAddr
     ) view external returns (address addr, uint16 version, bool exists);
+
+    function getCW20MarketingInfo(
+        string memory cwAddr
+    ) view external returns (string memory project, string memory description, string memory logo);
 }
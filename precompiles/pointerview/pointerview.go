@@ -17,6 +17,8 @@ const (
 	GetCW20Pointer   = "getCW20Pointer"
 	GetCW721Pointer  = "getCW721Pointer"
 	GetCW1155Pointer = "getCW1155Pointer"
+
+	GetCW20MarketingInfo = "getCW20MarketingInfo"
 )
 
 const PointerViewAddress = "0x000000000000000000000000000000000000100A"
@@ -33,6 +35,8 @@ type PrecompileExecutor struct {
 	GetCW20PointerID   []byte
 	GetCW721PointerID  []byte
 	GetCW1155PointerID []byte
+
+	GetCW20MarketingInfoID []byte
 }
 
 func NewPrecompile(evmKeeper pcommon.EVMKeeper) (*pcommon.Precompile, error) {
@@ -52,6 +56,8 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper) (*pcommon.Precompile, error) {
 			p.GetCW721PointerID = m.ID
 		case GetCW1155Pointer:
 			p.GetCW1155PointerID = m.ID
+		case GetCW20MarketingInfo:
+			p.GetCW20MarketingInfoID = m.ID
 		}
 	}
 
@@ -76,6 +82,8 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 		return p.GetCW721(ctx, method, args)
 	case GetCW1155Pointer:
 		return p.GetCW1155(ctx, method, args)
+	case GetCW20MarketingInfo:
+		return p.GetCW20Marketing(ctx, method, args)
 	default:
 		err = fmt.Errorf("unknown method %s", method.Name)
 	}
@@ -117,3 +125,17 @@ func (p PrecompileExecutor) GetCW1155(ctx sdk.Context, method *abi.Method, args
 	existingAddr, existingVersion, exists := p.evmKeeper.GetERC1155CW1155Pointer(ctx, addr)
 	return method.Outputs.Pack(existingAddr, existingVersion, exists)
 }
+
+// GetCW20Marketing returns the marketing info of a CW20 contract, which its
+// ERC20 pointer doesn't expose itself. Contracts without marketing info have
+// empty fields.
+func (p PrecompileExecutor) GetCW20Marketing(ctx sdk.Context, method *abi.Method, args []interface{}) (ret []byte, err error) {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	info, err := p.evmKeeper.GetCW20MarketingInfo(ctx, args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(info.Project, info.Description, info.Logo)
+}
@@ -1,9 +1,12 @@
 package pointerview_test
 
 import (
+	"encoding/json"
+	"os"
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/sei-protocol/sei-chain/precompiles/pointerview"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -84,3 +87,41 @@ func TestPointerView(t *testing.T) {
 	require.Nil(t, err)
 	require.False(t, outputs[2].(bool))
 }
+
+func TestPointerViewCW20MarketingInfo(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).CacheContext()
+	p, err := pointerview.NewPrecompile(k)
+	require.Nil(t, err)
+	creator, _ := testkeeper.MockAddressPair()
+	code, err := os.ReadFile("../../contracts/wasm/cw20_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, creator, code, nil)
+	require.Nil(t, err)
+	msg, err := json.Marshal(map[string]interface{}{
+		"name": "Foo", "symbol": "FOO", "decimals": 6, "initial_balances": []interface{}{},
+		"marketing": map[string]interface{}{"project": "foo.io", "description": "the foo token", "logo": map[string]string{"url": "https://foo.io/logo.png"}},
+	})
+	require.Nil(t, err)
+	cw20, _, err := k.WasmKeeper().Instantiate(ctx, codeID, creator, creator, msg, "foo", sdk.NewCoins())
+	require.Nil(t, err)
+
+	executor := p.GetExecutor().(*pointerview.PrecompileExecutor)
+	m, err := p.ABI.MethodById(executor.GetCW20MarketingInfoID)
+	require.Nil(t, err)
+	ret, err := executor.GetCW20Marketing(ctx, m, []interface{}{cw20.String()})
+	require.Nil(t, err)
+	outputs, err := m.Outputs.Unpack(ret)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"foo.io", "the foo token", "https://foo.io/logo.png"}, outputs)
+
+	// accounts without marketing info don't revert
+	ret, err = executor.GetCW20Marketing(ctx, m, []interface{}{creator.String()})
+	require.Nil(t, err)
+	outputs, err = m.Outputs.Unpack(ret)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"", "", ""}, outputs)
+
+	_, err = executor.GetCW20Marketing(ctx, m, []interface{}{"test"})
+	require.NotNil(t, err)
+}
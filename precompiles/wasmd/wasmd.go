@@ -261,6 +261,9 @@ func (p PrecompileExecutor) executeBatch(ctx sdk.Context, method *abi.Method, ca
 			if (!erc20exists || erc20pointer.Cmp(callingContract) != 0) && (!erc721exists || erc721pointer.Cmp(callingContract) != 0) && (!erc1155exists || erc1155pointer.Cmp(callingContract) != 0) {
 				return nil, 0, fmt.Errorf("%s is not a pointer of %s", callingContract.Hex(), contractAddrStr)
 			}
+			if p.evmKeeper.IsPointerPaused(ctx, callingContract) {
+				return nil, 0, types.ErrPointerPaused
+			}
 		}
 
 		contractAddr, err := sdk.AccAddressFromBech32(contractAddrStr)
@@ -355,6 +358,9 @@ func (p PrecompileExecutor) execute(ctx sdk.Context, method *abi.Method, caller
 		if (!erc20exists || erc20pointer.Cmp(callingContract) != 0) && (!erc721exists || erc721pointer.Cmp(callingContract) != 0) && (!erc1155exists || erc1155pointer.Cmp(callingContract) != 0) {
 			return nil, 0, fmt.Errorf("%s is not a pointer of %s", callingContract.Hex(), contractAddrStr)
 		}
+		if p.evmKeeper.IsPointerPaused(ctx, callingContract) {
+			return nil, 0, types.ErrPointerPaused
+		}
 	}
 	// addresses will be sent in Sei format
 	contractAddr, err := sdk.AccAddressFromBech32(contractAddrStr)
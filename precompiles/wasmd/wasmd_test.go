@@ -15,6 +15,7 @@ import (
 	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -200,6 +201,16 @@ func TestExecute(t *testing.T) {
 	require.NotNil(t, err)
 	require.NotNil(t, statedb.GetPrecompileError())
 
+	// paused pointer delegatecall
+	_, err = testApp.EvmKeeper.SetPointerPaused(ctx, "", types.PointerType_CW20, contractAddr.String(), true)
+	require.Nil(t, err)
+	statedb.SetPrecompileError(nil)
+	_, _, err = p.RunAndCalculateGas(&evm, mockEVMAddr, contractAddrAllowed, append(p.GetExecutor().(*wasmd.PrecompileExecutor).ExecuteID, args...), suppliedGas, nil, nil, false, true)
+	require.NotNil(t, err)
+	require.ErrorIs(t, statedb.GetPrecompileError(), types.ErrPointerPaused)
+	_, err = testApp.EvmKeeper.SetPointerPaused(ctx, "", types.PointerType_CW20, contractAddr.String(), false)
+	require.Nil(t, err)
+
 	// bad contract address
 	args, _ = executeMethod.Inputs.Pack(mockAddr.String(), []byte("{\"echo\":{\"message\":\"test msg\"}}"), amtsbz)
 	statedb.SetPrecompileError(nil)
@@ -104,3 +104,7 @@ func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.
 func UseiCoins(amount int64) sdk.Coins {
 	return sdk.NewCoins(sdk.NewCoin(sdk.MustGetBaseDenom(), sdk.NewInt(amount)))
 }
+
+// MockPointeeCode answers every call with a 32-byte 1, which satisfies the
+// probes run on ERC pointees during pointer registration.
+var MockPointeeCode = common.FromHex("0x600160005260206000f3")
@@ -6,6 +6,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
 type AssociationHelper struct {
@@ -18,8 +19,8 @@ func NewAssociationHelper(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKe
 	return &AssociationHelper{evmKeeper: evmKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper}
 }
 
-func (p AssociationHelper) AssociateAddresses(ctx sdk.Context, seiAddr sdk.AccAddress, evmAddr common.Address, pubkey cryptotypes.PubKey) error {
-	p.evmKeeper.SetAddressMapping(ctx, seiAddr, evmAddr)
+func (p AssociationHelper) AssociateAddresses(ctx sdk.Context, seiAddr sdk.AccAddress, evmAddr common.Address, pubkey cryptotypes.PubKey, mechanism evmtypes.AssociationMechanism) error {
+	p.evmKeeper.SetAddressMappingWithMechanism(ctx, seiAddr, evmAddr, mechanism)
 	if acc := p.accountKeeper.GetAccount(ctx, seiAddr); acc.GetPubKey() == nil {
 		if err := acc.SetPubKey(pubkey); err != nil {
 			return err
@@ -5,3 +5,11 @@ type ERCMetadata struct {
 	Symbol   string
 	Decimals uint8
 }
+
+// CW20MarketingInfo is the marketing info of a CW20 contract, with its logo
+// as a URL or, if embedded, as a data URI.
+type CW20MarketingInfo struct {
+	Project     string
+	Description string
+	Logo        string
+}
@@ -302,6 +302,66 @@ func IncrementAssociationError(scenario string, err types.AssociationMissingErr)
 	)
 }
 
+// Measures pointer operations by pointer type, operation and outcome
+// Metric Name:
+//
+//	sei_evm_pointer_operation
+func IncrPointerOperation(pointerType string, operation string, success bool) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "evm", "pointer", "operation"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("pointer_type", pointerType),
+			telemetry.NewLabel("operation", operation),
+			telemetry.NewLabel("success", strconv.FormatBool(success)),
+		},
+	)
+}
+
+// Measures failed pointer operations by reason
+// Metric Name:
+//
+//	sei_evm_pointer_failure
+func IncrPointerFailure(pointerType string, operation string, reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "evm", "pointer", "failure"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("pointer_type", pointerType),
+			telemetry.NewLabel("operation", operation),
+			telemetry.NewLabel("reason", reason),
+		},
+	)
+}
+
+// Measures new address associations by mechanism
+// Metric Name:
+//
+//	sei_evm_association_created
+func IncrAssociationCreated(mechanism string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "evm", "association", "created"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("mechanism", mechanism)},
+	)
+}
+
+// Measures address association lookups served by the per-block cache, by
+// direction and whether they hit
+// Metric Name:
+//
+//	sei_evm_association_cache_lookup
+func IncrAddressCacheLookup(direction string, hit bool) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "evm", "association", "cache", "lookup"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("direction", direction),
+			telemetry.NewLabel("hit", strconv.FormatBool(hit)),
+		},
+	)
+}
+
 // Measures the RPC request latency in milliseconds
 // Metric Name:
 //
@@ -140,6 +140,7 @@ func (qp QueryPlugin) HandleEVMQuery(ctx sdk.Context, queryData json.RawMessage)
 
 	defer func() {
 		metrics.IncrementErrorMetrics(string(queryType), err)
+		qp.evmHandler.RecordPointerQuery(queryType, err)
 	}()
 
 	switch queryType {
@@ -81,7 +81,7 @@ func (p *EVMPreprocessDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			metrics.IncrementAssociationError("associate_tx_insufficient_funds", evmtypes.NewAssociationMissingErr(seiAddr.String()))
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "account needs to have at least 1 wei to force association")
 		}
-		if err := associateHelper.AssociateAddresses(ctx, seiAddr, evmAddr, pubkey); err != nil {
+		if err := associateHelper.AssociateAddresses(ctx, seiAddr, evmAddr, pubkey, evmtypes.AssociationMechanism_ASSOCIATE_TX); err != nil {
 			return ctx, err
 		}
 
@@ -90,7 +90,7 @@ func (p *EVMPreprocessDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		// noop; for readability
 	} else {
 		// not associatedTx and not already associated
-		if err := associateHelper.AssociateAddresses(ctx, seiAddr, evmAddr, pubkey); err != nil {
+		if err := associateHelper.AssociateAddresses(ctx, seiAddr, evmAddr, pubkey, evmtypes.AssociationMechanism_EVM_TX_SIGNATURE); err != nil {
 			return ctx, err
 		}
 		if p.evmKeeper.EthReplayConfig.Enabled {
@@ -312,7 +312,11 @@ func (p *EVMAddressDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		ctx.EventManager().EmitEvent(sdk.NewEvent(evmtypes.EventTypeSigner,
 			sdk.NewAttribute(evmtypes.AttributeKeyEvmAddress, evmAddr.Hex()),
 			sdk.NewAttribute(evmtypes.AttributeKeySeiAddress, signer.String())))
-		p.evmKeeper.SetAddressMapping(ctx, signer, evmAddr)
+		mechanism := evmtypes.AssociationMechanism_COSMOS_TX_SIGNER
+		if evmtypes.IsTxMsgAssociate(tx) {
+			mechanism = evmtypes.AssociationMechanism_ASSOCIATE_TX
+		}
+		p.evmKeeper.SetAddressMappingWithMechanism(ctx, signer, evmAddr, mechanism)
 		associationHelper := helpers.NewAssociationHelper(p.evmKeeper, p.evmKeeper.BankKeeper(), p.accountKeeper)
 		if err := associationHelper.MigrateBalance(ctx, evmAddr, signer); err != nil {
 			ctx.Logger().Error(fmt.Sprintf("failed to migrate EVM address balance (%s) %s", evmAddr.Hex(), err))
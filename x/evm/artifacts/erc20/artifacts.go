@@ -2,7 +2,10 @@ package erc20
 
 import "embed"
 
-const CurrentVersion uint16 = 2
+// CurrentVersion is the version of the CW20 -> ERC20 pointer. Version 3 has
+// the code of version 2, and its calls into the ERC20 are checked by the EVM
+// module the way SafeERC20 checks them.
+const CurrentVersion uint16 = 3
 
 //go:embed cwerc20.wasm
 var f embed.FS
@@ -10,7 +10,10 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
 
-const CurrentVersion uint16 = 1
+// CurrentVersion is 2 since pointers take their decimals from the bank
+// metadata of their denom when deployed, which existing pointers opt into by
+// being upgraded.
+const CurrentVersion uint16 = 2
 
 //go:embed NativeSeiTokensERC20.abi
 //go:embed NativeSeiTokensERC20.bin
@@ -2,12 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/sei-protocol/sei-chain/evmrpc"
 	"github.com/sei-protocol/sei-chain/precompiles"
 	"github.com/sei-protocol/sei-chain/precompiles/pointer"
@@ -16,7 +19,8 @@ import (
 )
 
 const (
-	FlagCwAddress = "cw-address"
+	FlagCwAddress       = "cw-address"
+	FlagContinueOnError = "continue-on-error"
 )
 
 func NativeSendTxCmd() *cobra.Command {
@@ -85,6 +89,77 @@ func RegisterCwPointerCmd() *cobra.Command {
 	return cmd
 }
 
+func RegisterCwPointersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cw-pointers [pointer type:erc address]...",
+		Short: `Register CosmWasm pointers for several ERC20/721/1155 contracts in one transaction, e.g. ERC721:0x... ERC20:0x.... Unless --continue-on-error is set, the transaction fails if any entry fails.`,
+		Args:  cobra.RangeArgs(1, types.MaxPointersPerRegistration),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries := make([]*types.PointerRegistrationRequest, 0, len(args))
+			for _, arg := range args {
+				parts := strings.SplitN(arg, ":", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid entry %s, expected [pointer type]:[erc address]", arg)
+				}
+				pointerType, ok := types.PointerType_value[parts[0]]
+				if !ok {
+					return fmt.Errorf("invalid pointer type: %s", parts[0])
+				}
+				entries = append(entries, &types.PointerRegistrationRequest{PointerType: types.PointerType(pointerType), Pointee: parts[1]})
+			}
+			continueOnError, err := cmd.Flags().GetBool(FlagContinueOnError)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRegisterPointers(clientCtx.GetFromAddress(), entries, continueOnError)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagContinueOnError, false, "skip and report failing entries instead of failing the transaction")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func UpgradePointerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-pointer [pointer type] [pointee]",
+		Short: `Upgrade the pointer of a pointee to the current artifact version in place. Only the creator of the pointer may upgrade it.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pointerType, ok := types.PointerType_value[args[0]]
+			if !ok {
+				return fmt.Errorf("invalid pointer type: %s", args[0])
+			}
+			msg := types.NewMsgUpgradePointer(clientCtx.GetFromAddress(), types.PointerType(pointerType), args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func RegisterEvmPointerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-evm-pointer [pointer type] [cw-address] --gas-fee-cap=<cap> --gas-limit=<limit> --evm-rpc=<url>",
@@ -204,3 +279,121 @@ func NativeAssociateCmd() *cobra.Command {
 
 	return cmd
 }
+
+func AssociateWithSignatureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "associate-with-signature [evm address] [nonce] [signature hex]",
+		Short: `Submit an EIP-712 association signature of an EVM address, associating it with the Sei address of its public key.`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			association, err := parseSignedAssociation(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAssociateWithSignature(clientCtx.GetFromAddress(), common.HexToAddress(association.EvmAddress), association.Nonce, association.Signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func AssociateWithSignaturesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "associate-with-signatures [evm address:nonce:signature hex]...",
+		Short: `Submit several EIP-712 association signatures in one transaction, paying the fees on behalf of the signers. Each association succeeds or fails independently.`,
+		Args:  cobra.RangeArgs(1, types.MaxAssociationsPerBatch),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			associations := make([]*types.SignedAssociation, 0, len(args))
+			for _, arg := range args {
+				parts := strings.SplitN(arg, ":", 3)
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid association %s, expected [evm address]:[nonce]:[signature hex]", arg)
+				}
+				association, err := parseSignedAssociation(parts[0], parts[1], parts[2])
+				if err != nil {
+					return err
+				}
+				associations = append(associations, association)
+			}
+			msg := types.NewMsgAssociateWithSignatures(clientCtx.GetFromAddress(), associations)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseSignedAssociation(evmAddress string, nonceStr string, sigHex string) (*types.SignedAssociation, error) {
+	if !common.IsHexAddress(evmAddress) {
+		return nil, fmt.Errorf("%s is not a valid EVM address", evmAddress)
+	}
+	nonce, err := strconv.ParseUint(nonceStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hexutil.Decode(sigHex)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignedAssociation{EvmAddress: evmAddress, Nonce: nonce, Signature: sig}, nil
+}
+
+func AssociatePubkeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "associate-pubkey [optional compressed pubkey hex]",
+		Short: `Associate the sender with the EVM address of its secp256k1 public key, which defaults to the key of --from.`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pubkey []byte
+			if len(args) == 1 {
+				if pubkey, err = hexutil.Decode(args[0]); err != nil {
+					return err
+				}
+			} else {
+				info, err := clientCtx.Keyring.Key(clientCtx.GetFromName())
+				if err != nil {
+					return err
+				}
+				pubkey = info.GetPubKey().Bytes()
+			}
+			msg := types.NewMsgAssociatePubkey(clientCtx.GetFromAddress(), pubkey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,12 +10,14 @@ import (
 
 	"math/big"
 	"os"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/lib/ethapi"
 
@@ -47,6 +49,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdQuerySeiAddress())
 	cmd.AddCommand(CmdQueryEVMAddress())
+	cmd.AddCommand(CmdQuerySeiAddresses())
+	cmd.AddCommand(CmdQueryEVMAddresses())
+	cmd.AddCommand(CmdQueryAssociations())
 	cmd.AddCommand(CmdQueryERC20Payload())
 	cmd.AddCommand(CmdQueryERC721Payload())
 	cmd.AddCommand(CmdQueryERC1155Payload())
@@ -54,8 +59,50 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryPayload())
 	cmd.AddCommand(CmdQueryPointer())
 	cmd.AddCommand(CmdQueryPointerVersion())
+	cmd.AddCommand(CmdQueryPointerVersions())
+	cmd.AddCommand(CmdQueryPointers())
+	cmd.AddCommand(CmdQueryPointees())
 	cmd.AddCommand(CmdQueryPointee())
+	cmd.AddCommand(CmdQueryPointerDisplayMetadata())
+	cmd.AddCommand(CmdQueryPointerMetadata())
+	cmd.AddCommand(CmdQueryContractTxParticipants())
+	cmd.AddCommand(CmdQueryChainStats())
+	cmd.AddCommand(CmdQueryPointerStats())
+	cmd.AddCommand(CmdQuerySmartResolve())
+	cmd.AddCommand(CmdQueryResolve())
+	cmd.AddCommand(CmdQueryIsPointer())
+	cmd.AddCommand(CmdQueryClassifyAsset())
+	cmd.AddCommand(CmdQueryPointerInfo())
+	cmd.AddCommand(CmdQueryAllowance())
+	cmd.AddCommand(CmdQueryNFTInfo())
+	cmd.AddCommand(CmdQueryBalance1155Batch())
 	cmd.AddCommand(CmdQueryTxByHash())
+	cmd.AddCommand(CmdQueryCode())
+	cmd.AddCommand(CmdQueryStorage())
+	cmd.AddCommand(CmdQueryNonce())
+	cmd.AddCommand(CmdQueryBalance())
+	cmd.AddCommand(CmdQueryAccount())
+	cmd.AddCommand(CmdQueryReceipt())
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryGasPrice())
+	cmd.AddCommand(CmdQueryPointerCodeIDs())
+	cmd.AddCommand(CmdQueryPointersByCodeID())
+	cmd.AddCommand(CmdQueryPointerArtifact())
+	cmd.AddCommand(CmdQueryEstimateRegisterPointer())
+	cmd.AddCommand(CmdQueryContractInfo())
+	cmd.AddCommand(CmdQueryPendingNonce())
+	cmd.AddCommand(CmdQueryLogs())
+	cmd.AddCommand(CmdQueryNativePointerMetadata())
+	cmd.AddCommand(CmdQueryAssociationStats())
+	cmd.AddCommand(CmdQueryEVMAddressByPubkey())
+	cmd.AddCommand(CmdQueryAssociationPreflight())
+	cmd.AddCommand(CmdQueryNodeQueryConfig())
+	cmd.AddCommand(CmdQueryModuleAddress())
+	cmd.AddCommand(CmdQueryPointersSince())
+	cmd.AddCommand(CmdQueryNativePointerSupply())
+	cmd.AddCommand(CmdQueryPointerRegistrationAllowlist())
+	cmd.AddCommand(CmdQueryPredictPointerAddress())
+	cmd.AddCommand(CmdQueryVerifyPointer())
 
 	return cmd
 }
@@ -113,6 +160,92 @@ func CmdQueryEVMAddress() *cobra.Command {
 	return cmd
 }
 
+func CmdQuerySeiAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sei-addrs [evm addresses...]",
+		Short: "gets sei addresses (sei...) for a batch of EVM addresses (0x...), preserving input order",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SeiAddressesByEVMAddresses(context.Background(), &types.QuerySeiAddressesByEVMAddressesRequest{EvmAddresses: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEVMAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-addrs [sei addresses...]",
+		Short: "gets evm addresses (0x...) for a batch of Sei addresses (sei...), preserving input order",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EVMAddressesBySeiAddresses(context.Background(), &types.QueryEVMAddressesBySeiAddressesRequest{SeiAddresses: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAssociations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "associations [evm addresses...]",
+		Short: "list all EVM (0x...) to Sei (sei...) address associations, or only those of the given EVM addresses",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Associations(cmd.Context(), &types.QueryAssociationsRequest{EvmAddresses: args, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "associations")
+
+	return cmd
+}
+
 func CmdQueryERC20() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "erc20 [addr] [method] [arguments...]",
@@ -152,6 +285,12 @@ func CmdQueryERC20() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if res.RevertError != nil {
+				if res.RevertError.Reason != "" {
+					return fmt.Errorf("execution reverted: %s", res.RevertError.Reason)
+				}
+				return fmt.Errorf("execution reverted (revert data %s)", hexutil.Encode(res.RevertError.Data))
+			}
 			fields, err := abi.Unpack(args[1], res.Data)
 			if err != nil {
 				return err
@@ -383,6 +522,11 @@ func CmdQueryERC1155Payload() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagIncludeContractInfo = "include-contract-info"
+	FlagAllTypes            = "all-types"
+)
+
 func CmdQueryPointer() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pointer [type] [pointee]",
@@ -396,8 +540,89 @@ func CmdQueryPointer() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 			ctx := cmd.Context()
 
+			includeContractInfo, err := cmd.Flags().GetBool(FlagIncludeContractInfo)
+			if err != nil {
+				return err
+			}
+			allTypes, err := cmd.Flags().GetBool(FlagAllTypes)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Pointer(ctx, &types.QueryPointerRequest{
-				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1], IncludeContractInfo: includeContractInfo,
+				AllTypes: allTypes,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagIncludeContractInfo, false, "also return the contract info of the pointee and the pointer")
+	cmd.Flags().Bool(FlagAllTypes, false, "return the pointers of every type registered for the pointee, ignoring the type")
+
+	return cmd
+}
+
+func CmdQueryPointers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointers [type]",
+		Short: "list all pointers of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155])",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pointers(ctx, &types.QueryPointersRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pointers")
+
+	return cmd
+}
+
+func CmdQueryPointees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointees [type]",
+		Short: "list all pointers of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155]) by pointer address, with their pointees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pointees(ctx, &types.QueryPointeesRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pagination: pageReq,
 			})
 			if err != nil {
 				return err
@@ -408,6 +633,7 @@ func CmdQueryPointer() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pointees")
 
 	return cmd
 }
@@ -441,21 +667,75 @@ func CmdQueryPointerVersion() *cobra.Command {
 	return cmd
 }
 
-func CmdQueryPointee() *cobra.Command {
+func CmdQueryPointerVersions() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "pointee [type] [pointer]",
-		Short: "Get pointee address of the specified type (one of [NATIVE, CW20, CW721, ERC20, ERC721]) and pointer",
+		Use:   "pointer-versions",
+		Short: "Query for the current version and stored code ID (if applicable) of every pointer type, and the module consensus version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PointerVersions(cmd.Context(), &types.QueryPointerVersionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerRegistrationAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-registration-allowlist",
+		Short: "Query for the addresses allowed to register pointers; registration is permissionless if the list is empty",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PointerRegistrationAllowlist(cmd.Context(), &types.QueryPointerRegistrationAllowlistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPredictPointerAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict-pointer-address [type] [pointee]",
+		Short: "Query for the address a pointer of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155]) and pointee would be deployed at if registered now",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			ctx := cmd.Context()
 
-			res, err := queryClient.Pointee(ctx, &types.QueryPointeeRequest{
-				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointer: args[1],
+			res, err := queryClient.PredictPointerAddress(cmd.Context(), &types.QueryPredictPointerAddressRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
 			})
 			if err != nil {
 				return err
@@ -470,35 +750,1153 @@ func CmdQueryPointee() *cobra.Command {
 	return cmd
 }
 
-func CmdQueryTxByHash() *cobra.Command {
+func CmdQueryVerifyPointer() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tx [hash]",
-		Short: "Query for a transaction by tx hash, same as eth_getTransactionByHash",
-		Args:  cobra.ExactArgs(1),
+		Use:   "verify-pointer [type] [pointee]",
+		Short: "Query whether the code of the registered pointer of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155]) and pointee matches the artifact of its version",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			hash := common.HexToHash(args[0])
-			rpc, err := cmd.Flags().GetString(FlagRPC)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			ethClient, err := ethclient.Dial(rpc)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyPointer(cmd.Context(), &types.QueryVerifyPointerRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
+			})
 			if err != nil {
 				return err
 			}
-			var response *ethapi.RPCTransaction
-			err = ethClient.Client().CallContext(context.Background(), &response, "eth_getTransactionByHash", hash)
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerCodeIDs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-code-ids [type]",
+		Short: "Query for the stored code ID of every version of the CW pointer code of the specified type (one of [ERC20, ERC721, ERC1155])",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
-			} else if response == nil {
-				return ethereum.NotFound
 			}
-			result, err := json.MarshalIndent(response, "", "  ")
-			fmt.Printf("%s\n", result)
-			return err
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PointerCodeIDs(cmd.Context(), &types.QueryPointerCodeIDsRequest{PointerType: types.PointerType(types.PointerType_value[args[0]])})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
-	cmd.Flags().String(FlagRPC, fmt.Sprintf("http://%s:8545", evmrpc.LocalAddress), "RPC endpoint to send request to")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointersByCodeID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointers-by-code-id [code-id]",
+		Short: "list the registered pointers instantiated from a CW code ID, if it is a stored pointer code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PointersByCodeID(ctx, &types.QueryPointersByCodeIDRequest{CodeId: codeID, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pointers-by-code-id")
+
+	return cmd
+}
+
+func CmdQueryPointerArtifact() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-artifact [type] [version] [address]",
+		Short: "get the pointer contract this binary deploys for the specified type and version, optionally checking a deployed pointer against it",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			version, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryPointerArtifactRequest{PointerType: types.PointerType(types.PointerType_value[args[0]]), Version: uint32(version)}
+			if len(args) == 3 {
+				req.Address = args[2]
+			}
+
+			res, err := queryClient.PointerArtifact(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEstimateRegisterPointer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-register-pointer [type] [erc address] [sender]",
+		Short: "estimate the gas and fee of registering a CW pointer of the specified type (one of [ERC20, ERC721, ERC1155]) to an ERC contract; pointers to CW contracts are not supported",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateRegisterPointer(cmd.Context(), &types.QueryEstimateRegisterPointerRequest{
+				Sender: args[2], PointerType: types.PointerType(types.PointerType_value[args[0]]), ErcAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointee [type] [pointer]",
+		Short: "Get pointee address of the specified type (one of [NATIVE, CW20, CW721, ERC20, ERC721]) and pointer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.Pointee(ctx, &types.QueryPointeeRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointer: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerDisplayMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-display-metadata [type] [pointee]",
+		Short: "Get display metadata of the pointer token of the specified type (one of [NATIVE, CW20, ERC20]) and pointee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.PointerDisplayMetadata(ctx, &types.QueryPointerDisplayMetadataRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-metadata [type] [pointee]",
+		Short: "get the name, symbol, decimals and total supply reported by the pointer of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721]) and pointee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.PointerMetadata(ctx, &types.QueryPointerMetadataRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryContractTxParticipants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-tx-participants [address] [from height] [to height]",
+		Short: "Get the distinct addresses that took part in transactions with the specified contract within a block range",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractTxParticipants(ctx, &types.QueryContractTxParticipantsRequest{
+				Address: args[0], FromHeight: fromHeight, ToHeight: toHeight, Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-tx-participants")
+
+	return cmd
+}
+
+func CmdQueryChainStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-stats",
+		Short: "Get aggregate counts of EVM transactions, deployed contracts, associations and pointers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChainStats(cmd.Context(), &types.QueryChainStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-stats",
+		Short: "Get the number of registered pointers of each type and the number of associations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PointerStats(cmd.Context(), &types.QueryPointerStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySmartResolve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart-resolve [input]",
+		Short: "Resolve pointers or pointees for a denom, hex address or bech32 address without specifying its pointer type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SmartResolve(cmd.Context(), &types.QuerySmartResolveRequest{Input: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryResolve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [input] [type (optional)]",
+		Short: "Resolve the counterpart of a pointer or pointee, optionally of the given type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155])",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryResolveRequest{Input: args[0]}
+			if len(args) > 1 {
+				req.PointerTypeHint = args[1]
+			}
+			res, err := queryClient.Resolve(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryClassifyAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classify-asset [denom or address]",
+		Short: "tell what kind of asset a denom or address is and which pointer type would point to it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassifyAsset(cmd.Context(), &types.QueryClassifyAssetRequest{Input: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIsPointer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-pointer [address]",
+		Short: "Check whether a hex or bech32 address is a pointer contract, and to which pointee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsPointer(cmd.Context(), &types.QueryIsPointerRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-info [type] [pointee]",
+		Short: "Query for the pointer of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155]) and pointee, and how it was registered",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PointerInfo(cmd.Context(), &types.QueryPointerInfoRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance [type] [pointee] [owner] [spender]",
+		Short: "Query for the allowance of a token of the specified type (one of [NATIVE, CW20, ERC20]) through its pointer; owner and spender may be hex or bech32",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Allowance(cmd.Context(), &types.QueryAllowanceRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1], Owner: args[2], Spender: args[3],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const FlagFullTokenURI = "full-token-uri"
+
+func CmdQueryNFTInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-info [type] [pointee] [token-id]",
+		Short: "Query for the owner, token URI and approved address of an NFT of the specified type (one of [CW721, ERC721]) through its pointer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fullTokenURI, err := cmd.Flags().GetBool(FlagFullTokenURI)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.NFTInfo(cmd.Context(), &types.QueryNFTInfoRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1], TokenId: args[2],
+				FullTokenUri: fullTokenURI,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagFullTokenURI, false, "return the token URI in full instead of cut to the maximum length")
+
+	return cmd
+}
+
+func CmdQueryBalance1155Batch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-1155-batch [address] [owners] [token-ids]",
+		Short: "Query for the balances of a CW1155 or ERC1155 token, or its pointer, for comma-separated lists of owners and token ids of the same length",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Balance1155Batch(cmd.Context(), &types.QueryBalance1155BatchRequest{
+				Address: args[0], Owners: strings.Split(args[1], ","), TokenIds: strings.Split(args[2], ","),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTxByHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx [hash]",
+		Short: "Query for a transaction by tx hash, same as eth_getTransactionByHash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hash := common.HexToHash(args[0])
+			rpc, err := cmd.Flags().GetString(FlagRPC)
+			if err != nil {
+				return err
+			}
+			ethClient, err := ethclient.Dial(rpc)
+			if err != nil {
+				return err
+			}
+			var response *ethapi.RPCTransaction
+			err = ethClient.Client().CallContext(context.Background(), &response, "eth_getTransactionByHash", hash)
+			if err != nil {
+				return err
+			} else if response == nil {
+				return ethereum.NotFound
+			}
+			result, err := json.MarshalIndent(response, "", "  ")
+			fmt.Printf("%s\n", result)
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagRPC, fmt.Sprintf("http://%s:8545", evmrpc.LocalAddress), "RPC endpoint to send request to")
+
+	return cmd
+}
+
+func CmdQueryCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code [address]",
+		Short: "get the bytecode deployed at an EVM address (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Code(cmd.Context(), &types.QueryCodeRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage [address] [slot]",
+		Short: "get the value of a storage slot (0x...) of an EVM address (0x...)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Storage(cmd.Context(), &types.QueryStorageRequest{Address: args[0], Slot: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce [address]",
+		Short: "get the EVM nonce of an EVM address (0x...) or Sei address (sei...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Nonce(cmd.Context(), &types.QueryNonceRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance [address]",
+		Short: "get the wei balance of an EVM address (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Balance(cmd.Context(), &types.QueryBalanceRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account [address]",
+		Short: "get the balance, nonce, code hash and association status of an EVM address (0x...) or Sei address (sei...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipt [hash]",
+		Short: "get the stored receipt of a transaction by its EVM tx hash (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Receipt(cmd.Context(), &types.QueryReceiptRequest{TxHash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "get the module params and stored pointer code IDs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price",
+		Short: "get the current base fee, minimum fee and a suggested priority fee, in wei per gas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasPrice(cmd.Context(), &types.QueryGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryContractInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-info [address]",
+		Short: "get the deployer and creation transaction of an EVM contract (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractInfo(cmd.Context(), &types.QueryContractInfoRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPendingNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-nonce [address]",
+		Short: "get the committed and pending nonce of a hex EVM address (0x...) or bech32 Sei address (sei...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingNonce(cmd.Context(), &types.QueryPendingNonceRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	FlagLogAddresses = "addresses"
+	FlagLogTopics    = "topic"
+)
+
+func CmdQueryLogs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [from height] [to height]",
+		Short: "Get the EVM logs emitted within a block range, optionally filtered by emitting contracts and topics",
+		Long: "Get the EVM logs emitted within a block range. --topic can be repeated once per topic position and takes " +
+			"a comma-separated list of topics any of which may match at that position; an empty value matches any topic.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			addresses, err := cmd.Flags().GetStringSlice(FlagLogAddresses)
+			if err != nil {
+				return err
+			}
+			topicArgs, err := cmd.Flags().GetStringArray(FlagLogTopics)
+			if err != nil {
+				return err
+			}
+			topics := make([]*types.TopicFilter, 0, len(topicArgs))
+			for _, arg := range topicArgs {
+				filter := &types.TopicFilter{}
+				if arg != "" {
+					filter.Topics = strings.Split(arg, ",")
+				}
+				topics = append(topics, filter)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Logs(cmd.Context(), &types.QueryLogsRequest{
+				FromHeight: fromHeight, ToHeight: toHeight, Addresses: addresses, Topics: topics, Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagLogAddresses, nil, "comma-separated hex addresses of the emitting contracts")
+	cmd.Flags().StringArray(FlagLogTopics, nil, "comma-separated topics to match at the next topic position")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "logs")
+
+	return cmd
+}
+
+func CmdQueryNativePointerMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "native-pointer-metadata [denom or pointer]",
+		Short: "get the native ERC20 pointer of a bank denom and the denom's metadata, by denom or pointer address (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NativePointerMetadata(cmd.Context(), &types.QueryNativePointerMetadataRequest{DenomOrPointer: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAssociationStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "association-stats [blocks]",
+		Short: "Get the number of address associations and how many were added in the last blocks (at most 1000, the default)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAssociationStatsRequest{}
+			if len(args) > 0 {
+				if req.Blocks, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+			res, err := queryClient.AssociationStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEVMAddressByPubkey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-address-by-pubkey [pubkey]",
+		Short: "derive the EVM and Sei addresses of a compressed secp256k1 public key (hex or base64)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EVMAddressByPubkey(cmd.Context(), &types.QueryEVMAddressByPubkeyRequest{Pubkey: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAssociationPreflight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "association-preflight [sei address] [evm address]",
+		Short: "check whether a Sei address (sei...) and an EVM address (0x...) can be associated with each other",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AssociationPreflight(cmd.Context(), &types.QueryAssociationPreflightRequest{SeiAddress: args[0], EvmAddress: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryNodeQueryConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-query-config",
+		Short: "Get the query limits of the queried node, which may differ between nodes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NodeQueryConfig(cmd.Context(), &types.QueryNodeQueryConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryModuleAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-address",
+		Short: "Get the Sei and EVM addresses of the EVM module account and of the fee collector as seen by the EVM",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAddress(cmd.Context(), &types.QueryModuleAddressRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointersSince() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointers-since [height]",
+		Short: "list pointer registrations made at or after the given height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PointersSince(cmd.Context(), &types.QueryPointersSinceRequest{StartHeight: height, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pointers-since")
+
+	return cmd
+}
+
+func CmdQueryNativePointerSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "native-pointer-supply [denom or pointer]",
+		Short: "get the bank supply of a denom with a native ERC20 pointer, scaled by the pointer's decimals, by denom or pointer address (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NativePointerSupply(cmd.Context(), &types.QueryNativePointerSupplyRequest{DenomOrPointer: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
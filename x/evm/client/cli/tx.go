@@ -64,10 +64,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCallPrecompile())
 	cmd.AddCommand(NativeSendTxCmd())
 	cmd.AddCommand(RegisterCwPointerCmd())
+	cmd.AddCommand(RegisterCwPointersCmd())
 	cmd.AddCommand(RegisterEvmPointerCmd())
+	cmd.AddCommand(UpgradePointerCmd())
 	cmd.AddCommand(NewAddERCNativePointerProposalTxCmd())
 	cmd.AddCommand(AssociateContractAddressCmd())
 	cmd.AddCommand(NativeAssociateCmd())
+	cmd.AddCommand(AssociateWithSignatureCmd())
+	cmd.AddCommand(AssociateWithSignaturesCmd())
+	cmd.AddCommand(AssociatePubkeyCmd())
 
 	return cmd
 }
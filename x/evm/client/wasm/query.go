@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw721"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
@@ -544,6 +545,8 @@ func (h *EVMQueryHandler) HandleERC721RoyaltyInfo(ctx sdk.Context, caller string
 		return nil, err
 	}
 
+	// no royalty receiver is reported as empty, and a receiver without an
+	// association as the Sei address cast from its EVM address
 	typedReceiver := typed[0].(common.Address)
 	receiver := ""
 	if (typedReceiver != common.Address{}) {
@@ -977,13 +980,18 @@ func (h *EVMQueryHandler) HandleSupportsInterface(ctx sdk.Context, caller string
 	if err != nil {
 		return nil, err
 	}
+	// per ERC165, a contract that reverts or doesn't return a bool doesn't
+	// support the interface
 	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		return json.Marshal(bindings.SupportsInterfaceResponse{Supported: false})
+	}
 	if err != nil {
 		return nil, err
 	}
 	typed, err := abi.Unpack("supportsInterface", res)
 	if err != nil {
-		return nil, err
+		return json.Marshal(bindings.SupportsInterfaceResponse{Supported: false})
 	}
 	return json.Marshal(bindings.SupportsInterfaceResponse{Supported: typed[0].(bool)})
 }
@@ -1004,3 +1012,45 @@ func (h *EVMQueryHandler) HandleGetSeiAddress(ctx sdk.Context, evmAddr string) (
 	response := bindings.GetSeiAddressResponse{SeiAddress: seiAddr.String(), Associated: associated}
 	return json.Marshal(response)
 }
+
+type pointerQueryOperation struct {
+	pointerType types.PointerType
+	operation   string
+}
+
+// pointerQueryOperations maps the queries CW pointers make of their ERC
+// pointee to the pointer operation they are part of.
+var pointerQueryOperations = map[bindings.EVMQueryType]pointerQueryOperation{
+	bindings.ERC20TransferType:              {types.PointerType_ERC20, types.PointerOpTransfer},
+	bindings.ERC20TransferFromType:          {types.PointerType_ERC20, types.PointerOpTransfer},
+	bindings.ERC20AllowanceType:             {types.PointerType_ERC20, types.PointerOpStaticCall},
+	bindings.ERC20TokenInfoType:             {types.PointerType_ERC20, types.PointerOpStaticCall},
+	bindings.ERC20BalanceType:               {types.PointerType_ERC20, types.PointerOpStaticCall},
+	bindings.ERC721TransferType:             {types.PointerType_ERC721, types.PointerOpTransfer},
+	bindings.ERC721OwnerType:                {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC721ApprovedType:             {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC721IsApprovedForAllType:     {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC721TotalSupplyType:          {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC721NameSymbolType:           {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC721UriType:                  {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC721RoyaltyInfoType:          {types.PointerType_ERC721, types.PointerOpStaticCall},
+	bindings.ERC1155TransferType:            {types.PointerType_ERC1155, types.PointerOpTransfer},
+	bindings.ERC1155BatchTransferType:       {types.PointerType_ERC1155, types.PointerOpTransfer},
+	bindings.ERC1155IsApprovedForAllType:    {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155BalanceOfType:           {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155BalanceOfBatchType:      {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155UriType:                 {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155TotalSupplyType:         {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155TotalSupplyForTokenType: {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155TokenExistsType:         {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155NameSymbolType:          {types.PointerType_ERC1155, types.PointerOpStaticCall},
+	bindings.ERC1155RoyaltyInfoType:         {types.PointerType_ERC1155, types.PointerOpStaticCall},
+}
+
+// RecordPointerQuery counts a query made by a CW pointer as a transfer or a
+// static call through the pointer. Other queries are not counted.
+func (h *EVMQueryHandler) RecordPointerQuery(queryType bindings.EVMQueryType, err error) {
+	if op, ok := pointerQueryOperations[queryType]; ok {
+		h.k.RecordPointerOperation(op.pointerType, op.operation, err)
+	}
+}
@@ -274,6 +274,35 @@ func TestHandleERC721RoyaltyInfo(t *testing.T) {
 	require.True(t, match)
 }
 
+func TestHandleSupportsInterface(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	privKey := testkeeper.MockPrivateKey()
+	res, _ := deployContract(t, ctx, k, "../../../../example/contracts/erc721/DummyERC721.bin", privKey)
+	addr1, e1 := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, addr1, e1)
+	receipt, err := k.GetReceipt(ctx, common.HexToHash(res.Hash))
+	require.Nil(t, err)
+	contractAddr := common.HexToAddress(receipt.ContractAddress)
+	h := wasm.NewEVMQueryHandler(k)
+	res2, err := h.HandleSupportsInterface(ctx, addr1.String(), "0x80ac58cd", contractAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, `{"supported":true}`, string(res2))
+
+	// a contract that reverts doesn't support the interface
+	_, revertingAddr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, revertingAddr, []byte{0x60, 0x00, 0x60, 0x00, 0xfd}) // PUSH1 0 PUSH1 0 REVERT
+	res2, err = h.HandleSupportsInterface(ctx, addr1.String(), "0x80ac58cd", revertingAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, `{"supported":false}`, string(res2))
+
+	// neither does an address without code
+	_, emptyAddr := testkeeper.MockAddressPair()
+	res2, err = h.HandleSupportsInterface(ctx, addr1.String(), "0x80ac58cd", emptyAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, `{"supported":false}`, string(res2))
+}
+
 // 1155
 func TestERC1155TransferPayload(t *testing.T) {
 	k := &testkeeper.EVMTestApp.EvmKeeper
@@ -14,7 +14,7 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	k.InitGenesis(ctx, genState)
 	k.SetParams(ctx, genState.Params)
 	for _, aa := range genState.AddressAssociations {
-		k.SetAddressMapping(ctx, sdk.MustAccAddressFromBech32(aa.SeiAddress), common.HexToAddress(aa.EthAddress))
+		k.ImportAddressAssociation(ctx, aa)
 	}
 	for _, code := range genState.Codes {
 		k.SetCode(ctx, common.HexToAddress(code.Address), code.Code)
@@ -25,6 +25,12 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, nonce := range genState.Nonces {
 		k.SetNonce(ctx, common.HexToAddress(nonce.Address), nonce.Nonce)
 	}
+	for _, registry := range genState.PointerRegistries {
+		k.ImportPointerRegistry(ctx, registry)
+	}
+	for _, codeIDs := range genState.PointerCodeIds {
+		k.ImportPointerCodeIDs(ctx, codeIDs)
+	}
 	for _, serialized := range genState.Serialized {
 		if len(serialized.Key) == 0 {
 			ctx.KVStore(k.GetStoreKey()).Set(serialized.Prefix, serialized.Value)
@@ -38,10 +44,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	k.IterateSeiAddressMapping(ctx, func(evmAddr common.Address, seiAddr sdk.AccAddress) bool {
-		genesis.AddressAssociations = append(genesis.AddressAssociations, &types.AddressAssociation{
-			SeiAddress: seiAddr.String(),
-			EthAddress: evmAddr.Hex(),
-		})
+		genesis.AddressAssociations = append(genesis.AddressAssociations, k.ExportAddressAssociation(ctx, seiAddr, evmAddr))
 		return false
 	})
 	k.IterateAllCode(ctx, func(addr common.Address, code []byte) bool {
@@ -66,13 +69,18 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		})
 		return false
 	})
+	genesis.PointerRegistries = k.ExportPointerRegistries(ctx)
+	genesis.PointerCodeIds = k.ExportPointerCodeIDs(ctx)
 	for _, prefix := range [][]byte{
 		types.ReceiptKeyPrefix,
 		types.BlockBloomPrefix,
 		types.TxHashesPrefix,
-		types.PointerRegistryPrefix,
-		types.PointerCWCodePrefix,
-		types.PointerReverseRegistryPrefix,
+		types.PointerCreationInfoPrefix,
+		types.ContractCreationInfoPrefix,
+		types.PointerTombstonePrefix,
+		types.IBCDenomPointerQueuePrefix,
+		types.PointerPausedPrefix,
+		types.AssociationNoncePrefix,
 	} {
 		k.IterateAll(ctx, prefix, func(key, val []byte) bool {
 			genesis.Serialized = append(genesis.Serialized, &types.Serialized{
@@ -100,10 +108,7 @@ func ExportGenesisStream(ctx sdk.Context, k *keeper.Keeper) <-chan *types.Genesi
 		k.IterateSeiAddressMapping(ctx, func(evmAddr common.Address, seiAddr sdk.AccAddress) bool {
 			var genesis types.GenesisState
 			genesis.Params = k.GetParams(ctx)
-			genesis.AddressAssociations = append(genesis.AddressAssociations, &types.AddressAssociation{
-				SeiAddress: seiAddr.String(),
-				EthAddress: evmAddr.Hex(),
-			})
+			genesis.AddressAssociations = append(genesis.AddressAssociations, k.ExportAddressAssociation(ctx, seiAddr, evmAddr))
 			ch <- &genesis
 			return false
 		})
@@ -142,13 +147,37 @@ func ExportGenesisStream(ctx sdk.Context, k *keeper.Keeper) <-chan *types.Genesi
 			return false
 		})
 
+		for _, registry := range k.ExportPointerRegistries(ctx) {
+			for start := 0; start < len(registry.Entries); start += GENESIS_EXPORT_STREAM_SERIALIZED_LEN_MAX {
+				end := start + GENESIS_EXPORT_STREAM_SERIALIZED_LEN_MAX
+				if end > len(registry.Entries) {
+					end = len(registry.Entries)
+				}
+				var genesis types.GenesisState
+				genesis.Params = k.GetParams(ctx)
+				genesis.PointerRegistries = append(genesis.PointerRegistries, &types.PointerRegistry{
+					PointerType: registry.PointerType,
+					Entries:     registry.Entries[start:end],
+				})
+				ch <- &genesis
+			}
+		}
+
+		genesis = types.DefaultGenesis()
+		genesis.Params = k.GetParams(ctx)
+		genesis.PointerCodeIds = k.ExportPointerCodeIDs(ctx)
+		ch <- genesis
+
 		for _, prefix := range [][]byte{
 			types.ReceiptKeyPrefix,
 			types.BlockBloomPrefix,
 			types.TxHashesPrefix,
-			types.PointerRegistryPrefix,
-			types.PointerCWCodePrefix,
-			types.PointerReverseRegistryPrefix,
+			types.PointerCreationInfoPrefix,
+			types.ContractCreationInfoPrefix,
+			types.PointerTombstonePrefix,
+			types.IBCDenomPointerQueuePrefix,
+			types.PointerPausedPrefix,
+			types.AssociationNoncePrefix,
 		} {
 			genesis := types.DefaultGenesis()
 			genesis.Params = k.GetParams(ctx)
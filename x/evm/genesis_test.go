@@ -2,11 +2,15 @@ package evm_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,14 +21,21 @@ func TestExportImportGenesis(t *testing.T) {
 	origctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
 	ctx := origctx.WithMultiStore(origctx.MultiStore().CacheMultiStore())
 	seiAddr, evmAddr := testkeeper.MockAddressPair()
-	keeper.SetAddressMapping(ctx, seiAddr, evmAddr)
-	_, codeAddr := testkeeper.MockAddressPair()
+	keeper.SetAddressMapping(ctx.WithBlockHeight(7), seiAddr, evmAddr)
+	cw20Addr, codeAddr := testkeeper.MockAddressPair()
 	keeper.SetCode(ctx, codeAddr, []byte("abcde"))
 	keeper.SetState(ctx, codeAddr, common.BytesToHash([]byte("123")), common.BytesToHash([]byte("456")))
 	keeper.SetNonce(ctx, evmAddr, 2)
 	keeper.MockReceipt(ctx, common.BytesToHash([]byte("789")), &types.Receipt{TxType: 2})
 	keeper.SetBlockBloom(ctx, []ethtypes.Bloom{{1}})
-	keeper.SetERC20CW20Pointer(ctx, "cw20addr", codeAddr)
+	keeper.SetERC20CW20Pointer(ctx, cw20Addr.String(), codeAddr)
+	_, erc20Addr := testkeeper.MockAddressPair()
+	keeper.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+	_, err := evmkeeper.NewMsgServerImpl(keeper).RegisterPointer(sdk.WrapSDKContext(ctx.WithBlockTime(time.Now())), &types.MsgRegisterPointer{
+		Sender: seiAddr.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex(),
+	})
+	require.Nil(t, err)
+	keeper.SetStoredPointerCodeID(ctx, types.PointerType_ERC721, 0, 42)
 	genesis := evm.ExportGenesis(ctx, keeper)
 	assert.NoError(t, genesis.Validate())
 	param := genesis.GetParams()
@@ -37,14 +48,129 @@ func TestExportImportGenesis(t *testing.T) {
 	assert.Equal(t, types.DefaultParams().MaxDynamicBaseFeeDownwardAdjustment, param.MaxDynamicBaseFeeDownwardAdjustment)
 	evm.InitGenesis(origctx, keeper, *genesis)
 	require.Equal(t, evmAddr, keeper.GetEVMAddressOrDefault(origctx, seiAddr))
+	require.Equal(t, int64(7), keeper.GetAssociationInfo(origctx, seiAddr).Height)
 	require.Equal(t, keeper.GetCode(ctx, codeAddr), keeper.GetCode(origctx, codeAddr))
 	require.Equal(t, keeper.GetCodeHash(ctx, codeAddr), keeper.GetCodeHash(origctx, codeAddr))
 	require.Equal(t, keeper.GetCodeSize(ctx, codeAddr), keeper.GetCodeSize(origctx, codeAddr))
 	require.Equal(t, keeper.GetState(ctx, codeAddr, common.BytesToHash([]byte("123"))), keeper.GetState(origctx, codeAddr, common.BytesToHash([]byte("123"))))
 	require.Equal(t, keeper.GetNonce(ctx, evmAddr), keeper.GetNonce(origctx, evmAddr))
-	_, err := keeper.GetReceipt(origctx, common.BytesToHash([]byte("789")))
+	_, err = keeper.GetReceipt(origctx, common.BytesToHash([]byte("789")))
 	require.Nil(t, err)
 	require.Equal(t, keeper.GetBlockBloom(ctx), keeper.GetBlockBloom(origctx))
-	_, _, exists := keeper.GetERC20CW20Pointer(origctx, "cw20addr")
+	_, _, exists := keeper.GetERC20CW20Pointer(origctx, cw20Addr.String())
 	require.True(t, exists)
+	pointerKey, _ := evmkeeper.PointerRegistryKey(types.PointerType_ERC20, erc20Addr.Hex())
+	creationInfo, found := keeper.GetPointerCreationInfo(origctx, pointerKey)
+	require.True(t, found)
+	require.Equal(t, seiAddr.String(), creationInfo.Creator)
+	codeIDs := map[uint16]uint64{}
+	keeper.IteratePointerCodeIDs(origctx, types.PointerType_ERC721, func(version uint16, codeID uint64) bool {
+		codeIDs[version] = codeID
+		return false
+	})
+	require.Equal(t, uint64(42), codeIDs[0])
+	require.Equal(t, keeper.GetStoredPointerCodeID(ctx, types.PointerType_ERC721), codeIDs[erc721.CurrentVersion])
+}
+
+func TestExportImportAddressAssociations(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	seiAddr1, evmAddr1 := testkeeper.MockAddressPair()
+	seiAddr2, evmAddr2 := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx.WithBlockHeight(3).WithTxSum([32]byte{1}), seiAddr1, evmAddr1)
+	k.SetAddressMapping(ctx.WithBlockHeight(0), seiAddr2, evmAddr2)
+	genesis := evm.ExportGenesis(ctx, k)
+	require.NoError(t, genesis.Validate())
+
+	importedKeeper, importedCtx := testkeeper.MockEVMKeeper()
+	evm.InitGenesis(importedCtx, importedKeeper, *genesis)
+	for _, evmAddr := range []common.Address{evmAddr1, evmAddr2} {
+		req := &types.QuerySeiAddressByEVMAddressRequest{EvmAddress: evmAddr.Hex()}
+		expected, err := evmkeeper.Querier{Keeper: k}.SeiAddressByEVMAddress(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		actual, err := evmkeeper.Querier{Keeper: importedKeeper}.SeiAddressByEVMAddress(sdk.WrapSDKContext(importedCtx), req)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+
+	// conflicting associations are rejected
+	_, otherEvmAddr := testkeeper.MockAddressPair()
+	conflicting := *genesis
+	conflicting.AddressAssociations = append(conflicting.AddressAssociations, &types.AddressAssociation{
+		SeiAddress: seiAddr1.String(),
+		EthAddress: otherEvmAddr.Hex(),
+	})
+	require.Error(t, conflicting.Validate())
+	require.Panics(t, func() { evm.InitGenesis(importedCtx, importedKeeper, conflicting) })
+
+	invalid := *genesis
+	invalid.AddressAssociations = []*types.AddressAssociation{{SeiAddress: seiAddr1.String(), EthAddress: "0x1234"}}
+	require.Error(t, invalid.Validate())
+}
+
+func TestExportImportPointerRegistry(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	cwPointer1, erc20Addr := testkeeper.MockAddressPair()
+	cwPointer2, erc721Addr := testkeeper.MockAddressPair()
+	cwPointer3, erc1155Addr := testkeeper.MockAddressPair()
+	cw20, nativePointer := testkeeper.MockAddressPair()
+	cw721, cw20Pointer := testkeeper.MockAddressPair()
+	cw1155, cw721Pointer := testkeeper.MockAddressPair()
+	_, cw1155Pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer1.String()))
+	require.Nil(t, k.SetCW721ERC721Pointer(ctx, erc721Addr, cwPointer2.String()))
+	require.Nil(t, k.SetCW1155ERC1155Pointer(ctx, erc1155Addr, cwPointer3.String()))
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+	require.Nil(t, k.SetERC20CW20PointerWithVersion(ctx, cw20.String(), cw20Pointer, 1))
+	require.Nil(t, k.SetERC20CW20PointerWithVersion(ctx, cw20.String(), cw20Pointer, 2))
+	require.Nil(t, k.SetERC721CW721Pointer(ctx, cw721.String(), cw721Pointer))
+	require.Nil(t, k.SetERC1155CW1155Pointer(ctx, cw1155.String(), cw1155Pointer))
+	k.SetStoredPointerCodeID(ctx, types.PointerType_ERC20, 0, 42)
+
+	genesis := evm.ExportGenesis(ctx, k)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.PointerRegistries, 7)
+	for _, serialized := range genesis.Serialized {
+		require.NotEqual(t, types.PointerRegistryPrefix, serialized.Prefix)
+		require.NotEqual(t, types.PointerReverseRegistryPrefix, serialized.Prefix)
+		require.NotEqual(t, types.PointerCWCodePrefix, serialized.Prefix)
+	}
+
+	importedKeeper, importedCtx := testkeeper.MockEVMKeeper()
+	evm.InitGenesis(importedCtx, importedKeeper, *genesis)
+	require.Equal(t, genesis.PointerRegistries, importedKeeper.ExportPointerRegistries(importedCtx))
+	require.Equal(t, genesis.PointerCodeIds, importedKeeper.ExportPointerCodeIDs(importedCtx))
+	for i := 0; i < len(types.PointerType_name); i++ {
+		require.Equal(t, k.GetPointerCount(ctx, types.PointerType(i)), importedKeeper.GetPointerCount(importedCtx, types.PointerType(i)))
+	}
+	// the reverse registry is rebuilt
+	pointee, version, exists := importedKeeper.GetERC20Pointee(importedCtx, cwPointer1.String())
+	require.True(t, exists)
+	require.Equal(t, erc20Addr, pointee)
+	_, expectedVersion, _ := k.GetERC20Pointee(ctx, cwPointer1.String())
+	require.Equal(t, expectedVersion, version)
+	cw20Pointee, version, exists := importedKeeper.GetCW20Pointee(importedCtx, cw20Pointer)
+	require.True(t, exists)
+	require.Equal(t, cw20.String(), cw20Pointee)
+	require.Equal(t, uint16(2), version)
+	token, _, exists := importedKeeper.GetNativePointee(importedCtx, nativePointer.Hex())
+	require.True(t, exists)
+	require.Equal(t, "ufoo", token)
+	entry, pointerType, exists := importedKeeper.LookupPointer(importedCtx, cw1155Pointer)
+	require.True(t, exists)
+	require.Equal(t, types.PointerType_CW1155, pointerType)
+	require.Equal(t, cw1155.String(), entry.Pointee)
+
+	// a pointer can't be registered for two pointees
+	inconsistent := *genesis
+	inconsistent.PointerRegistries = []*types.PointerRegistry{{
+		PointerType: types.PointerType_NATIVE,
+		Entries: []*types.PointerEntry{
+			{Pointee: "ufoo", Pointer: nativePointer.Hex(), Version: 1},
+			{Pointee: "ubar", Pointer: nativePointer.Hex(), Version: 1},
+		},
+	}}
+	require.Error(t, inconsistent.Validate())
+	inconsistent.PointerRegistries = nil
+	inconsistent.PointerCodeIds = []*types.PointerCodeIDs{{PointerType: types.PointerType_NATIVE, CodeIds: []*types.PointerCodeID{{Version: 1, CodeId: 1}}}}
+	require.Error(t, inconsistent.Validate())
 }
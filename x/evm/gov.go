@@ -6,6 +6,8 @@ import (
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
@@ -33,6 +35,11 @@ func logNativeV2Error(ctx sdk.Context, p *types.AddERCNativePointerProposalV2, s
 	ctx.Logger().Error(fmt.Sprintf("proposal (%s) encountered error during (%s) due to (%s)", id, step, err))
 }
 
+func HandleRemovePointerProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemovePointerProposal) error {
+	_, err := k.RemovePointerFromRegistry(ctx, authtypes.NewModuleAddress(govtypes.ModuleName).String(), p.PointerType, p.Pointee, p.AllowReregistration)
+	return err
+}
+
 func HandleAddERCNativePointerProposal(ctx sdk.Context, k *keeper.Keeper, p *types.AddERCNativePointerProposal) error {
 	return errors.New("proposal type deprecated")
 }
@@ -48,3 +48,27 @@ func TestAddERCNativePointerProposalsV2(t *testing.T) {
 	require.True(t, exists2)
 	require.NotEqual(t, pointer, pointer2)
 }
+
+func TestRemovePointerProposal(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	addProposal := &types.AddERCNativePointerProposalV2{Token: "removed", Name: "NAME", Symbol: "SYMBOL", Decimals: 6}
+	require.Nil(t, evm.HandleAddERCNativePointerProposalV2(ctx, k, addProposal))
+	pointer, _, _ := k.GetERC20NativePointer(ctx, "removed")
+	code := k.GetCode(ctx, pointer)
+
+	removeProposal := &types.RemovePointerProposal{PointerType: types.PointerType_NATIVE, Pointee: "removed"}
+	require.Nil(t, evm.HandleRemovePointerProposal(ctx, k, removeProposal))
+	_, _, exists := k.GetERC20NativePointer(ctx, "removed")
+	require.False(t, exists)
+	_, _, exists = k.LookupPointer(ctx, pointer)
+	require.False(t, exists)
+	require.Equal(t, code, k.GetCode(ctx, pointer))
+	require.NotNil(t, evm.HandleAddERCNativePointerProposalV2(ctx, k, addProposal))
+
+	removeProposal.AllowReregistration = true
+	require.Nil(t, evm.HandleRemovePointerProposal(ctx, k, removeProposal))
+	require.Nil(t, evm.HandleAddERCNativePointerProposalV2(ctx, k, addProposal))
+	_, _, exists = k.GetERC20NativePointer(ctx, "removed")
+	require.True(t, exists)
+}
@@ -30,6 +30,33 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		case *types.MsgAssociateContractAddress:
 			res, err := msgServer.AssociateContractAddress(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpgradePointer:
+			res, err := msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemovePointer:
+			res, err := msgServer.RemovePointer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterPointers:
+			res, err := msgServer.RegisterPointers(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOverridePointer:
+			res, err := msgServer.OverridePointer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPausePointer:
+			res, err := msgServer.PausePointer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnpausePointer:
+			res, err := msgServer.UnpausePointer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAssociateWithSignature:
+			res, err := msgServer.AssociateWithSignature(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAssociateWithSignatures:
+			res, err := msgServer.AssociateWithSignatures(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAssociatePubkey:
+			res, err := msgServer.AssociatePubkey(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -56,6 +83,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return HandleAddCWERC1155PointerProposal(ctx, &k, c)
 		case *types.AddERCNativePointerProposalV2:
 			return HandleAddERCNativePointerProposalV2(ctx, &k, c)
+		case *types.RemovePointerProposal:
+			return HandleRemovePointerProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized evm proposal content type: %T", c)
 		}
@@ -0,0 +1,44 @@
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+)
+
+type DenomTraceKeeper interface {
+	HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool
+}
+
+// IBCMiddleware wraps the transfer IBC module to queue an ERC20 native
+// pointer for every ibc/ denom the first time a transfer mints it.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper           *keeper.Keeper
+	denomTraceKeeper DenomTraceKeeper
+}
+
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper, denomTraceKeeper DenomTraceKeeper) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, keeper: k, denomTraceKeeper: denomTraceKeeper}
+}
+
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil ||
+		ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// not a voucher, or one being sent back to this chain
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	fullDenomPath := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	seen := im.denomTraceKeeper.HasDenomTrace(ctx, ibctransfertypes.ParseDenomTrace(fullDenomPath).Hash())
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if !seen && ack != nil && ack.Success() {
+		im.keeper.QueueIBCDenomPointer(ctx, fullDenomPath)
+	}
+	return ack
+}
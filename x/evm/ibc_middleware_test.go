@@ -0,0 +1,79 @@
+package evm_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTransferModule struct {
+	porttypes.IBCModule
+	ack ibcexported.Acknowledgement
+}
+
+func (m mockTransferModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return m.ack
+}
+
+type mockDenomTraceKeeper map[string]bool
+
+func (m mockDenomTraceKeeper) HasDenomTrace(_ sdk.Context, hash tmbytes.HexBytes) bool {
+	return m[hash.String()]
+}
+
+func TestIBCMiddlewareAutoCreatesPointers(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).CacheContext()
+	seenDenoms := mockDenomTraceKeeper{}
+	middleware := evm.NewIBCMiddleware(mockTransferModule{ack: channeltypes.NewResultAcknowledgement([]byte{1})}, k, seenDenoms)
+	packet := func(denom string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData(denom, "1", "sender", "receiver")
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-1", "transfer", "channel-0", clienttypes.ZeroHeight(), 0)
+	}
+	uatom := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	// disabled by default
+	middleware.OnRecvPacket(ctx, packet("uatom"), nil)
+	k.CreateQueuedIBCDenomPointers(ctx, keeper.DefaultIBCDenomPointersToCreate)
+	_, _, exists := k.GetERC20NativePointer(ctx, uatom)
+	require.False(t, exists)
+
+	params := k.GetParams(ctx)
+	params.AutoCreateIbcDenomPointers = true
+	k.SetParams(ctx, params)
+	// denoms that were seen before or are returning to this chain are skipped
+	seenDenoms[ibctransfertypes.ParseDenomTrace("transfer/channel-0/uosmo").Hash().String()] = true
+	middleware.OnRecvPacket(ctx, packet("uosmo"), nil)
+	middleware.OnRecvPacket(ctx, packet("transfer/channel-1/usei"), nil)
+	middleware.OnRecvPacket(ctx, packet("uatom"), nil)
+	k.CreateQueuedIBCDenomPointers(ctx, keeper.DefaultIBCDenomPointersToCreate)
+	_, _, exists = k.GetERC20NativePointer(ctx, ibctransfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom())
+	require.False(t, exists)
+	_, _, exists = k.GetERC20NativePointer(ctx, "usei")
+	require.False(t, exists)
+	_, _, exists = k.GetERC20NativePointer(ctx, uatom)
+	require.True(t, exists)
+	pointerKey, _ := keeper.PointerRegistryKey(types.PointerType_NATIVE, uatom)
+	info, ok := k.GetPointerCreationInfo(ctx, pointerKey)
+	require.True(t, ok)
+	require.True(t, info.AutoCreated)
+
+	// failed transfers don't queue anything
+	failing := evm.NewIBCMiddleware(mockTransferModule{ack: channeltypes.NewErrorAcknowledgement("failed")}, k, seenDenoms)
+	failing.OnRecvPacket(ctx, packet("ujuno"), nil)
+	k.CreateQueuedIBCDenomPointers(ctx, keeper.DefaultIBCDenomPointersToCreate)
+	_, _, exists = k.GetERC20NativePointer(ctx, ibctransfertypes.ParseDenomTrace("transfer/channel-0/ujuno").IBCDenom())
+	require.False(t, exists)
+}
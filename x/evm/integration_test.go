@@ -253,6 +253,34 @@ func TestCW721RoyaltiesPointerToERC721Royalties(t *testing.T) {
 	ret, err = testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, sdk.MustAccAddressFromBech32(res2.PointerAddress), query)
 	require.Nil(t, err)
 	require.Equal(t, fmt.Sprintf("{\"address\":\"%s\",\"royalty_amount\":\"1000\"}", seiAddr.String()), string(ret))
+
+	// an unassociated receiver is returned as its cast address
+	_, unassociated := testkeeper.MockAddressPair()
+	data, err = abi.Pack("setDefaultRoyalty", unassociated)
+	require.Nil(t, err)
+	txData = ethtypes.LegacyTx{
+		Nonce:    2,
+		GasPrice: big.NewInt(100000000000),
+		Gas:      300000,
+		To:       &to,
+		Data:     data,
+	}
+	tx, err = ethtypes.SignTx(ethtypes.NewTx(&txData), signer, key)
+	require.Nil(t, err)
+	typedTx, err = ethtx.NewLegacyTx(tx)
+	require.Nil(t, err)
+	msg, err = types.NewMsgEVMTransaction(typedTx)
+	require.Nil(t, err)
+	txBuilder = testkeeper.EVMTestApp.GetTxConfig().NewTxBuilder()
+	txBuilder.SetMsgs(msg)
+	cosmosTx = txBuilder.GetTx()
+	txbz, err = testkeeper.EVMTestApp.GetTxConfig().TxEncoder()(cosmosTx)
+	require.Nil(t, err)
+	res = testkeeper.EVMTestApp.DeliverTx(ctx, abci.RequestDeliverTx{Tx: txbz}, cosmosTx, sha256.Sum256(txbz))
+	require.Equal(t, uint32(0), res.Code)
+	ret, err = testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, sdk.MustAccAddressFromBech32(res2.PointerAddress), query)
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("{\"address\":\"%s\",\"royalty_amount\":\"1000\"}", sdk.AccAddress(unassociated[:]).String()), string(ret))
 }
 
 func TestERC1155RoyaltiesPointerToCW1155Royalties(t *testing.T) {
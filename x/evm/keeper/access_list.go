@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// CreateAccessList executes msg with gasCap gas against a discarded branch of
+// ctx and returns the accounts and storage slots it touches. The sender, the
+// recipient and precompiles are only listed for their storage slots. Since applying an access list can
+// change the execution path, msg is re-run with the list found so far until
+// the list stops changing. The result of the last run is returned alongside
+// the list, so a reverted call still yields the slots touched before it
+// reverted.
+func (k *Keeper) CreateAccessList(ctx sdk.Context, msg core.Message, gasCap uint64) (ethtypes.AccessList, *core.ExecutionResult, error) {
+	to := crypto.CreateAddress(msg.From, msg.Nonce)
+	if msg.To != nil {
+		to = *msg.To
+	}
+	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
+	rules := cfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix()))
+	precompiles := vm.ActivePrecompiles(rules)
+	prevTracer := logger.NewAccessListTracer(nil, msg.From, to, precompiles)
+	for {
+		accessList := prevTracer.AccessList()
+		tracer := logger.NewAccessListTracer(accessList, msg.From, to, precompiles)
+		msg.AccessList = accessList
+		msg.GasLimit = gasCap
+		res, err := k.simulateEVMMessage(ctx, msg, tracer.Hooks())
+		if err != nil {
+			return nil, nil, err
+		}
+		if tracer.Equal(prevTracer) {
+			return tracer.AccessList(), res, nil
+		}
+		prevTracer = tracer
+	}
+}
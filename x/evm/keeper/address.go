@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -8,7 +10,32 @@ import (
 )
 
 func (k *Keeper) SetAddressMapping(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) {
+	k.SetAddressMappingWithMechanism(ctx, seiAddress, evmAddress, types.AssociationMechanism_UNSPECIFIED_ASSOCIATION)
+}
+
+// SetAddressMappingWithMechanism associates seiAddress with evmAddress. If
+// seiAddress wasn't associated yet, it emits an EventAddressAssociated
+// carrying mechanism and runs the association hooks, except for associations
+// imported from genesis which have already been seen by the hooks of the
+// exporting chain.
+func (k *Keeper) SetAddressMappingWithMechanism(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address, mechanism types.AssociationMechanism) {
 	store := ctx.KVStore(k.storeKey)
+	isNew := !store.Has(types.SeiAddressToEVMAddressKey(seiAddress))
+	if !isNew || ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).KVStore(k.storeKey).Has(types.EVMAddressToSeiAddressKey(evmAddress)) {
+		k.markAssociationRewritten(ctx)
+	}
+	if isNew {
+		k.incrementChainStat(ctx, types.ChainStatsAssociationCountKey, 1)
+		k.recordAssociationAdded(ctx)
+		info := &types.AssociationInfo{Height: ctx.BlockHeight()}
+		if txSum := ctx.TxSum(); txSum != [32]byte{} {
+			info.TxHash = fmt.Sprintf("%X", txSum[:])
+		}
+		if info.Height != 0 || info.TxHash != "" {
+			k.SetAssociationInfo(ctx, seiAddress, info)
+		}
+	}
+	k.addressCache.invalidate(seiAddress, evmAddress)
 	store.Set(types.EVMAddressToSeiAddressKey(evmAddress), seiAddress)
 	store.Set(types.SeiAddressToEVMAddressKey(seiAddress), evmAddress[:])
 	if !k.accountKeeper.HasAccount(ctx, seiAddress) {
@@ -19,15 +46,101 @@ func (k *Keeper) SetAddressMapping(ctx sdk.Context, seiAddress sdk.AccAddress, e
 		sdk.NewAttribute(types.AttributeKeySeiAddress, seiAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyEvmAddress, evmAddress.Hex()),
 	))
+	if !isNew {
+		return
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddressAssociated{
+		SeiAddress: seiAddress.String(),
+		EvmAddress: evmAddress.Hex(),
+		Mechanism:  mechanism,
+		Height:     ctx.BlockHeight(),
+	}); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit association event for %s: %s", seiAddress.String(), err))
+	}
+	k.recordAssociationCreated(mechanism)
+	if mechanism != types.AssociationMechanism_GENESIS {
+		k.afterAddressAssociated(ctx, seiAddress, evmAddress)
+	}
 }
 
 func (k *Keeper) DeleteAddressMapping(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) {
 	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.SeiAddressToEVMAddressKey(seiAddress)) {
+		k.decrementChainStat(ctx, types.ChainStatsAssociationCountKey)
+	}
+	k.markAssociationRewritten(ctx)
+	k.addressCache.invalidate(seiAddress, evmAddress)
 	store.Delete(types.EVMAddressToSeiAddressKey(evmAddress))
 	store.Delete(types.SeiAddressToEVMAddressKey(seiAddress))
+	store.Delete(types.AssociationInfoKey(seiAddress))
+}
+
+// SetAssociationInfo records when seiAddress was associated. An empty info
+// clears the record.
+func (k *Keeper) SetAssociationInfo(ctx sdk.Context, seiAddress sdk.AccAddress, info *types.AssociationInfo) {
+	store := ctx.KVStore(k.storeKey)
+	if info.Height == 0 && info.TxHash == "" {
+		store.Delete(types.AssociationInfoKey(seiAddress))
+		return
+	}
+	bz, err := info.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.AssociationInfoKey(seiAddress), bz)
+}
+
+// GetAssociationInfo returns when seiAddress was associated, or an empty info
+// if it was associated before association info was recorded.
+func (k *Keeper) GetAssociationInfo(ctx sdk.Context, seiAddress sdk.AccAddress) *types.AssociationInfo {
+	info := &types.AssociationInfo{}
+	bz := ctx.KVStore(k.storeKey).Get(types.AssociationInfoKey(seiAddress))
+	if bz == nil {
+		return info
+	}
+	if err := info.Unmarshal(bz); err != nil {
+		return &types.AssociationInfo{}
+	}
+	return info
+}
+
+// ImportAddressAssociation sets an association from genesis state, along with
+// its association info if any. It panics if either address is already
+// associated with a different address.
+func (k *Keeper) ImportAddressAssociation(ctx sdk.Context, association *types.AddressAssociation) {
+	seiAddress := sdk.MustAccAddressFromBech32(association.SeiAddress)
+	evmAddress := common.HexToAddress(association.EthAddress)
+	if existing, ok := k.GetEVMAddress(ctx, seiAddress); ok && existing != evmAddress {
+		panic(fmt.Sprintf("sei address %s is already associated with %s", association.SeiAddress, existing.Hex()))
+	}
+	if existing, ok := k.GetSeiAddress(ctx, evmAddress); ok && !existing.Equals(seiAddress) {
+		panic(fmt.Sprintf("evm address %s is already associated with %s", association.EthAddress, existing.String()))
+	}
+	k.SetAddressMappingWithMechanism(ctx, seiAddress, evmAddress, types.AssociationMechanism_GENESIS)
+	k.SetAssociationInfo(ctx, seiAddress, &types.AssociationInfo{Height: association.AssociatedAtHeight, TxHash: association.AssociatedInTx})
+}
+
+// ExportAddressAssociation returns the genesis state of an association.
+func (k *Keeper) ExportAddressAssociation(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) *types.AddressAssociation {
+	info := k.GetAssociationInfo(ctx, seiAddress)
+	return &types.AddressAssociation{
+		SeiAddress:         seiAddress.String(),
+		EthAddress:         evmAddress.Hex(),
+		AssociatedAtHeight: info.Height,
+		AssociatedInTx:     info.TxHash,
+	}
 }
 
 func (k *Keeper) GetEVMAddress(ctx sdk.Context, seiAddress sdk.AccAddress) (common.Address, bool) {
+	cached := k.addressCacheUsable(ctx)
+	if cached {
+		if addr, ok := k.addressCache.getEVMAddress(ctx.BlockHeight(), seiAddress); ok {
+			consumeCachedReadGas(ctx, types.SeiAddressToEVMAddressKey(seiAddress), addr[:])
+			k.recordAddressCacheLookup(types.AddressCacheDirectionSeiToEVM, true)
+			return addr, true
+		}
+		k.recordAddressCacheLookup(types.AddressCacheDirectionSeiToEVM, false)
+	}
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.SeiAddressToEVMAddressKey(seiAddress))
 	addr := common.Address{}
@@ -35,6 +148,9 @@ func (k *Keeper) GetEVMAddress(ctx sdk.Context, seiAddress sdk.AccAddress) (comm
 		return addr, false
 	}
 	copy(addr[:], bz)
+	if cached {
+		k.cacheAssociationIfSettled(ctx, seiAddress, addr)
+	}
 	return addr, true
 }
 
@@ -47,11 +163,23 @@ func (k *Keeper) GetEVMAddressOrDefault(ctx sdk.Context, seiAddress sdk.AccAddre
 }
 
 func (k *Keeper) GetSeiAddress(ctx sdk.Context, evmAddress common.Address) (sdk.AccAddress, bool) {
+	cached := k.addressCacheUsable(ctx)
+	if cached {
+		if addr, ok := k.addressCache.getSeiAddress(ctx.BlockHeight(), evmAddress); ok {
+			consumeCachedReadGas(ctx, types.EVMAddressToSeiAddressKey(evmAddress), addr)
+			k.recordAddressCacheLookup(types.AddressCacheDirectionEVMToSei, true)
+			return addr, true
+		}
+		k.recordAddressCacheLookup(types.AddressCacheDirectionEVMToSei, false)
+	}
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.EVMAddressToSeiAddressKey(evmAddress))
 	if bz == nil {
 		return []byte{}, false
 	}
+	if cached {
+		k.cacheAssociationIfSettled(ctx, bz, evmAddress)
+	}
 	return bz, true
 }
 
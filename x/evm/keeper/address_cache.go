@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// addressCache memoizes address associations for the block being executed.
+// It only ever holds associations made before that block. Those can still be
+// removed or replaced while the block executes, e.g. by a self-destruct, so
+// every such write records the block height in the transient store, which
+// every cached lookup reads. Under parallel execution, a transaction that read
+// from the cache therefore conflicts with one changing an association, and
+// the cache isn't used for the rest of the block. The cache is in memory only
+// and never affects state or gas.
+type addressCache struct {
+	mtx sync.RWMutex
+	// the height of the block being executed, if any; lookups at any other
+	// height, e.g. queries against committed state, read the store
+	height int64
+	// keyed by the Sei address bytes
+	evmAddrs map[string]common.Address
+	seiAddrs map[common.Address]sdk.AccAddress
+}
+
+func newAddressCache() *addressCache {
+	return &addressCache{
+		evmAddrs: map[string]common.Address{},
+		seiAddrs: map[common.Address]sdk.AccAddress{},
+	}
+}
+
+func (c *addressCache) open(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.height = height
+	c.evmAddrs = map[string]common.Address{}
+	c.seiAddrs = map[common.Address]sdk.AccAddress{}
+}
+
+func (c *addressCache) openHeight() int64 {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.height
+}
+
+func (c *addressCache) getEVMAddress(height int64, seiAddress sdk.AccAddress) (common.Address, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if height != c.height {
+		return common.Address{}, false
+	}
+	addr, ok := c.evmAddrs[string(seiAddress)]
+	return addr, ok
+}
+
+func (c *addressCache) getSeiAddress(height int64, evmAddress common.Address) (sdk.AccAddress, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if height != c.height {
+		return nil, false
+	}
+	addr, ok := c.seiAddrs[evmAddress]
+	if !ok {
+		return nil, false
+	}
+	return append(sdk.AccAddress{}, addr...), true
+}
+
+func (c *addressCache) set(height int64, seiAddress sdk.AccAddress, evmAddress common.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if height != c.height {
+		return
+	}
+	c.evmAddrs[string(seiAddress)] = evmAddress
+	c.seiAddrs[evmAddress] = append(sdk.AccAddress{}, seiAddress...)
+}
+
+// invalidate drops both directions of an association that is being written.
+func (c *addressCache) invalidate(seiAddress sdk.AccAddress, evmAddress common.Address) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if cached, ok := c.seiAddrs[evmAddress]; ok {
+		delete(c.evmAddrs, string(cached))
+	}
+	if cached, ok := c.evmAddrs[string(seiAddress)]; ok {
+		delete(c.seiAddrs, cached)
+	}
+	delete(c.evmAddrs, string(seiAddress))
+	delete(c.seiAddrs, evmAddress)
+}
+
+// OpenAddressCache starts caching association lookups made at the height of
+// the block that begins in ctx, dropping those of the previous block.
+func (k *Keeper) OpenAddressCache(ctx sdk.Context) {
+	if k.addressCache != nil {
+		k.addressCache.open(ctx.BlockHeight())
+	}
+}
+
+// CloseAddressCache stops caching association lookups once the block in ctx
+// has been executed, so that queries against the state it commits read the
+// store.
+func (k *Keeper) CloseAddressCache(sdk.Context) {
+	if k.addressCache != nil {
+		k.addressCache.open(0)
+	}
+}
+
+// addressCacheUsable returns whether association lookups in ctx may go
+// through the cache. CheckTx, contexts outside the block being executed and
+// blocks that have removed or replaced an association, as seen from ctx,
+// bypass it.
+func (k *Keeper) addressCacheUsable(ctx sdk.Context) bool {
+	if k.addressCache == nil || ctx.IsCheckTx() || ctx.IsReCheckTx() || ctx.BlockHeight() == 0 || ctx.BlockHeight() != k.addressCache.openHeight() {
+		return false
+	}
+	bz := ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).TransientStore(k.transientStoreKey).Get(types.AddressAssociationRewrittenKey)
+	return len(bz) != 8 || int64(binary.BigEndian.Uint64(bz)) != ctx.BlockHeight()
+}
+
+// markAssociationRewritten records that an association made before ctx may
+// have been removed or replaced in the block being executed, without charging
+// gas.
+func (k *Keeper) markAssociationRewritten(ctx sdk.Context) {
+	if k.addressCache == nil || ctx.BlockHeight() == 0 {
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).TransientStore(k.transientStoreKey).Set(types.AddressAssociationRewrittenKey, bz)
+}
+
+// cacheAssociationIfSettled caches the association of seiAddress and
+// evmAddress read from the store in ctx if it was made before the current
+// block. The association info is read without charging gas, so that lookups
+// cost the same whether they are cached or not.
+func (k *Keeper) cacheAssociationIfSettled(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) {
+	info := k.GetAssociationInfo(ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)), seiAddress)
+	if info.Height >= ctx.BlockHeight() {
+		return
+	}
+	k.addressCache.set(ctx.BlockHeight(), seiAddress, evmAddress)
+}
+
+// consumeCachedReadGas charges the gas a store read of key returning value
+// would have cost.
+func consumeCachedReadGas(ctx sdk.Context, key []byte, value []byte) {
+	gasConfig := storetypes.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(key)), storetypes.GasReadPerByteDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(value)), storetypes.GasReadPerByteDesc)
+}
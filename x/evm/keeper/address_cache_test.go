@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+func TestAddressCache(t *testing.T) {
+	k, ctx := keeper.MockEVMKeeper()
+	seiAddr, evmAddr := keeper.MockAddressPair()
+	k.SetAddressMapping(ctx.WithBlockHeight(5), seiAddr, evmAddr)
+	ctx = ctx.WithBlockHeight(10)
+	k.OpenAddressCache(ctx)
+
+	// lookups cost the same whether they are cached or not
+	gasUsed := func(lookup func(sdk.Context)) uint64 {
+		c := ctx.WithGasMeter(sdk.NewGasMeter(1000000, 1, 1))
+		lookup(c)
+		return c.GasMeter().GasConsumed()
+	}
+	getEVM := func(c sdk.Context) { k.GetEVMAddress(c, seiAddr) }
+	getSei := func(c sdk.Context) { k.GetSeiAddress(c, evmAddr) }
+	require.Equal(t, gasUsed(getEVM), gasUsed(getEVM))
+	require.Equal(t, gasUsed(getSei), gasUsed(getSei))
+
+	// both directions are served from the cache once either was looked up
+	store := ctx.KVStore(k.GetStoreKey())
+	store.Delete(types.SeiAddressToEVMAddressKey(seiAddr))
+	store.Delete(types.EVMAddressToSeiAddressKey(evmAddr))
+	foundEVM, ok := k.GetEVMAddress(ctx, seiAddr)
+	require.True(t, ok)
+	require.Equal(t, evmAddr, foundEVM)
+	foundSei, ok := k.GetSeiAddress(ctx, evmAddr)
+	require.True(t, ok)
+	require.Equal(t, seiAddr, foundSei)
+
+	// CheckTx and contexts outside the block being executed read the store
+	_, ok = k.GetEVMAddress(ctx.WithIsCheckTx(true), seiAddr)
+	require.False(t, ok)
+	_, ok = k.GetSeiAddress(ctx.WithBlockHeight(9), evmAddr)
+	require.False(t, ok)
+	_, ok = k.GetSeiAddress(ctx.WithBlockHeight(11), evmAddr)
+	require.False(t, ok)
+	// so do queries against the committed state once the block has ended
+	k.CloseAddressCache(ctx)
+	_, ok = k.GetSeiAddress(ctx, evmAddr)
+	require.False(t, ok)
+	// and entries of an earlier block are dropped
+	k.OpenAddressCache(ctx)
+	_, ok = k.GetSeiAddress(ctx, evmAddr)
+	require.False(t, ok)
+
+	// writing an association invalidates it
+	ctx = ctx.WithBlockHeight(12)
+	k.OpenAddressCache(ctx)
+	k.SetAddressMapping(ctx.WithBlockHeight(5), seiAddr, evmAddr)
+	otherSeiAddr, otherEvmAddr := keeper.MockAddressPair()
+	k.SetAddressMapping(ctx.WithBlockHeight(5), otherSeiAddr, otherEvmAddr)
+	_, ok = k.GetEVMAddress(ctx, seiAddr)
+	require.True(t, ok)
+	_, ok = k.GetEVMAddress(ctx, otherSeiAddr)
+	require.True(t, ok)
+	k.DeleteAddressMapping(ctx, seiAddr, evmAddr)
+	_, ok = k.GetEVMAddress(ctx, seiAddr)
+	require.False(t, ok)
+	_, ok = k.GetSeiAddress(ctx, evmAddr)
+	require.False(t, ok)
+	// and removing one stops caching for the rest of the block
+	store.Delete(types.SeiAddressToEVMAddressKey(otherSeiAddr))
+	_, ok = k.GetEVMAddress(ctx, otherSeiAddr)
+	require.False(t, ok)
+	k.SetAddressMapping(ctx.WithBlockHeight(5), otherSeiAddr, otherEvmAddr)
+
+	// so does replacing one, e.g. the association of an EVM address
+	ctx = ctx.WithBlockHeight(13)
+	k.OpenAddressCache(ctx)
+	k.SetAddressMapping(ctx.WithBlockHeight(5), seiAddr, evmAddr)
+	_, ok = k.GetSeiAddress(ctx, evmAddr)
+	require.True(t, ok)
+	replacingSeiAddr, _ := keeper.MockAddressPair()
+	k.SetAddressMapping(ctx, replacingSeiAddr, otherEvmAddr)
+	found, ok := k.GetSeiAddress(ctx, otherEvmAddr)
+	require.True(t, ok)
+	require.Equal(t, replacingSeiAddr, found)
+	store.Delete(types.EVMAddressToSeiAddressKey(evmAddr))
+	_, ok = k.GetSeiAddress(ctx, evmAddr)
+	require.False(t, ok)
+
+	// associations made in the current block aren't cached
+	ctx = ctx.WithBlockHeight(14)
+	k.OpenAddressCache(ctx)
+	newSeiAddr, newEvmAddr := keeper.MockAddressPair()
+	k.SetAddressMapping(ctx, newSeiAddr, newEvmAddr)
+	_, ok = k.GetSeiAddress(ctx, newEvmAddr)
+	require.True(t, ok)
+	ctx.KVStore(k.GetStoreKey()).Delete(types.EVMAddressToSeiAddressKey(newEvmAddr))
+	_, ok = k.GetSeiAddress(ctx, newEvmAddr)
+	require.False(t, ok)
+}
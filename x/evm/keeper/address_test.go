@@ -2,11 +2,15 @@ package keeper_test
 
 import (
 	"bytes"
+	"errors"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/sei-protocol/sei-chain/testutil/keeper"
 	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,6 +32,98 @@ func TestSetGetAddressMapping(t *testing.T) {
 	require.Equal(t, seiAddr, k.AccountKeeper().GetAccount(ctx, seiAddr).GetAddress())
 }
 
+func TestSetAddressMappingTypedEvent(t *testing.T) {
+	k, ctx := keeper.MockEVMKeeper()
+	seiAddr, evmAddr := keeper.MockAddressPair()
+	associatedEvents := func() []*types.EventAddressAssociated {
+		events := []*types.EventAddressAssociated{}
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			if msg, err := sdk.ParseTypedEvent(e); err == nil {
+				events = append(events, msg.(*types.EventAddressAssociated))
+			}
+		}
+		return events
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(5)
+	k.SetAddressMappingWithMechanism(ctx, seiAddr, evmAddr, types.AssociationMechanism_SUBMITTED_PUBKEY)
+	require.Equal(t, []*types.EventAddressAssociated{{
+		SeiAddress: seiAddr.String(),
+		EvmAddress: evmAddr.Hex(),
+		Mechanism:  types.AssociationMechanism_SUBMITTED_PUBKEY,
+		Height:     5,
+	}}, associatedEvents())
+
+	// only new associations are reported
+	k.SetAddressMappingWithMechanism(ctx, seiAddr, evmAddr, types.AssociationMechanism_SUBMITTED_PUBKEY)
+	require.Len(t, associatedEvents(), 1)
+}
+
+type mockAssociationHook struct {
+	k      *evmkeeper.Keeper
+	nonce  uint64
+	err    error
+	called []common.Address
+}
+
+func (h *mockAssociationHook) AfterAddressAssociated(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) error {
+	// the association is visible to hooks
+	if addr, ok := h.k.GetEVMAddress(ctx, seiAddress); !ok || addr != evmAddress {
+		return errors.New("association not found")
+	}
+	h.called = append(h.called, evmAddress)
+	h.k.SetNonce(ctx, evmAddress, h.k.GetNonce(ctx, evmAddress)+h.nonce)
+	return h.err
+}
+
+func TestAssociationHooks(t *testing.T) {
+	k, ctx := keeper.MockEVMKeeper()
+	failing := &mockAssociationHook{k: k, nonce: 1, err: errors.New("failed")}
+	succeeding := &mockAssociationHook{k: k, nonce: 5}
+	k.SetHooks(types.NewMultiAssociationHooks(failing, succeeding))
+	require.Panics(t, func() { k.SetHooks(types.NewMultiAssociationHooks()) })
+
+	seiAddr, evmAddr := keeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	require.Equal(t, []common.Address{evmAddr}, failing.called)
+	require.Equal(t, []common.Address{evmAddr}, succeeding.called)
+	// a failing hook doesn't abort the association, and only its own changes
+	// are discarded
+	addr, ok := k.GetEVMAddress(ctx, seiAddr)
+	require.True(t, ok)
+	require.Equal(t, evmAddr, addr)
+	require.Equal(t, uint64(5), k.GetNonce(ctx, evmAddr))
+
+	// hooks only run for new associations, and not for genesis imports
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	genesisSeiAddr, genesisEvmAddr := keeper.MockAddressPair()
+	k.SetAddressMappingWithMechanism(ctx, genesisSeiAddr, genesisEvmAddr, types.AssociationMechanism_GENESIS)
+	require.Len(t, succeeding.called, 1)
+}
+
+func TestAssociationInfo(t *testing.T) {
+	k, ctx := keeper.MockEVMKeeper()
+	q := evmkeeper.Querier{k}
+	seiAddr, evmAddr := keeper.MockAddressPair()
+	k.SetAddressMapping(ctx.WithBlockHeight(8).WithTxSum([32]byte{1}), seiAddr, evmAddr)
+	seiRes, err := q.SeiAddressByEVMAddress(sdk.WrapSDKContext(ctx), &types.QuerySeiAddressByEVMAddressRequest{EvmAddress: evmAddr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, int64(8), seiRes.AssociatedAtHeight)
+	require.Equal(t, "01"+strings.Repeat("00", 31), seiRes.AssociatedInTx)
+	// re-associating keeps the original info
+	k.SetAddressMapping(ctx.WithBlockHeight(9), seiAddr, evmAddr)
+	evmRes, err := q.EVMAddressBySeiAddress(sdk.WrapSDKContext(ctx), &types.QueryEVMAddressBySeiAddressRequest{SeiAddress: seiAddr.String()})
+	require.Nil(t, err)
+	require.Equal(t, int64(8), evmRes.AssociatedAtHeight)
+
+	// associations that predate association info have a zero height
+	k.SetAssociationInfo(ctx, seiAddr, &types.AssociationInfo{})
+	evmRes, err = q.EVMAddressBySeiAddress(sdk.WrapSDKContext(ctx), &types.QueryEVMAddressBySeiAddressRequest{SeiAddress: seiAddr.String()})
+	require.Nil(t, err)
+	require.True(t, evmRes.Associated)
+	require.Equal(t, int64(0), evmRes.AssociatedAtHeight)
+	require.Empty(t, evmRes.AssociatedInTx)
+}
+
 func TestDeleteAddressMapping(t *testing.T) {
 	k := &keeper.EVMTestApp.EvmKeeper
 	ctx := keeper.EVMTestApp.GetContextForDeliverTx([]byte{})
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/utils/helpers"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// AssociateWithTypedDataSignature associates evmAddr with the Sei address of
+// the public key recovered from sig, an EIP-712 signature over
+// types.AssociationTypedDataHash. Each nonce can only be used once per address.
+func (k *Keeper) AssociateWithTypedDataSignature(ctx sdk.Context, evmAddr common.Address, nonce uint64, sig []byte) (sdk.AccAddress, error) {
+	store := ctx.KVStore(k.GetStoreKey())
+	nonceKey := types.AssociationNonceKey(evmAddr, nonce)
+	if store.Has(nonceKey) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "nonce %d has already been used by %s", nonce, evmAddr.Hex())
+	}
+	V := new(big.Int).SetUint64(uint64(sig[64]))
+	if V.Uint64() < 27 {
+		V.Add(V, big.NewInt(27))
+	}
+	R := new(big.Int).SetBytes(sig[:32])
+	S := new(big.Int).SetBytes(sig[32:64])
+	recovered, seiAddr, pubkey, err := helpers.GetAddresses(V, R, S, types.AssociationTypedDataHash(k.ChainID(ctx), evmAddr, nonce))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid association signature: %s", err)
+	}
+	if recovered.Cmp(evmAddr) != 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "association signature is from %s instead of %s", recovered.Hex(), evmAddr.Hex())
+	}
+	if _, associated := k.GetEVMAddress(ctx, seiAddr); associated {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "account already has association set")
+	}
+	if _, associated := k.GetSeiAddress(ctx, evmAddr); associated {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "account already has association set")
+	}
+	store.Set(nonceKey, []byte{1})
+	if err := helpers.NewAssociationHelper(k, k.BankKeeper(), k.AccountKeeper()).AssociateAddresses(ctx, seiAddr, evmAddr, pubkey, types.AssociationMechanism_EIP712_SIGNATURE); err != nil {
+		return nil, err
+	}
+	return seiAddr, nil
+}
@@ -32,10 +32,39 @@ func (k *Keeper) SetCode(ctx sdk.Context, addr common.Address, code []byte) {
 	k.PrefixStore(ctx, types.CodeHashKeyPrefix).Set(addr[:], h[:])
 	// set association with direct cast Sei address for the contract address
 	if _, ok := k.GetSeiAddress(ctx, addr); !ok {
-		k.SetAddressMapping(ctx, k.GetSeiAddressOrDefault(ctx, addr), addr)
+		k.SetAddressMappingWithMechanism(ctx, k.GetSeiAddressOrDefault(ctx, addr), addr, types.AssociationMechanism_CONTRACT)
 	}
 }
 
+// SetContractCreationInfo records that the contract at addr was deployed by a
+// transaction initiated by deployer at the current height. A zero txHash is
+// recorded as empty.
+func (k *Keeper) SetContractCreationInfo(ctx sdk.Context, addr common.Address, deployer common.Address, txHash common.Hash) {
+	info := &types.ContractCreationInfo{Deployer: deployer.Hex(), Height: ctx.BlockHeight()}
+	if txHash != (common.Hash{}) {
+		info.TxHash = txHash.Hex()
+	}
+	bz, err := info.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ContractCreationInfoKey(addr), bz)
+}
+
+// GetContractCreationInfo returns the creation info of the contract at addr.
+// Contracts deployed before creation info was recorded have none.
+func (k *Keeper) GetContractCreationInfo(ctx sdk.Context, addr common.Address) (*types.ContractCreationInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractCreationInfoKey(addr))
+	if bz == nil {
+		return nil, false
+	}
+	info := &types.ContractCreationInfo{}
+	if err := info.Unmarshal(bz); err != nil {
+		return nil, false
+	}
+	return info, true
+}
+
 func (k *Keeper) GetCodeHash(ctx sdk.Context, addr common.Address) common.Hash {
 	store := k.PrefixStore(ctx, types.CodeHashKeyPrefix)
 	bz := store.Get(addr[:])
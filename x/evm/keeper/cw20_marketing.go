@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/utils"
+)
+
+// MaxCW20LogoDataURILength caps the data URI an embedded CW20 logo is returned
+// as. It fits the 5 KiB embedded logos cw20-base accepts; larger logos are
+// returned as an empty string.
+const MaxCW20LogoDataURILength = 8192
+
+// GetCW20MarketingInfo returns the marketing info of the CW20 contract at
+// cw20Addr. Contracts that have no marketing info, or don't support the
+// marketing queries, have empty fields rather than an error.
+func (k *Keeper) GetCW20MarketingInfo(ctx sdk.Context, cw20Addr string) (utils.CW20MarketingInfo, error) {
+	addr, err := sdk.AccAddressFromBech32(cw20Addr)
+	if err != nil {
+		return utils.CW20MarketingInfo{}, err
+	}
+	info := struct {
+		Project     *string         `json:"project"`
+		Description *string         `json:"description"`
+		Logo        json.RawMessage `json:"logo"`
+	}{}
+	if !k.queryCWTokenMetadata(ctx, addr, `{"marketing_info":{}}`, &info) {
+		return utils.CW20MarketingInfo{}, nil
+	}
+	res := utils.CW20MarketingInfo{}
+	if info.Project != nil {
+		res.Project = *info.Project
+	}
+	if info.Description != nil {
+		res.Description = *info.Description
+	}
+	// the logo is either {"url": ...} or "embedded", in which case its data
+	// has to be downloaded separately
+	logoURL := struct {
+		URL string `json:"url"`
+	}{}
+	var logoKind string
+	if err := json.Unmarshal(info.Logo, &logoURL); err == nil {
+		res.Logo = logoURL.URL
+	} else if err := json.Unmarshal(info.Logo, &logoKind); err == nil && logoKind == "embedded" {
+		logo := struct {
+			MimeType string `json:"mime_type"`
+			Data     string `json:"data"`
+		}{}
+		if k.queryCWTokenMetadata(ctx, addr, `{"download_logo":{}}`, &logo) {
+			if uri := fmt.Sprintf("data:%s;base64,%s", logo.MimeType, logo.Data); len(uri) <= MaxCW20LogoDataURILength {
+				res.Logo = uri
+			}
+		}
+	}
+	return res, nil
+}
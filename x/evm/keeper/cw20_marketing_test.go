@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCW20MarketingInfo(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	creator, _ := testkeeper.MockAddressPair()
+	code, err := os.ReadFile("../../../contracts/wasm/cw20_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, creator, code, nil)
+	require.Nil(t, err)
+	instantiate := func(marketing map[string]interface{}) string {
+		msg := map[string]interface{}{"name": "Foo", "symbol": "FOO", "decimals": 6, "initial_balances": []interface{}{}}
+		if marketing != nil {
+			msg["marketing"] = marketing
+		}
+		bz, err := json.Marshal(msg)
+		require.Nil(t, err)
+		addr, _, err := k.WasmKeeper().Instantiate(ctx, codeID, creator, creator, bz, "foo", sdk.NewCoins())
+		require.Nil(t, err)
+		return addr.String()
+	}
+
+	cw20 := instantiate(map[string]interface{}{"project": "foo.io", "description": "the foo token", "logo": map[string]string{"url": "https://foo.io/logo.png"}})
+	info, err := k.GetCW20MarketingInfo(ctx, cw20)
+	require.Nil(t, err)
+	require.Equal(t, utils.CW20MarketingInfo{Project: "foo.io", Description: "the foo token", Logo: "https://foo.io/logo.png"}, info)
+
+	// embedded logos are returned as data URIs
+	png := base64.StdEncoding.EncodeToString(append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 16)...))
+	cw20 = instantiate(map[string]interface{}{"project": "foo.io", "logo": map[string]interface{}{"embedded": map[string]string{"png": png}}})
+	info, err = k.GetCW20MarketingInfo(ctx, cw20)
+	require.Nil(t, err)
+	require.Equal(t, utils.CW20MarketingInfo{Project: "foo.io", Logo: "data:image/png;base64," + png}, info)
+
+	// contracts without marketing info, or that aren't CW20s, have empty fields
+	info, err = k.GetCW20MarketingInfo(ctx, instantiate(nil))
+	require.Nil(t, err)
+	require.Equal(t, utils.CW20MarketingInfo{}, info)
+	info, err = k.GetCW20MarketingInfo(ctx, creator.String())
+	require.Nil(t, err)
+	require.Equal(t, utils.CW20MarketingInfo{}, info)
+
+	_, err = k.GetCW20MarketingInfo(ctx, "0xnotbech32")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// CreateDenomPointer deploys the ERC20 native pointer of denom from its bank
+// metadata, recording creator as the pointer creator. If denom already has a
+// pointer, that pointer is returned and nothing is deployed.
+func (k *Keeper) CreateDenomPointer(ctx sdk.Context, denom string, creator sdk.AccAddress) (string, bool, error) {
+	if pointer, _, exists := k.GetERC20NativePointer(ctx, denom); exists {
+		return pointer.Hex(), false, nil
+	}
+	if err := k.CheckPointerRegistrationAllowed(ctx, creator); err != nil {
+		return "", false, err
+	}
+	metadata, ok := k.BankKeeper().GetDenomMetaData(ctx, denom)
+	if !ok {
+		return "", false, fmt.Errorf("denom %s does not have metadata stored", denom)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	// recorded before deployment so that the one-off EVM instance's module
+	// origin isn't recorded as the creator
+	pointerKey, _ := PointerRegistryKey(types.PointerType_NATIVE, denom)
+	if err := k.setPointerCreationInfo(cacheCtx, pointerKey, creator, native.CurrentVersion); err != nil {
+		return "", false, err
+	}
+	var pointer string
+	if err := k.RunWithOneOffEVMInstance(cacheCtx, func(e *vm.EVM) error {
+		addr, err := k.UpsertERCNativePointer(cacheCtx, e, denom, denomPointerMetadata(metadata))
+		pointer = addr.Hex()
+		return err
+	}, func(string, string) {}); err != nil {
+		return "", false, err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return pointer, true, nil
+}
+
+// denomPointerMetadata returns the pointer metadata of a denom with bank
+// metadata. Its decimals, which only apply if the metadata has no display
+// unit, are the largest exponent of its denom units.
+func denomPointerMetadata(metadata banktypes.Metadata) utils.ERCMetadata {
+	res := utils.ERCMetadata{Name: metadata.Name, Symbol: metadata.Symbol}
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Exponent > uint32(res.Decimals) && denomUnit.Exponent <= math.MaxUint8 {
+			res.Decimals = uint8(denomUnit.Exponent)
+		}
+	}
+	return res
+}
+
+// bankDisplayDecimals returns the exponent of the display unit in the bank
+// metadata of denom, if there is one that fits the decimals of an ERC20.
+func (k *Keeper) bankDisplayDecimals(ctx sdk.Context, denom string) (uint8, bool) {
+	metadata, ok := k.BankKeeper().GetDenomMetaData(ctx, denom)
+	if !ok {
+		return 0, false
+	}
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display && denomUnit.Exponent <= math.MaxUint8 {
+			return uint8(denomUnit.Exponent), true
+		}
+	}
+	return 0, false
+}
+
+// NativePointerDecimalsFromMetadata returns whether the native pointer of
+// denom was last deployed with the decimals of the denom's bank metadata, as
+// opposed to the fallback decimals it was registered with. Pointers deployed
+// before version 2 of the native artifact always use the fallback.
+func (k *Keeper) NativePointerDecimalsFromMetadata(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.GetStoreKey()).Has(types.NativePointerDecimalsFromMetadataKey(denom))
+}
+
+func (k *Keeper) setNativePointerDecimalsFromMetadata(ctx sdk.Context, denom string, fromMetadata bool) {
+	store := ctx.KVStore(k.GetStoreKey())
+	if fromMetadata {
+		store.Set(types.NativePointerDecimalsFromMetadataKey(denom), []byte{1})
+	} else {
+		store.Delete(types.NativePointerDecimalsFromMetadataKey(denom))
+	}
+}
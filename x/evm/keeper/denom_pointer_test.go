@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDenomPointer(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).CacheContext()
+	creator, _ := testkeeper.MockAddressPair()
+	denom := "factory/" + creator.String() + "/foo"
+
+	// metadata is required
+	_, _, err := k.CreateDenomPointer(ctx, denom, creator)
+	require.NotNil(t, err)
+
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		Name:       "Foo",
+		Symbol:     "FOO",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "foo", Exponent: 6}},
+	})
+	pointer, created, err := k.CreateDenomPointer(ctx, denom, creator)
+	require.Nil(t, err)
+	require.True(t, created)
+	addr, _, exists := k.GetERC20NativePointer(ctx, denom)
+	require.True(t, exists)
+	require.Equal(t, addr.Hex(), pointer)
+	for query, expected := range map[string]interface{}{"name": "Foo", "symbol": "FOO", "decimals": uint8(6)} {
+		out, err := k.QueryERCSingleOutput(ctx, "native", addr, query)
+		require.Nil(t, err)
+		require.Equal(t, expected, out)
+	}
+	pointerKey, _ := keeper.PointerRegistryKey(types.PointerType_NATIVE, denom)
+	info, found := k.GetPointerCreationInfo(ctx, pointerKey)
+	require.True(t, found)
+	require.Equal(t, creator.String(), info.Creator)
+	require.False(t, info.AutoCreated)
+
+	// an existing pointer is returned as is
+	other, _ := testkeeper.MockAddressPair()
+	pointer2, created, err := k.CreateDenomPointer(ctx, denom, other)
+	require.Nil(t, err)
+	require.False(t, created)
+	require.Equal(t, pointer, pointer2)
+	info, _ = k.GetPointerCreationInfo(ctx, pointerKey)
+	require.Equal(t, creator.String(), info.Creator)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+var ErrGasCapExceeded = errors.New("gas required exceeds allowance")
+
+// EstimateGas binary searches the lowest gas limit, up to gasCap, with which
+// msg executes successfully. Each attempt runs through the same state
+// transition as an EVM transaction against a discarded branch of ctx. It also
+// returns the bounds the search started from. If msg reverts at gasCap, the
+// returned error is a *types.RevertError.
+func (k *Keeper) EstimateGas(ctx sdk.Context, msg core.Message, gasCap uint64) (gas uint64, lo uint64, hi uint64, err error) {
+	msg.GasLimit = gasCap
+	res, err := k.simulateEVMMessage(ctx, msg, nil)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if res.Failed() {
+		if errors.Is(res.Err, vm.ErrExecutionReverted) {
+			return 0, 0, 0, types.NewRevertError(res.Revert())
+		}
+		return 0, 0, 0, fmt.Errorf("%w (%d): %s", ErrGasCapExceeded, gasCap, res.Err)
+	}
+	// gas used with the full allowance is a lower bound since refunds are
+	// capped at a fraction of it
+	lo, hi = res.UsedGas-1, gasCap
+	gas = hi
+	for lo+1 < gas {
+		mid := lo + (gas-lo)/2
+		msg.GasLimit = mid
+		res, err := k.simulateEVMMessage(ctx, msg, nil)
+		if err != nil || res.Failed() {
+			lo = mid
+		} else {
+			gas = mid
+		}
+	}
+	return gas, lo, hi, nil
+}
+
+func (k *Keeper) simulateEVMMessage(ctx sdk.Context, msg core.Message, tracer *tracing.Hooks) (*core.ExecutionResult, error) {
+	ctx, _ = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).CacheContext()
+	stateDB := state.NewDBImpl(ctx, k, true)
+	defer stateDB.Cleanup()
+	return k.applyEVMMessageWithTracer(ctx, &msg, stateDB, k.GetGasPool(), tracer)
+}
@@ -10,12 +10,14 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/utils/metrics"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
@@ -49,10 +51,13 @@ func (k *Keeper) HandleInternalEVMDelegateCall(ctx sdk.Context, req *types.MsgIn
 	} else {
 		return nil, errors.New("cannot use a CosmWasm contract to delegate-create an EVM contract")
 	}
-	addr, _, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(req.FromContract))))
+	addr, _, exists := k.getPointerReverseIndex(ctx, common.BytesToAddress([]byte(req.FromContract)))
 	if !exists || common.BytesToAddress(addr).Cmp(*to) != 0 {
 		return nil, errors.New("only pointer contract can make delegatecalls")
 	}
+	if k.IsPointerPaused(ctx, common.BytesToAddress([]byte(req.FromContract))) {
+		return nil, types.ErrPointerPaused
+	}
 	zeroInt := sdk.ZeroInt()
 	senderAddr, err := sdk.AccAddressFromBech32(req.Sender)
 	if err != nil {
@@ -66,13 +71,101 @@ func (k *Keeper) HandleInternalEVMDelegateCall(ctx sdk.Context, req *types.MsgIn
 		metrics.IncrementAssociationError("evm_handle_internal_evm_delegate_call", err)
 		return nil, err
 	}
+	cwPointer, version, isERC20 := k.GetCW20ERC20Pointer(ctx, *to)
+	safeERC20 := isERC20 && cwPointer.String() == req.FromContract && version >= safeERC20PointerVersion
+	if safeERC20 {
+		if err := k.resetERC20Allowance(ctx, senderEvmAddr, *to, req.Data); err != nil {
+			return nil, err
+		}
+	}
 	ret, err := k.CallEVM(ctx, senderEvmAddr, to, &zeroInt, req.Data)
 	if err != nil {
 		return nil, err
 	}
+	if safeERC20 {
+		if err := checkERC20Return(req.Data, ret); err != nil {
+			return nil, err
+		}
+	}
 	return &sdk.Result{Data: ret}, nil
 }
 
+// safeERC20PointerVersion is the first version of the CW20 -> ERC20 pointer
+// whose calls into its ERC20 are made the way SafeERC20 makes them. Earlier
+// pointers keep the unchecked calls until they are upgraded.
+const safeERC20PointerVersion uint16 = 3
+
+// erc20BoolMethods are the ERC20 methods that report success as a bool.
+var erc20BoolMethods = map[string]bool{"transfer": true, "transferFrom": true, "approve": true}
+
+// resetERC20Allowance approves 0 for the spender of an ERC20 approve call
+// from owner that changes a nonzero allowance to another nonzero one, the way
+// SafeERC20's forceApprove does, since tokens like USDT reject such a change.
+// Calls other than approve are left alone.
+func (k *Keeper) resetERC20Allowance(ctx sdk.Context, owner common.Address, token common.Address, data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsedABI := artifacts.GetParsedABI("native")
+	method, err := parsedABI.MethodById(data[:4])
+	if err != nil || method.Name != "approve" {
+		return nil
+	}
+	// malformed arguments are left for the approve call itself to reject
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil
+	}
+	spender, amount := args[0].(common.Address), args[1].(*big.Int)
+	if amount.Sign() == 0 {
+		return nil
+	}
+	query, err := parsedABI.Pack("allowance", owner, spender)
+	if err != nil {
+		return err
+	}
+	res, err := k.StaticCallEVM(ctx, k.GetSeiAddressOrDefault(ctx, owner), &token, query)
+	if err != nil {
+		return nil
+	}
+	allowance, err := parsedABI.Unpack("allowance", res)
+	if err != nil || allowance[0].(*big.Int).Sign() == 0 {
+		return nil
+	}
+	reset, err := parsedABI.Pack("approve", spender, big.NewInt(0))
+	if err != nil {
+		return err
+	}
+	zeroInt := sdk.ZeroInt()
+	ret, err := k.CallEVM(ctx, owner, &token, &zeroInt, reset)
+	if err != nil {
+		return err
+	}
+	return checkERC20Return(reset, ret)
+}
+
+// checkERC20Return checks the return of an ERC20 call made by a CW20 pointer
+// the way SafeERC20 does: tokens like USDT return nothing from transfer and
+// approve, so no return data is a success, while a returned false or a return
+// that doesn't decode as a bool is a failure.
+func checkERC20Return(data []byte, ret []byte) error {
+	if len(data) < 4 || len(ret) == 0 {
+		return nil
+	}
+	method, err := artifacts.GetParsedABI("native").MethodById(data[:4])
+	if err != nil || !erc20BoolMethods[method.Name] {
+		return nil
+	}
+	outputs, err := method.Outputs.Unpack(ret)
+	if err != nil {
+		return fmt.Errorf("ERC20 %s returned malformed data: %w", method.Name, err)
+	}
+	if success, _ := outputs[0].(bool); !success {
+		return fmt.Errorf("ERC20 %s returned false", method.Name)
+	}
+	return nil
+}
+
 func (k *Keeper) CallEVM(ctx sdk.Context, from common.Address, to *common.Address, val *sdk.Int, data []byte) (retdata []byte, reterr error) {
 	if ctx.IsEVM() && !ctx.EVMEntryViaWasmdPrecompile() {
 		return nil, errors.New("sei does not support EVM->CW->EVM call pattern")
@@ -90,6 +183,7 @@ func (k *Keeper) CallEVM(ctx sdk.Context, from common.Address, to *common.Addres
 	// This call was not part of an existing StateTransition, so it should trigger one
 	executionCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).WithEVMEntryViaWasmdPrecompile(false)
 	stateDB := state.NewDBImpl(executionCtx, k, false)
+	stateDB.SetTxOrigin(from, ctx.TxSum())
 	gp := k.GetGasPool()
 	evmMsg := &core.Message{
 		Nonce:             stateDB.GetNonce(from), // replay attack is prevented by the AccountSequence number set on the CW transaction that triggered this call
@@ -147,7 +241,14 @@ func (k *Keeper) CallEVM(ctx sdk.Context, from common.Address, to *common.Addres
 }
 
 func (k *Keeper) StaticCallEVM(ctx sdk.Context, from sdk.AccAddress, to *common.Address, data []byte) ([]byte, error) {
-	evm, err := k.createReadOnlyEVM(ctx, from)
+	return k.StaticCallEVMWithTracer(ctx, from, to, data, nil, nil)
+}
+
+// StaticCallEVMWithTracer is StaticCallEVM with the given tracing hooks attached to
+// the read-only EVM, executing in a block context with blockOverrides applied.
+// tracer and blockOverrides may be nil.
+func (k *Keeper) StaticCallEVMWithTracer(ctx sdk.Context, from sdk.AccAddress, to *common.Address, data []byte, tracer *tracing.Hooks, blockOverrides *BlockOverrides) ([]byte, error) {
+	evm, err := k.createReadOnlyEVM(ctx, from, tracer, blockOverrides)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +265,9 @@ func (k *Keeper) callEVM(ctx sdk.Context, from common.Address, to *common.Addres
 	}
 	ret, leftoverGas, err := f(vm.AccountRef(from), to, data, evmGasLimit, value)
 	k.consumeEvmGas(ctx, evmGasLimit-leftoverGas)
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		return nil, types.NewRevertError(ret)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +275,7 @@ func (k *Keeper) callEVM(ctx sdk.Context, from common.Address, to *common.Addres
 }
 
 // only used for StaticCalls
-func (k *Keeper) createReadOnlyEVM(ctx sdk.Context, from sdk.AccAddress) (*vm.EVM, error) {
+func (k *Keeper) createReadOnlyEVM(ctx sdk.Context, from sdk.AccAddress, tracer *tracing.Hooks, blockOverrides *BlockOverrides) (*vm.EVM, error) {
 	executionCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
 	stateDB := state.NewDBImpl(executionCtx, k, true)
 	gp := k.GetGasPool()
@@ -179,9 +283,10 @@ func (k *Keeper) createReadOnlyEVM(ctx sdk.Context, from sdk.AccAddress) (*vm.EV
 	if err != nil {
 		return nil, err
 	}
+	blockOverrides.apply(blockCtx)
 	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
 	txCtx := vm.TxContext{Origin: k.GetEVMAddressOrDefault(ctx, from)}
-	return vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{}, k.customPrecompiles), nil
+	return vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{Tracer: tracer}, k.customPrecompiles), nil
 }
 
 func (k *Keeper) getEvmGasLimitFromCtx(ctx sdk.Context) uint64 {
@@ -12,6 +12,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
@@ -211,4 +212,123 @@ func TestHandleInternalEVMDelegateCall_AssociationError(t *testing.T) {
 	}
 	_, err := k.HandleInternalEVMDelegateCall(ctx, req)
 	require.Equal(t, err.Error(), types.NewAssociationMissingErr(testAddr.String()).Error())
+
+	_, err = k.SetPointerPaused(ctx, "", types.PointerType_CW20, string(castedAddr.Bytes()), true)
+	require.Nil(t, err)
+	_, err = k.HandleInternalEVMDelegateCall(ctx, req)
+	require.ErrorIs(t, err, types.ErrPointerPaused)
+}
+
+func TestHandleInternalEVMDelegateCall_ERC20Return(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	testAddr, testEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, testAddr, testEvmAddr)
+	abi, err := native.NativeMetaData.GetAbi()
+	require.Nil(t, err)
+	transfer, err := abi.Pack("transfer", testEvmAddr, big.NewInt(1))
+	require.Nil(t, err)
+	balanceOf, err := abi.Pack("balanceOf", testEvmAddr)
+	require.Nil(t, err)
+	// returnWord is the code of a token that returns the given 32-byte word
+	// from every call
+	returnWord := func(w byte) []byte {
+		return []byte{0x60, w, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	}
+
+	for _, tc := range []struct {
+		name        string
+		code        []byte
+		data        []byte
+		version     uint16
+		expectedErr string
+	}{
+		{"no return data", []byte{0x00}, transfer, erc20.CurrentVersion, ""},
+		{"true", returnWord(1), transfer, erc20.CurrentVersion, ""},
+		{"false", returnWord(0), transfer, erc20.CurrentVersion, "ERC20 transfer returned false"},
+		{"not a bool", returnWord(2), transfer, erc20.CurrentVersion, "ERC20 transfer returned malformed data"},
+		{"revert", []byte{0x60, 0x00, 0x60, 0x00, 0xfd}, transfer, erc20.CurrentVersion, "execution reverted"},
+		{"not a bool method", returnWord(0), balanceOf, erc20.CurrentVersion, ""},
+		// pointers from before the checks keep their behavior until upgraded
+		{"false from an outdated pointer", returnWord(0), transfer, 2, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			_, erc20Addr := testkeeper.MockAddressPair()
+			cwAddr, _ := testkeeper.MockAddressPair()
+			k.SetCode(ctx, erc20Addr, tc.code)
+			require.Nil(t, k.SetCW20ERC20PointerWithVersion(ctx, erc20Addr, cwAddr.String(), tc.version))
+			_, err := k.HandleInternalEVMDelegateCall(ctx, &types.MsgInternalEVMDelegateCall{
+				Sender:       testAddr.String(),
+				FromContract: cwAddr.String(),
+				To:           erc20Addr.Hex(),
+				Data:         tc.data,
+			})
+			if tc.expectedErr == "" {
+				require.Nil(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestHandleInternalEVMDelegateCall_ERC20AllowanceReset(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	testAddr, testEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, testAddr, testEvmAddr)
+	_, spender := testkeeper.MockAddressPair()
+	abi, err := native.NativeMetaData.GetAbi()
+	require.Nil(t, err)
+	// usdtCode is the code of a token that, like USDT, returns nothing from
+	// approve and reverts when an allowance is changed from one nonzero amount
+	// to another. Allowances are kept per spender only.
+	usdtCode := common.FromHex("0x60003560e01c8063095ea7b314601a5763dd62ed3e14603557005b5060243560043580548215159015151660305755005b600080fd5b6024355460005260206000f3")
+
+	for _, tc := range []struct {
+		name        string
+		version     uint16
+		expectedErr string
+	}{
+		{"reset first", erc20.CurrentVersion, ""},
+		{"outdated pointer", 2, "execution reverted"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			_, erc20Addr := testkeeper.MockAddressPair()
+			cwAddr, _ := testkeeper.MockAddressPair()
+			k.SetCode(ctx, erc20Addr, usdtCode)
+			require.Nil(t, k.SetCW20ERC20PointerWithVersion(ctx, erc20Addr, cwAddr.String(), tc.version))
+			approve := func(amount int64) error {
+				data, err := abi.Pack("approve", spender, big.NewInt(amount))
+				require.Nil(t, err)
+				_, err = k.HandleInternalEVMDelegateCall(ctx, &types.MsgInternalEVMDelegateCall{
+					Sender:       testAddr.String(),
+					FromContract: cwAddr.String(),
+					To:           erc20Addr.Hex(),
+					Data:         data,
+				})
+				return err
+			}
+			allowance := func() *big.Int {
+				data, err := abi.Pack("allowance", testEvmAddr, spender)
+				require.Nil(t, err)
+				res, err := k.StaticCallEVM(ctx, testAddr, &erc20Addr, data)
+				require.Nil(t, err)
+				return new(big.Int).SetBytes(res)
+			}
+
+			require.Nil(t, approve(5))
+			require.Equal(t, big.NewInt(5), allowance())
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, approve(7), tc.expectedErr)
+				return
+			}
+			require.Nil(t, approve(7))
+			require.Equal(t, big.NewInt(7), allowance())
+			require.Nil(t, approve(0))
+			require.Equal(t, 0, allowance().Sign())
+		})
+	}
 }
@@ -3,10 +3,8 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -17,7 +15,6 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
-	artifactsutils "github.com/sei-protocol/sei-chain/x/evm/artifacts/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
@@ -30,40 +27,31 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
 	seiAddrFc := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName) // feeCollector == coinbase
-	k.SetAddressMapping(ctx, seiAddrFc, GetCoinbaseAddress())
+	k.SetAddressMappingWithMechanism(ctx, seiAddrFc, GetCoinbaseAddress(), types.AssociationMechanism_GENESIS)
 
 	for _, addr := range genState.AddressAssociations {
-		k.SetAddressMapping(ctx, sdk.MustAccAddressFromBech32(addr.SeiAddress), common.HexToAddress(addr.EthAddress))
+		k.ImportAddressAssociation(ctx, addr)
 	}
 
 	erc20CodeID, err := k.wasmKeeper.Create(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), erc20.GetBin(), nil)
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("error creating CWERC20 pointer code due to %s", err))
 	} else {
-		prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW20ERC20Prefix).Set(
-			artifactsutils.GetVersionBz(erc20.CurrentVersion),
-			artifactsutils.GetCodeIDBz(erc20CodeID),
-		)
+		k.SetStoredPointerCodeID(ctx, types.PointerType_ERC20, erc20.CurrentVersion, erc20CodeID)
 	}
 
 	erc721CodeID, err := k.wasmKeeper.Create(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), erc721.GetBin(), nil)
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("error creating CWERC721 pointer code due to %s", err))
 	} else {
-		prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW721ERC721Prefix).Set(
-			artifactsutils.GetVersionBz(erc721.CurrentVersion),
-			artifactsutils.GetCodeIDBz(erc721CodeID),
-		)
+		k.SetStoredPointerCodeID(ctx, types.PointerType_ERC721, erc721.CurrentVersion, erc721CodeID)
 	}
 
 	erc1155CodeID, err := k.wasmKeeper.Create(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), erc1155.GetBin(), nil)
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("error creating CWERC1155 pointer code due to %s", err))
 	} else {
-		prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW1155ERC1155Prefix).Set(
-			artifactsutils.GetVersionBz(erc1155.CurrentVersion),
-			artifactsutils.GetCodeIDBz(erc1155CodeID),
-		)
+		k.SetStoredPointerCodeID(ctx, types.PointerType_ERC1155, erc1155.CurrentVersion, erc1155CodeID)
 	}
 
 	if k.EthReplayConfig.Enabled && !ethReplayInitialied {
@@ -1,12 +1,43 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/utils/helpers"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw721"
@@ -15,13 +46,63 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
+	dbm "github.com/tendermint/tm-db"
 )
 
 var _ types.QueryServer = Querier{}
 
+// shellEVMTxType is the tx type of receipts synthesized for Cosmos
+// transactions, matching app.ShellEVMTxType.
+const shellEVMTxType = math.MaxUint32
+
 var ErrMustSpecifyPointer = errors.New("must specify a pointer")
 var ErrMustSpecifyPointee = errors.New("must specify a pointee")
 
+// Statuses reported by AssociationPreflight.
+const (
+	AssociationPreflightOK                = "ok"
+	AssociationPreflightAlreadyAssociated = "already_associated_same"
+	AssociationPreflightSeiTaken          = "sei_taken"
+	AssociationPreflightEVMTaken          = "evm_taken"
+)
+
+// MaxAddressBatchSize caps how many addresses a single batch association
+// query may look up.
+const MaxAddressBatchSize = 500
+
+// MaxStaticCallBatchSize caps how many calls a single StaticCalls query may
+// execute.
+const MaxStaticCallBatchSize = 50
+
+// MaxPointerBatchSize caps how many pointers a single PointersByPointees query
+// may look up.
+const MaxPointerBatchSize = 500
+
+// MaxBalance1155BatchSize caps how many balances a single Balance1155Batch
+// query may read.
+const MaxBalance1155BatchSize = 100
+
+// MaxStateOverrideAccounts caps how many accounts a single StaticCall may
+// override, and MaxStateOverrideSlots how many storage slots across them.
+const (
+	MaxStateOverrideAccounts = 100
+	MaxStateOverrideSlots    = 1000
+)
+
+// DefaultSuggestedTip is the priority fee per gas, in wei, GasPrice suggests
+// when blocks are at or below their gas target.
+const DefaultSuggestedTip = 1_000_000_000 // 1gwei
+
+// MaxLogTopics is the number of topic positions a Logs query can filter on,
+// matching the number of topics a log can have.
+const MaxLogTopics = 4
+
+// MaxTokenURILength caps the length of token URIs returned by NFTInfo, since
+// on-chain metadata is often inlined as a data URI. Requests for the full token
+// URI are only bounded by the query gas limit, which token URIs of a hundred
+// kilobytes exceed at its default.
+const MaxTokenURILength = 4096
+
 // Querier defines a wrapper around the x/mint keeper providing gRPC method
 // handlers.
 type Querier struct {
@@ -43,7 +124,8 @@ func (q Querier) SeiAddressByEVMAddress(c context.Context, req *types.QuerySeiAd
 		return &types.QuerySeiAddressByEVMAddressResponse{Associated: false}, nil
 	}
 
-	return &types.QuerySeiAddressByEVMAddressResponse{SeiAddress: addr.String(), Associated: true}, nil
+	info := q.Keeper.GetAssociationInfo(ctx, addr)
+	return &types.QuerySeiAddressByEVMAddressResponse{SeiAddress: addr.String(), Associated: true, AssociatedAtHeight: info.Height, AssociatedInTx: info.TxHash}, nil
 }
 
 func (q Querier) EVMAddressBySeiAddress(c context.Context, req *types.QueryEVMAddressBySeiAddressRequest) (*types.QueryEVMAddressBySeiAddressResponse, error) {
@@ -60,7 +142,171 @@ func (q Querier) EVMAddressBySeiAddress(c context.Context, req *types.QueryEVMAd
 		return &types.QueryEVMAddressBySeiAddressResponse{Associated: false}, nil
 	}
 
-	return &types.QueryEVMAddressBySeiAddressResponse{EvmAddress: addr.Hex(), Associated: true}, nil
+	info := q.Keeper.GetAssociationInfo(ctx, seiAddr)
+	return &types.QueryEVMAddressBySeiAddressResponse{EvmAddress: addr.Hex(), Associated: true, AssociatedAtHeight: info.Height, AssociatedInTx: info.TxHash}, nil
+}
+
+func (q Querier) SeiAddressesByEVMAddresses(c context.Context, req *types.QuerySeiAddressesByEVMAddressesRequest) (*types.QuerySeiAddressesByEVMAddressesResponse, error) {
+	if len(req.EvmAddresses) > MaxAddressBatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot look up more than %d addresses at once", MaxAddressBatchSize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QuerySeiAddressesByEVMAddressesResponse{Results: make([]*types.AddressAssociationResult, 0, len(req.EvmAddresses))}
+	for _, input := range req.EvmAddresses {
+		result := &types.AddressAssociationResult{Input: input}
+		res.Results = append(res.Results, result)
+		if !common.IsHexAddress(input) {
+			result.Error = "invalid hex address"
+			continue
+		}
+		if addr, found := q.Keeper.GetSeiAddress(ctx, common.HexToAddress(input)); found {
+			result.Associated, result.Result = true, addr.String()
+		}
+	}
+	return res, nil
+}
+
+func (q Querier) EVMAddressesBySeiAddresses(c context.Context, req *types.QueryEVMAddressesBySeiAddressesRequest) (*types.QueryEVMAddressesBySeiAddressesResponse, error) {
+	if len(req.SeiAddresses) > MaxAddressBatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot look up more than %d addresses at once", MaxAddressBatchSize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryEVMAddressesBySeiAddressesResponse{Results: make([]*types.AddressAssociationResult, 0, len(req.SeiAddresses))}
+	for _, input := range req.SeiAddresses {
+		result := &types.AddressAssociationResult{Input: input}
+		res.Results = append(res.Results, result)
+		seiAddr, err := sdk.AccAddressFromBech32(input)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		if addr, found := q.Keeper.GetEVMAddress(ctx, seiAddr); found {
+			result.Associated, result.Result = true, addr.Hex()
+		}
+	}
+	return res, nil
+}
+
+// Associations pages through all EVM to Sei address associations in EVM
+// address order, so that a page key can be used to resume enumeration. If
+// addresses are given, only the associated subset of them is returned instead.
+func (q Querier) Associations(c context.Context, req *types.QueryAssociationsRequest) (*types.QueryAssociationsResponse, error) {
+	if len(req.EvmAddresses) > MaxAddressBatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot look up more than %d addresses at once", MaxAddressBatchSize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryAssociationsResponse{}
+	if len(req.EvmAddresses) > 0 {
+		for _, input := range req.EvmAddresses {
+			if !common.IsHexAddress(input) {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid hex address %s", input)
+			}
+			evmAddr := common.HexToAddress(input)
+			if seiAddr, found := q.Keeper.GetSeiAddress(ctx, evmAddr); found {
+				res.Associations = append(res.Associations, &types.Association{EvmAddress: evmAddr.Hex(), SeiAddress: seiAddr.String()})
+			}
+		}
+		return res, nil
+	}
+	pageRes, err := query.Paginate(q.Keeper.PrefixStore(ctx, types.EVMAddressToSeiAddressKeyPrefix), q.boundedPageRequest(req.Pagination), func(key []byte, value []byte) error {
+		res.Associations = append(res.Associations, &types.Association{
+			EvmAddress: common.BytesToAddress(key).Hex(),
+			SeiAddress: sdk.AccAddress(value).String(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// Pointers pages through every pointer registered for a pointer type in
+// pointee order, so that a page key can be used to resume enumeration.
+func (q Querier) Pointers(c context.Context, req *types.QueryPointersRequest) (*types.QueryPointersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store, ok := q.PointerRegistryStore(ctx, req.PointerType)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %d", req.PointerType)
+	}
+	res := &types.QueryPointersResponse{}
+	pageRes, err := query.Paginate(store, q.boundedPageRequest(req.Pagination), func(key []byte, value []byte) error {
+		entry, err := DecodePointerRegistryEntry(req.PointerType, key, value)
+		if err != nil {
+			return err
+		}
+		res.Pointers = append(res.Pointers, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// Pointees pages through the reverse registry, returning the pointers of a
+// type along with their pointees. Since the reverse registry is shared by all
+// pointer types, entries of other types are skipped and do not count towards
+// the page limit.
+func (q Querier) Pointees(c context.Context, req *types.QueryPointeesRequest) (*types.QueryPointeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, ok := q.PointerRegistryStore(ctx, req.PointerType); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %d", req.PointerType)
+	}
+	version, err := q.PointerVersion(c, &types.QueryPointerVersionRequest{PointerType: req.PointerType})
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryPointeesResponse{CurrentVersion: version.Version}
+	store := q.PrefixStore(ctx, types.PointerReverseRegistryPrefix)
+	pageRes, err := query.FilteredPaginate(store, q.boundedPageRequest(req.Pagination), func(key []byte, value []byte, accumulate bool) (bool, error) {
+		entry, ok := q.ResolveReverseRegistryEntry(ctx, req.PointerType, key, value)
+		if !ok {
+			return false, nil
+		}
+		if accumulate {
+			res.Entries = append(res.Entries, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// PointersSince pages through the pointer registration log from the given
+// height on.
+func (q Querier) PointersSince(c context.Context, req *types.QueryPointersSinceRequest) (*types.QueryPointersSinceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryPointersSinceResponse{PrunedBefore: q.GetPointerRegistrationLogPrunedBefore(ctx)}
+	store := q.PrefixStore(ctx, types.PointerRegistrationLogPrefix)
+	pageReq := q.boundedPageRequest(req.Pagination)
+	if len(pageReq.Key) == 0 && pageReq.Offset == 0 && req.StartHeight > 0 {
+		// skip straight to the first entry at the start height
+		pageReq.Key = types.PointerRegistrationLogHeightPrefix(req.StartHeight)[len(types.PointerRegistrationLogPrefix):]
+	}
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		entry := &types.PointerRegistration{}
+		if err := entry.Unmarshal(value); err != nil {
+			return false, err
+		}
+		if entry.Height < req.StartHeight {
+			return false, nil
+		}
+		if accumulate {
+			res.Registrations = append(res.Registrations, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
 }
 
 func (q Querier) StaticCall(c context.Context, req *types.QueryStaticCallRequest) (*types.QueryStaticCallResponse, error) {
@@ -68,15 +314,572 @@ func (q Querier) StaticCall(c context.Context, req *types.QueryStaticCallRequest
 	if req.To == "" {
 		return nil, errors.New("cannot use static call to create contracts")
 	}
-	if ctx.GasMeter().Limit() == 0 {
-		ctx = ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, q.QueryConfig.GasLimit))
+	if req.From != "" && !common.IsHexAddress(req.From) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid from address")
+	}
+	if req.Height < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	errorABIs := make([]abi.ABI, 0, len(req.ErrorAbis))
+	for _, errorABI := range req.ErrorAbis {
+		parsed, err := abi.JSON(strings.NewReader(errorABI))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid error ABI: %s", err)
+		}
+		errorABIs = append(errorABIs, parsed)
+	}
+	if req.Height != 0 {
+		var err error
+		if ctx, err = q.HistoricalContext(ctx, req.Height); err != nil {
+			return nil, err
+		}
+	}
+	overrides, err := parseStateOverrides(req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+	blockOverrides, err := parseBlockOverrides(req.BlockOverrides)
+	if err != nil {
+		return nil, err
+	}
+	if len(overrides) > 0 {
+		ctx, _ = ctx.CacheContext()
+		if err := q.ApplyStateOverrides(ctx, overrides); err != nil {
+			return nil, err
+		}
+	}
+	from := q.Keeper.AccountKeeper().GetModuleAddress(types.ModuleName)
+	if req.From != "" {
+		from = q.Keeper.GetSeiAddressOrDefault(ctx, common.HexToAddress(req.From))
+	}
+	ctx = q.withQueryGasLimit(ctx)
+	// fail fast on calls whose calldata alone would exhaust the gas limit
+	intrinsicGas, err := core.IntrinsicGas(req.Data, nil, false, true, true, true)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := q.getEvmGasLimitFromCtx(ctx)
+	if intrinsicGas > gasLimit {
+		return nil, fmt.Errorf("%w: have %d, want %d", core.ErrIntrinsicGas, gasLimit, intrinsicGas)
+	}
+	internalReverted := false
+	var gasUsed uint64
+	tracer := &tracing.Hooks{
+		OnExit: func(depth int, _ []byte, frameGasUsed uint64, _ error, reverted bool) {
+			if depth == 0 {
+				gasUsed = frameGasUsed
+			} else if reverted && req.ReportInternalReverts {
+				internalReverted = true
+			}
+		},
 	}
 	to := common.HexToAddress(req.To)
-	res, err := q.Keeper.StaticCallEVM(ctx, q.Keeper.AccountKeeper().GetModuleAddress(types.ModuleName), &to, req.Data)
+	res, err := q.Keeper.StaticCallEVMWithTracer(ctx, from, &to, req.Data, tracer, blockOverrides)
+	var revertErr *types.RevertError
+	height, blockHash := ctx.BlockHeight(), common.BytesToHash(ctx.HeaderHash()).Hex()
+	if errors.As(err, &revertErr) {
+		return &types.QueryStaticCallResponse{
+			RevertError: decodeRevertError(errorABIs, revertErr.Data), InternalReverted: internalReverted, Height: height, BlockHash: blockHash,
+			GasUsed: gasUsed, GasLimitApplied: gasLimit,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStaticCallResponse{
+		Data: res, InternalReverted: internalReverted, Height: height, BlockHash: blockHash,
+		GasUsed: gasUsed, GasLimitApplied: gasLimit,
+	}, nil
+}
+
+func parseStateOverrides(overrides map[string]*types.AccountOverride) (map[common.Address]StateOverride, error) {
+	if len(overrides) > MaxStateOverrideAccounts {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot override more than %d accounts", MaxStateOverrideAccounts)
+	}
+	res := make(map[common.Address]StateOverride, len(overrides))
+	slots := 0
+	for addrHex, override := range overrides {
+		if !common.IsHexAddress(addrHex) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid override address %s", addrHex)
+		}
+		addr := common.HexToAddress(addrHex)
+		if _, ok := res[addr]; ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate override of %s", addr.Hex())
+		}
+		if override == nil {
+			override = &types.AccountOverride{}
+		}
+		if len(override.State) > 0 && len(override.StateDiff) > 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "override of %s cannot set both state and state diff", addr.Hex())
+		}
+		if slots += len(override.State) + len(override.StateDiff); slots > MaxStateOverrideSlots {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot override more than %d storage slots", MaxStateOverrideSlots)
+		}
+		var parsed StateOverride
+		if override.Balance != "" {
+			balance, ok := sdk.NewIntFromString(override.Balance)
+			if !ok || balance.IsNegative() {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid balance override of %s", addr.Hex())
+			}
+			parsed.Balance = balance.BigInt()
+		}
+		if override.Nonce != "" {
+			nonce, err := strconv.ParseUint(override.Nonce, 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid nonce override of %s", addr.Hex())
+			}
+			parsed.Nonce = &nonce
+		}
+		if override.Code != "" {
+			code, err := hexutil.Decode(override.Code)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid code override of %s: %s", addr.Hex(), err)
+			}
+			if len(code) > params.MaxInitCodeSize {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code override of %s exceeds %d bytes", addr.Hex(), params.MaxInitCodeSize)
+			}
+			parsed.Code = code
+		}
+		var err error
+		if parsed.State, err = parseStorageOverride(override.State); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state override of %s: %s", addr.Hex(), err)
+		}
+		if parsed.StateDiff, err = parseStorageOverride(override.StateDiff); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state diff override of %s: %s", addr.Hex(), err)
+		}
+		res[addr] = parsed
+	}
+	return res, nil
+}
+
+func parseBlockOverrides(overrides *types.BlockOverrides) (*BlockOverrides, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+	res := &BlockOverrides{}
+	if overrides.Number != "" {
+		number, ok := sdk.NewIntFromString(overrides.Number)
+		if !ok || number.IsNegative() {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid block number override")
+		}
+		res.Number = number.BigInt()
+	}
+	if overrides.Time != "" {
+		timestamp, err := strconv.ParseUint(overrides.Time, 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid block time override")
+		}
+		res.Time = &timestamp
+	}
+	if overrides.BaseFee != "" {
+		baseFee, ok := sdk.NewIntFromString(overrides.BaseFee)
+		if !ok || baseFee.IsNegative() {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid base fee override")
+		}
+		res.BaseFee = baseFee.BigInt()
+	}
+	if overrides.Coinbase != "" {
+		if !common.IsHexAddress(overrides.Coinbase) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid coinbase override")
+		}
+		coinbase := common.HexToAddress(overrides.Coinbase)
+		res.Coinbase = &coinbase
+	}
+	return res, nil
+}
+
+func parseStorageOverride(storage map[string]string) (map[common.Hash]common.Hash, error) {
+	if len(storage) == 0 {
+		return nil, nil
+	}
+	res := make(map[common.Hash]common.Hash, len(storage))
+	for keyHex, valHex := range storage {
+		key, err := parseHash(keyHex)
+		if err != nil {
+			return nil, err
+		}
+		if res[key], err = parseHash(valHex); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func parseHash(s string) (common.Hash, error) {
+	bz, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("%s: %w", s, err)
+	}
+	if len(bz) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("%s is longer than %d bytes", s, common.HashLength)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// StaticCalls executes a batch of read-only calls in order against the same
+// state. Calls share one gas limit; a call that fails, including by running out
+// of the remaining gas, is reported in its result without aborting the batch.
+func (q Querier) StaticCalls(c context.Context, req *types.QueryStaticCallsRequest) (*types.QueryStaticCallsResponse, error) {
+	if len(req.Calls) > MaxStaticCallBatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot execute more than %d calls at once", MaxStaticCallBatchSize)
+	}
+	ctx, _ := q.withQueryGasLimit(sdk.UnwrapSDKContext(c)).CacheContext()
+	res := &types.QueryStaticCallsResponse{Results: make([]*types.StaticCallResult, 0, len(req.Calls))}
+	for _, call := range req.Calls {
+		result := &types.StaticCallResult{}
+		res.Results = append(res.Results, result)
+		gasBefore := ctx.GasMeter().GasConsumedToLimit()
+		data, err := q.batchedStaticCall(ctx, call)
+		result.GasUsed = ctx.GasMeter().GasConsumedToLimit() - gasBefore
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.Data = data
+	}
+	return res, nil
+}
+
+// batchedStaticCall executes one call of a StaticCalls batch, returning an
+// error instead of panicking when the call exhausts the shared gas meter.
+func (q Querier) batchedStaticCall(ctx sdk.Context, call *types.StaticCallEntry) (ret []byte, err error) {
+	if !common.IsHexAddress(call.To) {
+		return nil, errors.New("invalid to address")
+	}
+	if call.From != "" && !common.IsHexAddress(call.From) {
+		return nil, errors.New("invalid from address")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", oog.Descriptor)
+		}
+	}()
+	from := q.Keeper.AccountKeeper().GetModuleAddress(types.ModuleName)
+	if call.From != "" {
+		from = q.Keeper.GetSeiAddressOrDefault(ctx, common.HexToAddress(call.From))
+	}
+	to := common.HexToAddress(call.To)
+	return q.Keeper.StaticCallEVM(ctx, from, &to, call.Data)
+}
+
+// EstimateGas finds the lowest gas limit, capped by the query gas limit, with
+// which the call or contract creation succeeds. A call that reverts with the
+// full gas limit returns its revert reason instead of an estimate.
+func (q Querier) EstimateGas(c context.Context, req *types.QueryEstimateGasRequest) (*types.QueryEstimateGasResponse, error) {
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	msg, err := q.simulatedMessage(ctx, req.From, req.To, req.Value, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	gas, lo, hi, err := q.Keeper.EstimateGas(ctx, msg, q.getEvmGasLimitFromCtx(ctx))
+	var revertErr *types.RevertError
+	if errors.As(err, &revertErr) {
+		res := &types.QueryEstimateGasResponse{RevertReason: err.Error(), RevertData: revertErr.Data}
+		if reason, unpackErr := abi.UnpackRevert(revertErr.Data); unpackErr == nil {
+			res.RevertReason = reason
+		}
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEstimateGasResponse{Gas: gas, LowerBound: lo, UpperBound: hi}, nil
+}
+
+// EstimateRegisterPointer dry-runs a MsgRegisterPointer against a cached
+// context and reports the gas it consumed, the fee that gas costs at the
+// chain's minimum gas prices and the resulting pointer address. Only pointers
+// to ERC contracts can be estimated. Registrations that would fail return
+// their error instead.
+func (q Querier) EstimateRegisterPointer(c context.Context, req *types.QueryEstimateRegisterPointerRequest) (*types.QueryEstimateRegisterPointerResponse, error) {
+	msg := &types.MsgRegisterPointer{Sender: req.Sender, PointerType: req.PointerType, ErcAddress: req.ErcAddress}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	switch req.PointerType {
+	case types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155:
+	default:
+		return nil, sdkerrors.Wrapf(errors.ErrUnsupported, "%s pointers cannot be estimated; only ERC20, ERC721 and ERC1155 pointers are supported", req.PointerType)
+	}
+	if q.ParamsKeeper == nil {
+		return nil, errors.New("minimum gas prices are unavailable")
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	if q.Keeper.GetCodeSize(ctx, common.HexToAddress(req.ErcAddress)) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no contract deployed at %s", req.ErcAddress)
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	gasBefore := cacheCtx.GasMeter().GasConsumed()
+	res, err := NewMsgServerImpl(q.Keeper).RegisterPointer(sdk.WrapSDKContext(cacheCtx), msg)
+	if err != nil {
+		return nil, err
+	}
+	gas := cacheCtx.GasMeter().GasConsumed() - gasBefore
+	// MsgRegisterPointer is a Cosmos message, so its gas is SDK gas and is
+	// priced like the ante handler prices it rather than at the EVM base fee
+	minGasPrices := q.ParamsKeeper.GetFeesParams(ctx).GlobalMinimumGasPrices.UnionMax(ctx.MinGasPrices()).Sort()
+	fee := make(sdk.Coins, 0, len(minGasPrices))
+	for _, price := range minGasPrices {
+		fee = append(fee, sdk.NewCoin(price.Denom, price.Amount.MulInt64(int64(gas)).Ceil().RoundInt()))
+	}
+	return &types.QueryEstimateRegisterPointerResponse{
+		Gas:            gas,
+		Fee:            fee.String(),
+		PointerAddress: res.PointerAddress,
+	}, nil
+}
+
+// simulatedMessage validates the call parameters shared by the simulation
+// queries and builds the message they execute. from defaults to the EVM
+// module address and an empty to means contract creation.
+func (q Querier) simulatedMessage(ctx sdk.Context, fromHex string, toHex string, valueDec string, data []byte) (core.Message, error) {
+	if fromHex != "" && !common.IsHexAddress(fromHex) {
+		return core.Message{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid from address")
+	}
+	if toHex != "" && !common.IsHexAddress(toHex) {
+		return core.Message{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid to address")
+	}
+	value := new(big.Int)
+	if valueDec != "" {
+		if _, ok := value.SetString(valueDec, 10); !ok || value.Sign() < 0 {
+			return core.Message{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "value must be a non-negative decimal integer")
+		}
+	}
+	from := q.Keeper.GetEVMAddressOrDefault(ctx, q.Keeper.AccountKeeper().GetModuleAddress(types.ModuleName))
+	if fromHex != "" {
+		from = common.HexToAddress(fromHex)
+	}
+	var to *common.Address
+	if toHex != "" {
+		addr := common.HexToAddress(toHex)
+		to = &addr
+	}
+	return core.Message{
+		From:              from,
+		To:                to,
+		Nonce:             q.Keeper.GetNonce(ctx, from),
+		Value:             value,
+		Data:              data,
+		GasPrice:          utils.Big0,
+		GasFeeCap:         utils.Big0,
+		GasTipCap:         utils.Big0,
+		SkipAccountChecks: true,
+	}, nil
+}
+
+// Code returns the runtime bytecode deployed at an EVM address. An address
+// without code is not an error and is reported with Exists false.
+func (q Querier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid address")
+	}
+	if req.Height < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height != 0 {
+		var err error
+		if ctx, err = q.HistoricalContext(ctx, req.Height); err != nil {
+			return nil, err
+		}
+	}
+	addr := common.HexToAddress(req.Address)
+	code := q.Keeper.GetCode(ctx, addr)
+	if code == nil {
+		code = []byte{}
+	}
+	return &types.QueryCodeResponse{
+		Code:      code,
+		CodeHash:  q.Keeper.GetCodeHash(ctx, addr).Hex(),
+		Exists:    len(code) > 0,
+		IsPointer: q.Keeper.evmAddressIsPointer(ctx, addr),
+	}, nil
+}
+
+// Storage reads a storage slot of an EVM account directly from state.
+func (q Querier) Storage(c context.Context, req *types.QueryStorageRequest) (*types.QueryStorageResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid address")
+	}
+	slot, err := hexutil.Decode(req.Slot)
+	if err != nil || len(slot) > common.HashLength {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "slot must be 0x-prefixed hex of at most 32 bytes")
+	}
+	if req.Height < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height != 0 {
+		if ctx, err = q.HistoricalContext(ctx, req.Height); err != nil {
+			return nil, err
+		}
+	}
+	addr := common.HexToAddress(req.Address)
+	return &types.QueryStorageResponse{
+		Value:         q.Keeper.GetState(ctx, addr, common.BytesToHash(slot)).Hex(),
+		AccountExists: q.Keeper.GetCodeHash(ctx, addr) != (common.Hash{}) || q.Keeper.GetNonce(ctx, addr) > 0,
+	}, nil
+}
+
+// Nonce returns the committed nonce of an account given either its EVM or its
+// Sei address.
+func (q Querier) Nonce(c context.Context, req *types.QueryNonceRequest) (*types.QueryNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var addr common.Address
+	var associated bool
+	if common.IsHexAddress(req.Address) {
+		addr = common.HexToAddress(req.Address)
+		_, associated = q.Keeper.GetSeiAddress(ctx, addr)
+	} else {
+		seiAddr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address must be a hex EVM address or a bech32 Sei address")
+		}
+		if addr, associated = q.Keeper.GetEVMAddress(ctx, seiAddr); !associated {
+			addr = q.Keeper.GetEVMAddressOrDefault(ctx, seiAddr)
+		}
+	}
+	return &types.QueryNonceResponse{Nonce: q.Keeper.GetNonce(ctx, addr), EvmAddress: addr.Hex(), Associated: associated}, nil
+}
+
+// PendingNonce returns the committed nonce of an address along with the next
+// nonce not taken by any of its transactions in this node's mempool. Nodes
+// that don't track their mempool report the committed nonce for both.
+func (q Querier) PendingNonce(c context.Context, req *types.QueryPendingNonceRequest) (*types.QueryPendingNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := q.resolveEVMAddress(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryPendingNonceResponse{
+		CommittedNonce:     q.Keeper.GetNonce(ctx, addr),
+		PendingUnavailable: !q.Keeper.PendingNoncesTracked(),
+		EvmAddress:         addr.Hex(),
+	}
+	res.PendingNonce = res.CommittedNonce
+	if !res.PendingUnavailable {
+		res.PendingNonce = q.Keeper.CalculateNextNonce(ctx, addr, true)
+	}
+	return res, nil
+}
+
+// Balance returns the wei balance of an EVM address the same way the EVM sees
+// it, combining its usei bank balance with its wei remainder.
+func (q Querier) Balance(c context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid address")
+	}
+	if req.Height < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height != 0 {
+		var err error
+		if ctx, err = q.HistoricalContext(ctx, req.Height); err != nil {
+			return nil, err
+		}
+	}
+	seiAddr := q.Keeper.GetSeiAddressOrDefault(ctx, common.HexToAddress(req.Address))
+	return &types.QueryBalanceResponse{Balance: q.Keeper.GetBalance(ctx, seiAddr).String(), SeiAddress: seiAddr.String()}, nil
+}
+
+// Account returns the balance, nonce, code and association status of an
+// address in one response. Unknown addresses report zero values.
+func (q Querier) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var evmAddr common.Address
+	var seiAddr sdk.AccAddress
+	var associated bool
+	res := &types.QueryAccountResponse{}
+	if common.IsHexAddress(req.Address) {
+		evmAddr = common.HexToAddress(req.Address)
+		if seiAddr, associated = q.Keeper.GetSeiAddress(ctx, evmAddr); !associated {
+			seiAddr = q.Keeper.GetSeiAddressOrDefault(ctx, evmAddr)
+		}
+		res.CounterpartAddress = seiAddr.String()
+	} else {
+		var err error
+		if seiAddr, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address must be a hex EVM address or a bech32 Sei address")
+		}
+		if evmAddr, associated = q.Keeper.GetEVMAddress(ctx, seiAddr); !associated {
+			evmAddr = q.Keeper.GetEVMAddressOrDefault(ctx, seiAddr)
+		}
+		res.CounterpartAddress = evmAddr.Hex()
+	}
+	res.EvmAddress = evmAddr.Hex()
+	res.WeiBalance = q.Keeper.GetBalance(ctx, seiAddr).String()
+	res.Nonce = q.Keeper.GetNonce(ctx, evmAddr)
+	res.CodeHash = q.Keeper.GetCodeHash(ctx, evmAddr).Hex()
+	res.IsContract = q.Keeper.GetCodeSize(ctx, evmAddr) > 0
+	res.IsPointer = q.Keeper.evmAddressIsPointer(ctx, evmAddr)
+	res.Associated = associated
+	return res, nil
+}
+
+// Receipt returns the stored receipt of an EVM transaction, or of a Cosmos
+// transaction whose events were surfaced to the EVM, by its hash.
+func (q Querier) Receipt(c context.Context, req *types.QueryReceiptRequest) (*types.QueryReceiptResponse, error) {
+	hash, err := hexutil.Decode(req.TxHash)
+	if err != nil || len(hash) != common.HashLength {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tx hash must be 0x-prefixed hex of 32 bytes")
+	}
+	receipt, err := q.Keeper.GetReceipt(sdk.UnwrapSDKContext(c), common.BytesToHash(hash))
 	if err != nil {
+		if errors.Is(err, types.ErrReceiptNotFound) {
+			return &types.QueryReceiptResponse{}, nil
+		}
 		return nil, err
 	}
-	return &types.QueryStaticCallResponse{Data: res}, nil
+	return &types.QueryReceiptResponse{Receipt: receipt, Exists: true, Synthetic: receipt.TxType == shellEVMTxType}, nil
+}
+
+// Params returns the module params along with the code IDs of the stored CW
+// pointer contracts.
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{
+		Params:               q.Keeper.GetParams(ctx),
+		Erc20PointerCodeId:   q.Keeper.GetStoredPointerCodeID(ctx, types.PointerType_ERC20),
+		Erc721PointerCodeId:  q.Keeper.GetStoredPointerCodeID(ctx, types.PointerType_ERC721),
+		Erc1155PointerCodeId: q.Keeper.GetStoredPointerCodeID(ctx, types.PointerType_ERC1155),
+	}, nil
+}
+
+// decodeRevertError decodes standard Error(string) and Panic(uint256) reverts
+// and matches any other revert data against the custom errors declared in
+// errorABIs, falling back to the raw selector and data if none match.
+func decodeRevertError(errorABIs []abi.ABI, data []byte) *types.StaticCallRevertError {
+	res := &types.StaticCallRevertError{Data: data}
+	if len(data) < 4 {
+		return res
+	}
+	res.Selector = data[:4]
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		res.Reason = reason
+		return res
+	}
+	for _, errorABI := range errorABIs {
+		for _, customErr := range errorABI.Errors {
+			if !bytes.Equal(customErr.ID[:4], res.Selector) {
+				continue
+			}
+			args, err := customErr.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			res.Name = customErr.Name
+			for _, arg := range args {
+				res.Params = append(res.Params, fmt.Sprintf("%v", arg))
+			}
+			return res
+		}
+	}
+	return res
 }
 
 func (q Querier) Pointer(c context.Context, req *types.QueryPointerRequest) (*types.QueryPointerResponse, error) {
@@ -84,6 +887,44 @@ func (q Querier) Pointer(c context.Context, req *types.QueryPointerRequest) (*ty
 		return nil, ErrMustSpecifyPointee
 	}
 	ctx := sdk.UnwrapSDKContext(c)
+	if req.AllTypes {
+		pointers := q.Keeper.GetPointersOfPointee(ctx, req.Pointee)
+		return &types.QueryPointerResponse{Exists: len(pointers) > 0, Pointers: pointers}, nil
+	}
+	res, err := q.pointer(ctx, req)
+	if err != nil || !res.Exists {
+		return res, err
+	}
+	res.Paused = q.Keeper.IsPointerPaused(ctx, pointerRegistryAddress(res.Pointer))
+	if !req.IncludeContractInfo {
+		return res, nil
+	}
+	if req.PointerType != types.PointerType_NATIVE {
+		res.PointeeContractInfo = q.pointerContractInfo(ctx, req.Pointee)
+	}
+	res.PointerContractInfo = q.pointerContractInfo(ctx, res.Pointer)
+	return res, nil
+}
+
+// pointerContractInfo returns the wasm contract info of a bech32 address, or
+// the code hash of a hex address. It returns nil for a bech32 address that is
+// not a wasm contract.
+func (q Querier) pointerContractInfo(ctx sdk.Context, addr string) *types.PointerContractInfo {
+	if common.IsHexAddress(addr) {
+		return &types.PointerContractInfo{CodeHash: q.Keeper.GetCodeHash(ctx, common.HexToAddress(addr)).Hex()}
+	}
+	seiAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil
+	}
+	info := q.wasmViewKeeper.GetContractInfo(ctx, seiAddr)
+	if info == nil {
+		return nil
+	}
+	return &types.PointerContractInfo{CodeId: info.CodeID, Creator: info.Creator, Admin: info.Admin, Label: info.Label}
+}
+
+func (q Querier) pointer(ctx sdk.Context, req *types.QueryPointerRequest) (*types.QueryPointerResponse, error) {
 	switch req.PointerType {
 	case types.PointerType_NATIVE:
 		p, v, e := q.Keeper.GetERC20NativePointer(ctx, req.Pointee)
@@ -160,29 +1001,139 @@ func (q Querier) Pointer(c context.Context, req *types.QueryPointerRequest) (*ty
 	}
 }
 
-func (q Querier) PointerVersion(c context.Context, req *types.QueryPointerVersionRequest) (*types.QueryPointerVersionResponse, error) {
+// PointersByPointees looks up the pointers of a batch of pointees in request
+// order. Malformed pointees are reported in their result without failing the
+// batch.
+func (q Querier) PointersByPointees(c context.Context, req *types.QueryPointersByPointeesRequest) (*types.QueryPointersByPointeesResponse, error) {
+	if len(req.Queries) > MaxPointerBatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot look up more than %d pointers at once", MaxPointerBatchSize)
+	}
 	ctx := sdk.UnwrapSDKContext(c)
-	switch req.PointerType {
-	case types.PointerType_NATIVE:
-		return &types.QueryPointerVersionResponse{
-			Version: uint32(native.CurrentVersion),
-		}, nil
-	case types.PointerType_CW20:
-		return &types.QueryPointerVersionResponse{
-			Version: uint32(cw20.CurrentVersion(ctx)),
-		}, nil
-	case types.PointerType_CW721:
-		return &types.QueryPointerVersionResponse{
-			Version: uint32(cw721.CurrentVersion),
-		}, nil
-	case types.PointerType_CW1155:
-		return &types.QueryPointerVersionResponse{
-			Version: uint32(cw1155.CurrentVersion),
-		}, nil
-	case types.PointerType_ERC20:
-		return &types.QueryPointerVersionResponse{
-			Version:  uint32(erc20.CurrentVersion),
-			CwCodeId: q.GetStoredPointerCodeID(ctx, types.PointerType_ERC20),
+	res := &types.QueryPointersByPointeesResponse{Results: make([]*types.PointerLookupResult, 0, len(req.Queries))}
+	for _, lookup := range req.Queries {
+		if lookup == nil {
+			lookup = &types.QueryPointerRequest{}
+		}
+		result := &types.PointerLookupResult{PointerType: lookup.PointerType, Pointee: lookup.Pointee}
+		res.Results = append(res.Results, result)
+		if err := validatePointee(lookup.PointerType, lookup.Pointee); err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		pointer, err := q.Pointer(sdk.WrapSDKContext(ctx), lookup)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.Pointer, result.Version, result.Exists = pointer.Pointer, pointer.Version, pointer.Exists
+	}
+	return res, nil
+}
+
+// PointeesByPointers looks up the pointees of a batch of pointers in request
+// order. Malformed pointers are reported in their result without failing the
+// batch.
+func (q Querier) PointeesByPointers(c context.Context, req *types.QueryPointeesByPointersRequest) (*types.QueryPointeesByPointersResponse, error) {
+	if len(req.Queries) > MaxPointerBatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot look up more than %d pointers at once", MaxPointerBatchSize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryPointeesByPointersResponse{Results: make([]*types.PointeeLookupResult, 0, len(req.Queries))}
+	for _, lookup := range req.Queries {
+		if lookup == nil {
+			lookup = &types.PointeeLookup{}
+		}
+		result := &types.PointeeLookupResult{PointerType: lookup.PointerType, Pointer: lookup.Pointer}
+		res.Results = append(res.Results, result)
+		addr, err := parsePointer(lookup.PointerType, lookup.Pointer, lookup.AnyType)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		entry, pointerType, ok := q.LookupPointer(ctx, addr)
+		if !ok || (!lookup.AnyType && pointerType != lookup.PointerType) {
+			continue
+		}
+		result.PointerType, result.Pointee, result.Version, result.Exists = pointerType, entry.Pointee, entry.Version, true
+	}
+	return res, nil
+}
+
+// parsePointer returns the reverse registry address of pointer, which must be
+// a hex address for pointer types backed by an EVM contract and a bech32
+// address for those backed by a CW contract. Either form is accepted if
+// anyType is set.
+func parsePointer(pointerType types.PointerType, pointer string, anyType bool) (common.Address, error) {
+	switch {
+	case anyType:
+		if common.IsHexAddress(pointer) {
+			return common.HexToAddress(pointer), nil
+		}
+		if seiAddr, err := sdk.AccAddressFromBech32(pointer); err == nil {
+			return common.BytesToAddress([]byte(seiAddr.String())), nil
+		}
+		return common.Address{}, errors.New("invalid hex or bech32 address")
+	case pointerType == types.PointerType_NATIVE, pointerType == types.PointerType_CW20, pointerType == types.PointerType_CW721, pointerType == types.PointerType_CW1155:
+		if !common.IsHexAddress(pointer) {
+			return common.Address{}, errors.New("invalid hex address")
+		}
+		return common.HexToAddress(pointer), nil
+	case pointerType == types.PointerType_ERC20, pointerType == types.PointerType_ERC721, pointerType == types.PointerType_ERC1155:
+		seiAddr, err := sdk.AccAddressFromBech32(pointer)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return common.BytesToAddress([]byte(seiAddr.String())), nil
+	default:
+		return common.Address{}, errors.ErrUnsupported
+	}
+}
+
+// validatePointee checks that pointee is a well-formed address or denom for
+// pointerType.
+func validatePointee(pointerType types.PointerType, pointee string) error {
+	switch pointerType {
+	case types.PointerType_NATIVE:
+		if err := sdk.ValidateDenom(pointee); err != nil {
+			return err
+		}
+	case types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155:
+		if _, err := sdk.AccAddressFromBech32(pointee); err != nil {
+			return err
+		}
+	case types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155:
+		if !common.IsHexAddress(pointee) {
+			return errors.New("invalid hex address")
+		}
+	default:
+		return errors.ErrUnsupported
+	}
+	return nil
+}
+
+func (q Querier) PointerVersion(c context.Context, req *types.QueryPointerVersionRequest) (*types.QueryPointerVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	switch req.PointerType {
+	case types.PointerType_NATIVE:
+		return &types.QueryPointerVersionResponse{
+			Version: uint32(native.CurrentVersion),
+		}, nil
+	case types.PointerType_CW20:
+		return &types.QueryPointerVersionResponse{
+			Version: uint32(cw20.CurrentVersion(ctx)),
+		}, nil
+	case types.PointerType_CW721:
+		return &types.QueryPointerVersionResponse{
+			Version: uint32(cw721.CurrentVersion),
+		}, nil
+	case types.PointerType_CW1155:
+		return &types.QueryPointerVersionResponse{
+			Version: uint32(cw1155.CurrentVersion),
+		}, nil
+	case types.PointerType_ERC20:
+		return &types.QueryPointerVersionResponse{
+			Version:  uint32(erc20.CurrentVersion),
+			CwCodeId: q.GetStoredPointerCodeID(ctx, types.PointerType_ERC20),
 		}, nil
 	case types.PointerType_ERC721:
 		return &types.QueryPointerVersionResponse{
@@ -199,11 +1150,177 @@ func (q Querier) PointerVersion(c context.Context, req *types.QueryPointerVersio
 	}
 }
 
+// PointerVersions returns the current version of every pointer type along with
+// the module's consensus version.
+func (q Querier) PointerVersions(c context.Context, _ *types.QueryPointerVersionsRequest) (*types.QueryPointerVersionsResponse, error) {
+	res := &types.QueryPointerVersionsResponse{
+		Versions:         make([]*types.PointerVersionEntry, 0, len(types.PointerType_name)),
+		ConsensusVersion: types.ConsensusVersion,
+	}
+	for i := 0; i < len(types.PointerType_name); i++ {
+		pointerType := types.PointerType(i)
+		version, err := q.PointerVersion(c, &types.QueryPointerVersionRequest{PointerType: pointerType})
+		if err != nil {
+			return nil, err
+		}
+		res.Versions = append(res.Versions, &types.PointerVersionEntry{PointerType: pointerType, Version: version.Version, CwCodeId: version.CwCodeId})
+	}
+	return res, nil
+}
+
+// PointerRegistrationAllowlist returns the addresses allowed to register
+// pointers, which is empty if registration is permissionless.
+func (q Querier) PointerRegistrationAllowlist(c context.Context, _ *types.QueryPointerRegistrationAllowlistRequest) (*types.QueryPointerRegistrationAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	allowlist := q.GetPointerRegistrationAllowlist(ctx)
+	return &types.QueryPointerRegistrationAllowlistResponse{
+		Addresses:      allowlist,
+		Permissionless: len(allowlist) == 0,
+	}, nil
+}
+
+// PointerCodeIDs returns the code ID of every stored version of the CW pointer
+// code of a pointer type, including versions since upgraded.
+func (q Querier) PointerCodeIDs(c context.Context, req *types.QueryPointerCodeIDsRequest) (*types.QueryPointerCodeIDsResponse, error) {
+	res := &types.QueryPointerCodeIDsResponse{}
+	switch req.PointerType {
+	case types.PointerType_ERC20:
+		res.CurrentVersion = uint32(erc20.CurrentVersion)
+	case types.PointerType_ERC721:
+		res.CurrentVersion = uint32(erc721.CurrentVersion)
+	case types.PointerType_ERC1155:
+		res.CurrentVersion = uint32(erc1155.CurrentVersion)
+	default:
+		return nil, errors.ErrUnsupported
+	}
+	q.IteratePointerCodeIDs(sdk.UnwrapSDKContext(c), req.PointerType, func(version uint16, codeID uint64) bool {
+		res.CodeIds = append(res.CodeIds, &types.PointerCodeID{Version: uint32(version), CodeId: codeID})
+		return false
+	})
+	return res, nil
+}
+
+// PointerArtifact returns the pointer contract this binary deploys for a
+// pointer type and version, and optionally whether a deployed pointer matches
+// it. Only the current version of each pointer type is shipped.
+func (q Querier) PointerArtifact(c context.Context, req *types.QueryPointerArtifactRequest) (*types.QueryPointerArtifactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var version uint16
+	var artifactType string
+	var wasm []byte
+	switch req.PointerType {
+	case types.PointerType_NATIVE:
+		version, artifactType = native.CurrentVersion, "native"
+	case types.PointerType_CW20:
+		version, artifactType = cw20.CurrentVersion(ctx), "cw20"
+	case types.PointerType_CW721:
+		version, artifactType = cw721.CurrentVersion, "cw721"
+	case types.PointerType_CW1155:
+		version, artifactType = cw1155.CurrentVersion, "cw1155"
+	case types.PointerType_ERC20:
+		version, wasm = erc20.CurrentVersion, erc20.GetBin()
+	case types.PointerType_ERC721:
+		version, wasm = erc721.CurrentVersion, erc721.GetBin()
+	case types.PointerType_ERC1155:
+		version, wasm = erc1155.CurrentVersion, erc1155.GetBin()
+	default:
+		return nil, errors.ErrUnsupported
+	}
+	if req.Version != uint32(version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown %s pointer version %d, known versions: [%d]", req.PointerType, req.Version, version)
+	}
+	res := &types.QueryPointerArtifactResponse{}
+	if wasm != nil {
+		checksum := sha256.Sum256(wasm)
+		res.WasmCodeHash = hex.EncodeToString(checksum[:])
+		if req.Address == "" {
+			return res, nil
+		}
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a bech32 address", req.Address)
+		}
+		if info := q.wasmViewKeeper.GetContractInfo(ctx, addr); info != nil {
+			codeInfo := q.wasmViewKeeper.GetCodeInfo(ctx, info.CodeID)
+			res.Matches = codeInfo != nil && bytes.Equal(codeInfo.CodeHash, checksum[:])
+		}
+		return res, nil
+	}
+	code, err := q.PointerDeploymentCode(ctx, artifactType)
+	if err != nil {
+		return nil, err
+	}
+	res.Bytecode = code
+	if req.Address == "" {
+		return res, nil
+	}
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a hex address", req.Address)
+	}
+	res.Matches = bytes.Equal(q.Keeper.GetCode(ctx, common.HexToAddress(req.Address)), code)
+	return res, nil
+}
+
+// PointersByCodeID matches a code ID against the current and historical CW
+// pointer code IDs and, if it is one, pages through the registered pointers
+// instantiated from it. Pointers of other code IDs are skipped and do not count
+// towards the page limit.
+func (q Querier) PointersByCodeID(c context.Context, req *types.QueryPointersByCodeIDRequest) (*types.QueryPointersByCodeIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryPointersByCodeIDResponse{}
+	for _, pointerType := range []types.PointerType{types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155} {
+		q.IteratePointerCodeIDs(ctx, pointerType, func(version uint16, codeID uint64) bool {
+			if codeID == req.CodeId {
+				res.IsPointerCode, res.PointerType, res.Version = true, pointerType, uint32(version)
+			}
+			return res.IsPointerCode
+		})
+		if res.IsPointerCode {
+			break
+		}
+	}
+	if !res.IsPointerCode {
+		return res, nil
+	}
+	store, _ := q.PointerRegistryStore(ctx, res.PointerType)
+	pageRes, err := query.FilteredPaginate(store, q.boundedPageRequest(req.Pagination), func(key []byte, value []byte, accumulate bool) (bool, error) {
+		entry, err := DecodePointerRegistryEntry(res.PointerType, key, value)
+		if err != nil {
+			return false, err
+		}
+		pointer, err := sdk.AccAddressFromBech32(entry.Pointer)
+		if err != nil {
+			return false, nil
+		}
+		if info := q.wasmViewKeeper.GetContractInfo(ctx, pointer); info == nil || info.CodeID != req.CodeId {
+			return false, nil
+		}
+		if accumulate {
+			res.Pointers = append(res.Pointers, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
 func (q Querier) Pointee(c context.Context, req *types.QueryPointeeRequest) (*types.QueryPointeeResponse, error) {
 	if req.Pointer == "" {
 		return nil, ErrMustSpecifyPointer
 	}
 	ctx := sdk.UnwrapSDKContext(c)
+	res, err := q.pointee(ctx, req)
+	if err != nil || !res.Exists {
+		return res, err
+	}
+	res.Paused = q.Keeper.IsPointerPaused(ctx, pointerRegistryAddress(req.Pointer))
+	return res, nil
+}
+
+func (q Querier) pointee(ctx sdk.Context, req *types.QueryPointeeRequest) (*types.QueryPointeeResponse, error) {
 	switch req.PointerType {
 	case types.PointerType_NATIVE:
 		p, v, e := q.Keeper.GetNativePointee(ctx, req.Pointer)
@@ -279,3 +1396,1451 @@ func (q Querier) Pointee(c context.Context, req *types.QueryPointeeRequest) (*ty
 		return nil, errors.ErrUnsupported
 	}
 }
+
+func (q Querier) PointerDisplayMetadata(c context.Context, req *types.QueryPointerDisplayMetadataRequest) (*types.QueryPointerDisplayMetadataResponse, error) {
+	if req.Pointee == "" {
+		return nil, ErrMustSpecifyPointee
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	res := &types.QueryPointerDisplayMetadataResponse{}
+	var token common.Address
+	hasDecimals := false
+	switch req.PointerType {
+	case types.PointerType_NATIVE:
+		p, _, e := q.Keeper.GetERC20NativePointer(ctx, req.Pointee)
+		if !e {
+			return &types.QueryPointerDisplayMetadataResponse{Exists: e}, nil
+		}
+		res.Pointer, res.Exists = p.Hex(), e
+		if md, found := q.BankKeeper().GetDenomMetaData(ctx, req.Pointee); found {
+			res.Name, res.Symbol, res.Display, res.Description = md.Name, md.Symbol, md.Display, md.Description
+			for _, unit := range md.DenomUnits {
+				if unit.Denom == md.Display {
+					res.Decimals, hasDecimals = unit.Exponent, true
+				}
+			}
+		}
+		token = p
+	case types.PointerType_CW20:
+		p, _, e := q.Keeper.GetERC20CW20Pointer(ctx, req.Pointee)
+		if !e {
+			return &types.QueryPointerDisplayMetadataResponse{Exists: e}, nil
+		}
+		res.Pointer, res.Exists = p.Hex(), e
+		token = p
+	case types.PointerType_ERC20:
+		p, _, e := q.Keeper.GetCW20ERC20Pointer(ctx, common.HexToAddress(req.Pointee))
+		if !e {
+			return &types.QueryPointerDisplayMetadataResponse{Exists: e}, nil
+		}
+		res.Pointer, res.Exists = p.String(), e
+		token = common.HexToAddress(req.Pointee)
+	default:
+		return nil, errors.ErrUnsupported
+	}
+	// fill in whatever bank metadata didn't provide from the token contract;
+	// fields that the contract fails to return are left empty
+	if res.Name == "" {
+		if name, ok := q.queryTokenMetadata(ctx, "native", token, "name"); ok {
+			res.Name, _ = name.(string)
+		}
+	}
+	if res.Symbol == "" {
+		if symbol, ok := q.queryTokenMetadata(ctx, "native", token, "symbol"); ok {
+			res.Symbol, _ = symbol.(string)
+		}
+	}
+	if !hasDecimals {
+		if decimals, ok := q.queryTokenMetadata(ctx, "native", token, "decimals"); ok {
+			if d, ok := decimals.(uint8); ok {
+				res.Decimals = uint32(d)
+			}
+		}
+	}
+	return res, nil
+}
+
+// queryTokenMetadata is like QueryERCSingleOutput but only logs at debug level,
+// since tokens not implementing the optional ERC20 metadata methods are routine
+// for display queries.
+func (q Querier) queryTokenMetadata(ctx sdk.Context, artifactType string, token common.Address, method string) (interface{}, bool) {
+	abi := artifacts.GetParsedABI(artifactType)
+	input, _ := abi.Pack(method)
+	ret, err := q.StaticCallEVM(ctx, q.AccountKeeper().GetModuleAddress(types.ModuleName), &token, input)
+	if err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("token %s did not return %s: %s", token.Hex(), method, err))
+		return nil, false
+	}
+	o, err := abi.Unpack(method, ret)
+	if err != nil || len(o) != 1 {
+		ctx.Logger().Debug(fmt.Sprintf("token %s returned malformed %s", token.Hex(), method))
+		return nil, false
+	}
+	return o[0], true
+}
+
+// PointerMetadata resolves a pointer and reads its token metadata: through EVM
+// calls for ERC pointer contracts and wasm smart queries for CW pointer
+// contracts. Fields the pointer contract fails to return are left empty.
+func (q Querier) PointerMetadata(c context.Context, req *types.QueryPointerMetadataRequest) (*types.QueryPointerMetadataResponse, error) {
+	if req.PointerType == types.PointerType_ERC1155 {
+		return nil, errors.ErrUnsupported
+	}
+	pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: req.PointerType, Pointee: req.Pointee})
+	if err != nil {
+		return nil, err
+	}
+	if !pointer.Exists {
+		return &types.QueryPointerMetadataResponse{Exists: false}, nil
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	res := &types.QueryPointerMetadataResponse{Pointer: pointer.Pointer, Exists: true}
+	switch req.PointerType {
+	case types.PointerType_NATIVE, types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155:
+		artifactType := map[types.PointerType]string{
+			types.PointerType_NATIVE: "native",
+			types.PointerType_CW20:   "cw20",
+			types.PointerType_CW721:  "cw721",
+			types.PointerType_CW1155: "cw1155",
+		}[req.PointerType]
+		token := common.HexToAddress(pointer.Pointer)
+		if name, ok := q.queryTokenMetadata(ctx, artifactType, token, "name"); ok {
+			res.Name, _ = name.(string)
+		}
+		if symbol, ok := q.queryTokenMetadata(ctx, artifactType, token, "symbol"); ok {
+			res.Symbol, _ = symbol.(string)
+		}
+		if _, ok := artifacts.GetParsedABI(artifactType).Methods["decimals"]; ok {
+			if decimals, ok := q.queryTokenMetadata(ctx, artifactType, token, "decimals"); ok {
+				if d, ok := decimals.(uint8); ok {
+					res.Decimals = uint32(d)
+				}
+			}
+		}
+		if supply, ok := q.queryTokenMetadata(ctx, artifactType, token, "totalSupply"); ok {
+			if s, ok := supply.(*big.Int); ok {
+				res.TotalSupply = s.String()
+			}
+		}
+		if req.PointerType == types.PointerType_CW20 {
+			marketing, err := q.GetCW20MarketingInfo(ctx, req.Pointee)
+			if err != nil {
+				return nil, err
+			}
+			res.Project, res.Description, res.Logo = marketing.Project, marketing.Description, marketing.Logo
+		}
+	case types.PointerType_ERC20, types.PointerType_ERC721:
+		contract, err := sdk.AccAddressFromBech32(pointer.Pointer)
+		if err != nil {
+			return nil, err
+		}
+		if req.PointerType == types.PointerType_ERC20 {
+			info := struct {
+				Name        string `json:"name"`
+				Symbol      string `json:"symbol"`
+				Decimals    uint32 `json:"decimals"`
+				TotalSupply string `json:"total_supply"`
+			}{}
+			if q.queryCWTokenMetadata(ctx, contract, `{"token_info":{}}`, &info) {
+				res.Name, res.Symbol, res.Decimals, res.TotalSupply = info.Name, info.Symbol, info.Decimals, info.TotalSupply
+			}
+		} else {
+			info := struct {
+				Name   string `json:"name"`
+				Symbol string `json:"symbol"`
+			}{}
+			if q.queryCWTokenMetadata(ctx, contract, `{"contract_info":{}}`, &info) {
+				res.Name, res.Symbol = info.Name, info.Symbol
+			}
+			numTokens := struct {
+				Count uint64 `json:"count"`
+			}{}
+			if q.queryCWTokenMetadata(ctx, contract, `{"num_tokens":{}}`, &numTokens) {
+				res.TotalSupply = fmt.Sprint(numTokens.Count)
+			}
+		}
+	default:
+		return nil, errors.ErrUnsupported
+	}
+	return res, nil
+}
+
+// NativePointerMetadata resolves a denom or its ERC20 pointer to the pointer
+// and the denom's bank metadata. Denoms without bank metadata are returned
+// with empty metadata.
+func (q Querier) NativePointerMetadata(c context.Context, req *types.QueryNativePointerMetadataRequest) (*types.QueryNativePointerMetadataResponse, error) {
+	if req.DenomOrPointer == "" {
+		return nil, ErrMustSpecifyPointee
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pointer, denom, version, exists := q.resolveNativePointer(ctx, req.DenomOrPointer)
+	if !exists {
+		return &types.QueryNativePointerMetadataResponse{}, nil
+	}
+	res := &types.QueryNativePointerMetadataResponse{
+		Exists: true, Pointer: pointer.Hex(), Denom: denom, Version: uint32(version),
+		DecimalsFromMetadata: q.Keeper.NativePointerDecimalsFromMetadata(ctx, denom),
+	}
+	if md, found := q.BankKeeper().GetDenomMetaData(ctx, denom); found {
+		res.Metadata = md
+		for _, unit := range md.DenomUnits {
+			if unit.Denom == md.Display {
+				res.DisplayExponent = unit.Exponent
+			}
+		}
+	}
+	return res, nil
+}
+
+// NativePointerSupply returns the bank supply of a denom with a native pointer
+// both as the raw amount the pointer's totalSupply() reports and scaled by the
+// pointer's decimals.
+func (q Querier) NativePointerSupply(c context.Context, req *types.QueryNativePointerSupplyRequest) (*types.QueryNativePointerSupplyResponse, error) {
+	if req.DenomOrPointer == "" {
+		return nil, ErrMustSpecifyPointee
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	pointer, denom, _, exists := q.resolveNativePointer(ctx, req.DenomOrPointer)
+	if !exists {
+		return &types.QueryNativePointerSupplyResponse{}, nil
+	}
+	var decimals uint8
+	if d, ok := q.queryTokenMetadata(ctx, "native", pointer, "decimals"); ok {
+		decimals, _ = d.(uint8)
+	}
+	amount := q.BankKeeper().GetSupply(ctx, denom).Amount
+	return &types.QueryNativePointerSupplyResponse{
+		Exists:    true,
+		Pointer:   pointer.Hex(),
+		Denom:     denom,
+		RawSupply: amount.String(),
+		Decimals:  uint32(decimals),
+		Supply:    formatUnits(amount.BigInt(), decimals),
+	}, nil
+}
+
+// resolveNativePointer returns the native pointer and denom identified by
+// denomOrPointer, which is either a bank denom or the hex address of its
+// pointer.
+func (q Querier) resolveNativePointer(ctx sdk.Context, denomOrPointer string) (pointer common.Address, denom string, version uint16, exists bool) {
+	if !common.IsHexAddress(denomOrPointer) {
+		denom = denomOrPointer
+		pointer, version, exists = q.Keeper.GetERC20NativePointer(ctx, denom)
+		return
+	}
+	pointer = common.HexToAddress(denomOrPointer)
+	denom, version, exists = q.Keeper.GetNativePointee(ctx, pointer.Hex())
+	// the reverse registry is shared by all pointer types, so make sure
+	// the pointee is a denom pointed to by this address
+	if exists {
+		forward, _, ok := q.Keeper.GetERC20NativePointer(ctx, denom)
+		exists = ok && forward == pointer
+	}
+	return
+}
+
+// formatUnits renders amount, a number of base units, as a decimal number of
+// whole tokens with the given number of decimals.
+func formatUnits(amount *big.Int, decimals uint8) string {
+	s := amount.String()
+	if decimals == 0 {
+		return s
+	}
+	d := int(decimals)
+	if len(s) <= d {
+		s = strings.Repeat("0", d-len(s)+1) + s
+	}
+	return s[:len(s)-d] + "." + s[len(s)-d:]
+}
+
+// queryCWTokenMetadata runs a wasm smart query against a CW contract
+// and decodes the response into out, logging failures at debug level.
+func (k *Keeper) queryCWTokenMetadata(ctx sdk.Context, contract sdk.AccAddress, msg string, out interface{}) bool {
+	ret, err := k.wasmViewKeeper.QuerySmartSafe(ctx, contract, []byte(msg))
+	if err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("contract %s did not answer %s: %s", contract, msg, err))
+		return false
+	}
+	if err := json.Unmarshal(ret, out); err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("contract %s returned malformed %s", contract, msg))
+		return false
+	}
+	return true
+}
+
+// checkReceiptRangeAvailable returns an error if receipts from fromHeight on
+// can't all be read by block, either because they were flushed before the
+// block index existed or because they have been pruned, so that range queries
+// never report such heights as empty.
+func (q Querier) checkReceiptRangeAvailable(fromHeight int64) error {
+	startHeight, err := q.GetReceiptIndexStartHeight()
+	if err != nil {
+		return err
+	}
+	if startHeight == 0 || fromHeight < startHeight {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "receipts are only indexed by block from height %d", startHeight)
+	}
+	prunedBefore, err := q.GetReceiptsPrunedBefore()
+	if err != nil {
+		return err
+	}
+	if fromHeight < prunedBefore {
+		return sdkerrors.Wrapf(types.ErrReceiptPruned, "receipts are only retained from height %d", prunedBefore)
+	}
+	return nil
+}
+
+// ContractTxParticipants returns the distinct addresses that took part in the
+// same transactions as a contract over a block range: the senders, recipients,
+// created contracts and log emitters of every receipt the contract appears in.
+// Receipts don't record internal calls, so this is co-participation rather than
+// a call graph; internal calls that emit no logs are not visible.
+func (q Querier) ContractTxParticipants(c context.Context, req *types.QueryContractTxParticipantsRequest) (*types.QueryContractTxParticipantsResponse, error) {
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid contract address")
+	}
+	if req.FromHeight <= 0 || req.ToHeight < req.FromHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block range [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+	if uint64(req.ToHeight-req.FromHeight+1) > q.QueryConfig.MaxContractTxParticipantsBlockRange {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "block range cannot exceed %d", q.QueryConfig.MaxContractTxParticipantsBlockRange)
+	}
+	if err := q.checkReceiptRangeAvailable(req.FromHeight); err != nil {
+		return nil, err
+	}
+	contract := common.HexToAddress(req.Address)
+	// distinct participants are collected into a sorted in-memory store so
+	// that the standard pagination helper can be used
+	participants := dbadapter.Store{DB: dbm.NewMemDB()}
+	gasConfig := storetypes.KVGasConfig()
+	if err := q.IterateReceiptsInRange(req.FromHeight, req.ToHeight, func(r *types.Receipt) bool {
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat+gasConfig.ReadCostPerByte*uint64(r.Size()), "contract tx participants")
+		involved := []string{r.From, r.To, r.ContractAddress}
+		for _, l := range r.Logs {
+			involved = append(involved, l.Address)
+		}
+		others := []common.Address{}
+		isInvolved := false
+		for _, a := range involved {
+			if a == "" {
+				continue
+			}
+			addr := common.HexToAddress(a)
+			if addr == contract {
+				isInvolved = true
+				continue
+			}
+			others = append(others, addr)
+		}
+		if isInvolved {
+			for _, addr := range others {
+				participants.Set(addr.Bytes(), []byte{})
+			}
+		}
+		return false
+	}); err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryContractTxParticipantsResponse{}
+	pageRes, err := query.Paginate(participants, q.boundedPageRequest(req.Pagination), func(key []byte, _ []byte) error {
+		res.Addresses = append(res.Addresses, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// Logs returns the logs emitted in a range of blocks that match the given
+// addresses and topics, with the semantics of eth_getLogs. Synthetic logs of
+// pointer contracts are included.
+func (q Querier) Logs(c context.Context, req *types.QueryLogsRequest) (*types.QueryLogsResponse, error) {
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	if req.FromHeight <= 0 || req.ToHeight < req.FromHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block range [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+	if uint64(req.ToHeight-req.FromHeight+1) > q.QueryConfig.MaxLogsBlockRange {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "block range cannot exceed %d", q.QueryConfig.MaxLogsBlockRange)
+	}
+	addresses := make(map[common.Address]struct{}, len(req.Addresses))
+	for _, a := range req.Addresses {
+		if !common.IsHexAddress(a) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid address %s", a)
+		}
+		addresses[common.HexToAddress(a)] = struct{}{}
+	}
+	if len(req.Topics) > MaxLogTopics {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot filter on more than %d topics", MaxLogTopics)
+	}
+	topics := make([][]common.Hash, 0, len(req.Topics))
+	for _, filter := range req.Topics {
+		position := make([]common.Hash, 0, len(filter.GetTopics()))
+		for _, t := range filter.GetTopics() {
+			bz, err := hexutil.Decode(t)
+			if err != nil || len(bz) != common.HashLength {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid topic %s", t)
+			}
+			position = append(position, common.BytesToHash(bz))
+		}
+		topics = append(topics, position)
+	}
+	if err := q.checkReceiptRangeAvailable(req.FromHeight); err != nil {
+		return nil, err
+	}
+	// matching logs are collected into an in-memory store keyed by their
+	// position in the chain so that the standard pagination helper can be used
+	logs := dbadapter.Store{DB: dbm.NewMemDB()}
+	gasConfig := storetypes.KVGasConfig()
+	var marshalErr error
+	if err := q.IterateReceiptsInRange(req.FromHeight, req.ToHeight, func(r *types.Receipt) bool {
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat+gasConfig.ReadCostPerByte*uint64(r.Size()), "logs")
+		for _, l := range r.Logs {
+			if !logMatches(l, addresses, topics) {
+				continue
+			}
+			entry := &types.LogEntry{
+				Address:     l.Address,
+				Topics:      l.Topics,
+				Data:        l.Data,
+				BlockHeight: int64(r.BlockNumber),
+				TxHash:      r.TxHashHex,
+				TxIndex:     r.TransactionIndex,
+				LogIndex:    l.Index,
+				Synthetic:   l.Synthetic,
+			}
+			bz, err := entry.Marshal()
+			if err != nil {
+				marshalErr = err
+				return true
+			}
+			key := make([]byte, 16)
+			binary.BigEndian.PutUint64(key, r.BlockNumber)
+			binary.BigEndian.PutUint32(key[8:], r.TransactionIndex)
+			binary.BigEndian.PutUint32(key[12:], l.Index)
+			logs.Set(key, bz)
+		}
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	res := &types.QueryLogsResponse{}
+	pageRes, err := query.Paginate(logs, q.boundedPageRequest(req.Pagination), func(_ []byte, value []byte) error {
+		entry := &types.LogEntry{}
+		if err := entry.Unmarshal(value); err != nil {
+			return err
+		}
+		res.Logs = append(res.Logs, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// logMatches reports whether a log was emitted by one of addresses, if any
+// are given, and has, at every filtered position, one of the topics filtered
+// for there.
+func logMatches(l *types.Log, addresses map[common.Address]struct{}, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		if _, ok := addresses[common.HexToAddress(l.Address)]; !ok {
+			return false
+		}
+	}
+	if len(topics) > len(l.Topics) {
+		return false
+	}
+	for i, position := range topics {
+		if len(position) == 0 {
+			continue
+		}
+		topic := common.HexToHash(l.Topics[i])
+		matched := false
+		for _, t := range position {
+			if t == topic {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (q Querier) ChainStats(c context.Context, _ *types.QueryChainStatsRequest) (*types.QueryChainStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryChainStatsResponse{
+		EvmTransactions:     q.GetChainStat(ctx, types.ChainStatsEVMTxCountKey),
+		ContractsDeployed:   q.GetChainStat(ctx, types.ChainStatsContractCountKey),
+		Associations:        q.GetChainStat(ctx, types.ChainStatsAssociationCountKey),
+		Pointers:            q.GetChainStat(ctx, types.ChainStatsPointerCountKey),
+		CountingSinceHeight: int64(q.GetChainStat(ctx, types.ChainStatsStartHeightKey)),
+	}, nil
+}
+
+// PointerStats returns the number of registered pointees of every pointer type
+// along with the number of address associations, read from counters kept up
+// to date on registration and deletion.
+func (q Querier) PointerStats(c context.Context, _ *types.QueryPointerStatsRequest) (*types.QueryPointerStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryPointerStatsResponse{
+		Counts:       make([]*types.PointerTypeCount, 0, len(types.PointerType_name)),
+		Total:        q.GetChainStat(ctx, types.ChainStatsPointerCountKey),
+		Associations: q.GetChainStat(ctx, types.ChainStatsAssociationCountKey),
+	}
+	for i := 0; i < len(types.PointerType_name); i++ {
+		pointerType := types.PointerType(i)
+		res.Counts = append(res.Counts, &types.PointerTypeCount{PointerType: pointerType, Count: q.GetPointerCount(ctx, pointerType)})
+	}
+	return res, nil
+}
+
+// AssociationStats returns the number of address associations and how many of
+// them were added in the most recent blocks, by default the whole tracked
+// window.
+func (q Querier) AssociationStats(c context.Context, req *types.QueryAssociationStatsRequest) (*types.QueryAssociationStatsResponse, error) {
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = types.AssociationStatsWindow
+	}
+	if blocks > types.AssociationStatsWindow {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "blocks cannot exceed %d", types.AssociationStatsWindow)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAssociationStatsResponse{
+		Total:            q.GetChainStat(ctx, types.ChainStatsAssociationCountKey),
+		AddedLastNBlocks: q.GetRecentAssociationCount(ctx, int64(blocks)),
+		Blocks:           blocks,
+	}, nil
+}
+
+// EVMAddressByPubkey derives the EVM and Sei addresses of a compressed
+// secp256k1 public key the same way association does, without requiring the
+// key to have signed anything.
+func (q Querier) EVMAddressByPubkey(c context.Context, req *types.QueryEVMAddressByPubkeyRequest) (*types.QueryEVMAddressByPubkeyResponse, error) {
+	pubkey, err := decodeCompressedPubkey(req.Pubkey)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	evmAddr, seiAddr, _, err := helpers.GetAddressesFromPubkeyBytes(pubkey.SerializeUncompressed())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	_, associated := q.GetSeiAddress(sdk.UnwrapSDKContext(c), evmAddr)
+	return &types.QueryEVMAddressByPubkeyResponse{
+		EvmAddress: evmAddr.Hex(),
+		SeiAddress: seiAddr.String(),
+		Associated: associated,
+	}, nil
+}
+
+// decodeCompressedPubkey parses a hex or base64 encoded compressed secp256k1
+// public key.
+func decodeCompressedPubkey(s string) (*btcec.PublicKey, error) {
+	if s == "" {
+		return nil, errors.New("must specify a public key")
+	}
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		if bz, err = base64.StdEncoding.DecodeString(s); err != nil {
+			return nil, fmt.Errorf("public key %q is neither hex nor base64 encoded", s)
+		}
+	}
+	switch {
+	case len(bz) == ed25519.PubKeySize:
+		return nil, errors.New("public key is 32 bytes long, likely ed25519; only secp256k1 keys can be associated")
+	case len(bz) != secp256k1.PubKeySize:
+		return nil, fmt.Errorf("public key is %d bytes long, expected a %d-byte compressed secp256k1 key", len(bz), secp256k1.PubKeySize)
+	}
+	pubkey, err := btcec.ParsePubKey(bz, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid secp256k1 public key: %w", err)
+	}
+	return pubkey, nil
+}
+
+// AssociationPreflight reports whether associating a Sei and an EVM address
+// would succeed, or which side is already associated with another address.
+func (q Querier) AssociationPreflight(c context.Context, req *types.QueryAssociationPreflightRequest) (*types.QueryAssociationPreflightResponse, error) {
+	seiAddr, err := sdk.AccAddressFromBech32(req.SeiAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sei address: %s", err)
+	}
+	if !common.IsHexAddress(req.EvmAddress) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid evm address")
+	}
+	evmAddr := common.HexToAddress(req.EvmAddress)
+	ctx := sdk.UnwrapSDKContext(c)
+	if mapped, found := q.GetEVMAddress(ctx, seiAddr); found {
+		if mapped == evmAddr {
+			return &types.QueryAssociationPreflightResponse{Status: AssociationPreflightAlreadyAssociated}, nil
+		}
+		return &types.QueryAssociationPreflightResponse{Status: AssociationPreflightSeiTaken, ConflictingEvmAddress: mapped.Hex()}, nil
+	}
+	if mapped, found := q.GetSeiAddress(ctx, evmAddr); found {
+		return &types.QueryAssociationPreflightResponse{Status: AssociationPreflightEVMTaken, ConflictingSeiAddress: mapped.String()}, nil
+	}
+	return &types.QueryAssociationPreflightResponse{Status: AssociationPreflightOK}, nil
+}
+
+// NodeQueryConfig returns the limits this node applies to queries, so that
+// clients don't have to discover them through failures.
+func (q Querier) NodeQueryConfig(context.Context, *types.QueryNodeQueryConfigRequest) (*types.QueryNodeQueryConfigResponse, error) {
+	return &types.QueryNodeQueryConfigResponse{
+		GasLimit:                            q.QueryConfig.GasLimit,
+		MaxPageLimit:                        q.QueryConfig.MaxPageLimit,
+		TracingDisabled:                     q.QueryConfig.DisableTracing,
+		MaxTraceSize:                        q.QueryConfig.MaxTraceSize,
+		MaxTraceStructLogs:                  q.QueryConfig.MaxTraceStructLogs,
+		MaxLogsBlockRange:                   q.QueryConfig.MaxLogsBlockRange,
+		MaxContractTxParticipantsBlockRange: q.QueryConfig.MaxContractTxParticipantsBlockRange,
+		MaxAddressBatchSize:                 MaxAddressBatchSize,
+		MaxStaticCallBatchSize:              MaxStaticCallBatchSize,
+		MaxPointerBatchSize:                 MaxPointerBatchSize,
+		MaxBalance1155BatchSize:             MaxBalance1155BatchSize,
+	}, nil
+}
+
+// ModuleAddress returns the addresses the module acts as in the EVM.
+func (q Querier) ModuleAddress(c context.Context, _ *types.QueryModuleAddressRequest) (*types.QueryModuleAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	moduleAddr := q.Keeper.AccountKeeper().GetModuleAddress(types.ModuleName)
+	feeCollectorEVMAddr, err := q.Keeper.GetFeeCollectorAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryModuleAddressResponse{
+		SeiAddress:             moduleAddr.String(),
+		EvmAddress:             q.Keeper.GetEVMAddressOrDefault(ctx, moduleAddr).Hex(),
+		FeeCollectorSeiAddress: q.Keeper.AccountKeeper().GetModuleAddress(authtypes.FeeCollectorName).String(),
+		FeeCollectorEvmAddress: feeCollectorEVMAddr.Hex(),
+	}, nil
+}
+
+// AccessList runs a call with an access-list tracer and returns the accounts
+// and storage slots it touches along with the gas it needs once that list is
+// applied. A reverting call is not an error: the slots touched before the
+// revert are returned with Reverted set.
+func (q Querier) AccessList(c context.Context, req *types.QueryAccessListRequest) (*types.QueryAccessListResponse, error) {
+	if req.Height < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height != 0 {
+		var err error
+		if ctx, err = q.HistoricalContext(ctx, req.Height); err != nil {
+			return nil, err
+		}
+	}
+	ctx = q.withQueryGasLimit(ctx)
+	msg, err := q.simulatedMessage(ctx, req.From, req.To, req.Value, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	gasCap := q.getEvmGasLimitFromCtx(ctx)
+	accessList, result, err := q.Keeper.CreateAccessList(ctx, msg, gasCap)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryAccessListResponse{AccessList: make([]*types.AccessTuple, 0, len(accessList))}
+	for _, tuple := range accessList {
+		keys := make([]string, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			keys = append(keys, key.Hex())
+		}
+		res.AccessList = append(res.AccessList, &types.AccessTuple{Address: tuple.Address.Hex(), StorageKeys: keys})
+	}
+	if result.Failed() {
+		if !errors.Is(result.Err, vm.ErrExecutionReverted) {
+			return nil, fmt.Errorf("%w (%d): %s", ErrGasCapExceeded, gasCap, result.Err)
+		}
+		res.Reverted = true
+		res.RevertData = result.Revert()
+		res.RevertReason = result.Err.Error()
+		if reason, unpackErr := abi.UnpackRevert(res.RevertData); unpackErr == nil {
+			res.RevertReason = reason
+		}
+		return res, nil
+	}
+	msg.AccessList = accessList
+	if res.Gas, _, _, err = q.Keeper.EstimateGas(ctx, msg, gasCap); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TraceCall executes a call with the requested tracer against a discarded
+// branch of the state and returns the trace as JSON. The call is capped at the
+// node's query gas limit and the trace at its configured size.
+func (q Querier) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if q.QueryConfig.DisableTracing {
+		return nil, ErrTracingDisabled
+	}
+	if req.Height < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height != 0 {
+		var err error
+		if ctx, err = q.HistoricalContext(ctx, req.Height); err != nil {
+			return nil, err
+		}
+	}
+	ctx = q.withQueryGasLimit(ctx)
+	msg, err := q.simulatedMessage(ctx, req.From, req.To, req.Value, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	var tracer *tracers.Tracer
+	if req.Tracer == "" {
+		cfg := req.StructLogConfig
+		if cfg == nil {
+			cfg = &types.StructLogConfig{}
+		}
+		limit := q.QueryConfig.MaxTraceStructLogs
+		if cfg.Limit != 0 && cfg.Limit < limit {
+			limit = cfg.Limit
+		}
+		tracer = NewStructLogTracer(&logger.Config{
+			EnableMemory:     cfg.EnableMemory,
+			DisableStack:     cfg.DisableStack,
+			DisableStorage:   cfg.DisableStorage,
+			EnableReturnData: cfg.EnableReturnData,
+			Limit:            int(limit),
+		}, int(cfg.MaxDepth))
+	} else {
+		if _, ok := TraceCallTracers[req.Tracer]; !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported tracer %s", req.Tracer)
+		}
+		tracer, err = tracers.DefaultDirectory.New(req.Tracer, &tracers.Context{BlockNumber: big.NewInt(ctx.BlockHeight())}, req.TracerConfig)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid tracer config: %s", err)
+		}
+	}
+	msg.GasLimit = q.getEvmGasLimitFromCtx(ctx)
+	result, err := q.Keeper.TraceMessage(ctx, msg, tracer)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(result)) > q.QueryConfig.MaxTraceSize {
+		return nil, fmt.Errorf("trace of %d bytes exceeds the limit of %d bytes", len(result), q.QueryConfig.MaxTraceSize)
+	}
+	return &types.QueryTraceCallResponse{Result: result}, nil
+}
+
+// ContractInfo returns who deployed the EVM contract at an address and in
+// which transaction. Contracts deployed before this was recorded are reported
+// with Exists false but still with their code presence.
+func (q Querier) ContractInfo(c context.Context, req *types.QueryContractInfoRequest) (*types.QueryContractInfoResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	addr := common.HexToAddress(req.Address)
+	res := &types.QueryContractInfoResponse{HasCode: q.Keeper.GetCodeSize(ctx, addr) > 0}
+	if info, ok := q.Keeper.GetContractCreationInfo(ctx, addr); ok {
+		res.Exists = true
+		res.CreationInfo = *info
+	}
+	return res, nil
+}
+
+// SmartResolve reads the input as every format it could be in and returns all
+// registered pointers or pointees it resolves to across pointer types.
+func (q Querier) SmartResolve(c context.Context, req *types.QuerySmartResolveRequest) (*types.QuerySmartResolveResponse, error) {
+	type candidate struct {
+		interpretation string
+		pointerType    types.PointerType
+		inputIsPointer bool
+	}
+	var candidates []candidate
+	res := &types.QuerySmartResolveResponse{}
+	if _, err := sdk.AccAddressFromBech32(req.Input); err == nil {
+		res.Interpretations = append(res.Interpretations, "bech32")
+		for _, t := range []types.PointerType{types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155} {
+			candidates = append(candidates, candidate{"bech32", t, false})
+		}
+		for _, t := range []types.PointerType{types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155} {
+			candidates = append(candidates, candidate{"bech32", t, true})
+		}
+	}
+	if common.IsHexAddress(req.Input) {
+		res.Interpretations = append(res.Interpretations, "hex")
+		for _, t := range []types.PointerType{types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155} {
+			candidates = append(candidates, candidate{"hex", t, false})
+		}
+		for _, t := range []types.PointerType{types.PointerType_NATIVE, types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155} {
+			candidates = append(candidates, candidate{"hex", t, true})
+		}
+	}
+	if sdk.ValidateDenom(req.Input) == nil {
+		res.Interpretations = append(res.Interpretations, "denom")
+		candidates = append(candidates, candidate{"denom", types.PointerType_NATIVE, false})
+	}
+	if len(candidates) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "input is not a denom, hex address or bech32 address")
+	}
+	for _, cand := range candidates {
+		match := &types.SmartResolveMatch{Interpretation: cand.interpretation, PointerType: cand.pointerType, InputIsPointer: cand.inputIsPointer}
+		if cand.inputIsPointer {
+			pointee, err := q.Pointee(c, &types.QueryPointeeRequest{PointerType: cand.pointerType, Pointer: req.Input})
+			if err != nil {
+				return nil, err
+			}
+			if !pointee.Exists {
+				continue
+			}
+			// the reverse registry is shared by all pointer types, so confirm
+			// that the pointee is registered under this type
+			pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: cand.pointerType, Pointee: pointee.Pointee})
+			if err != nil {
+				return nil, err
+			}
+			if !pointer.Exists || !strings.EqualFold(pointer.Pointer, req.Input) {
+				continue
+			}
+			match.Pointer, match.Pointee, match.Version = pointer.Pointer, pointee.Pointee, pointee.Version
+		} else {
+			pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: cand.pointerType, Pointee: req.Input})
+			if err != nil {
+				return nil, err
+			}
+			if !pointer.Exists {
+				continue
+			}
+			match.Pointer, match.Pointee, match.Version = pointer.Pointer, req.Input, pointer.Version
+		}
+		res.Matches = append(res.Matches, match)
+	}
+	res.Ambiguous = len(res.Matches) > 1
+	return res, nil
+}
+
+// Resolve determines whether the input is a pointer or a pointee, optionally of
+// a given pointer type, and returns its counterpart. If several pointers match,
+// the first one in SmartResolve order is returned.
+func (q Querier) Resolve(c context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	hint, hasHint := types.PointerType_value[req.PointerTypeHint]
+	if req.PointerTypeHint != "" && !hasHint {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %s", req.PointerTypeHint)
+	}
+	resolved, err := q.SmartResolve(c, &types.QuerySmartResolveRequest{Input: req.Input})
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range resolved.Matches {
+		if hasHint && match.PointerType != types.PointerType(hint) {
+			continue
+		}
+		res := &types.QueryResolveResponse{Exists: true, PointerType: match.PointerType, InputIsPointer: match.InputIsPointer, Counterpart: match.Pointer, Version: match.Version}
+		if match.InputIsPointer {
+			res.Counterpart = match.Pointee
+		}
+		return res, nil
+	}
+	return &types.QueryResolveResponse{}, nil
+}
+
+// IsPointer reports whether an address is a pointer contract, and if so of
+// which type and to which pointee.
+func (q Querier) IsPointer(c context.Context, req *types.QueryIsPointerRequest) (*types.QueryIsPointerResponse, error) {
+	var addr common.Address
+	if common.IsHexAddress(req.Address) {
+		addr = common.HexToAddress(req.Address)
+	} else if seiAddr, err := sdk.AccAddressFromBech32(req.Address); err == nil {
+		addr = common.BytesToAddress([]byte(seiAddr.String()))
+	} else {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address must be a hex or bech32 address")
+	}
+	entry, pointerType, ok := q.Keeper.LookupPointer(sdk.UnwrapSDKContext(c), addr)
+	if !ok {
+		return &types.QueryIsPointerResponse{}, nil
+	}
+	return &types.QueryIsPointerResponse{IsPointer: true, PointerType: pointerType, Pointee: entry.Pointee, Version: entry.Version}, nil
+}
+
+// ClassifyAsset tells what kind of asset a denom or address is and which
+// pointer type, if any, would point to it.
+func (q Querier) ClassifyAsset(c context.Context, req *types.QueryClassifyAssetRequest) (*types.QueryClassifyAssetResponse, error) {
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	res := &types.QueryClassifyAssetResponse{}
+	var pointerTypes []types.PointerType
+	if common.IsHexAddress(req.Input) {
+		addr := common.HexToAddress(req.Input)
+		if _, _, ok := q.LookupPointer(ctx, addr); ok {
+			return &types.QueryClassifyAssetResponse{Kind: "pointer", Exists: true}, nil
+		}
+		if q.Keeper.GetCodeSize(ctx, addr) == 0 {
+			return &types.QueryClassifyAssetResponse{Kind: "account"}, nil
+		}
+		res.Kind, res.Exists = "evm_contract", true
+		pointerTypes = []types.PointerType{types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155}
+	} else if seiAddr, err := sdk.AccAddressFromBech32(req.Input); err == nil {
+		if _, _, ok := q.LookupPointer(ctx, common.BytesToAddress([]byte(seiAddr.String()))); ok {
+			return &types.QueryClassifyAssetResponse{Kind: "pointer", Exists: true}, nil
+		}
+		if q.wasmViewKeeper.GetContractInfo(ctx, seiAddr) == nil {
+			return &types.QueryClassifyAssetResponse{Kind: "account"}, nil
+		}
+		res.Kind, res.Exists = "cw_contract", true
+		pointerTypes = []types.PointerType{types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155}
+	} else if sdk.ValidateDenom(req.Input) == nil {
+		switch {
+		case strings.HasPrefix(req.Input, "ibc/"):
+			res.Kind = "ibc"
+		case strings.HasPrefix(req.Input, "factory/"):
+			res.Kind = "tokenfactory"
+		default:
+			res.Kind = "native"
+		}
+		res.Exists = q.BankKeeper().GetSupply(ctx, req.Input).IsPositive()
+		pointerTypes = []types.PointerType{types.PointerType_NATIVE}
+	} else {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "input is not a denom, hex address or bech32 address")
+	}
+	for _, pointerType := range pointerTypes {
+		pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: pointerType, Pointee: req.Input})
+		if err != nil {
+			return nil, err
+		}
+		if pointer.Exists {
+			res.AlreadyHasPointer, res.ExistingPointer = true, pointer.Pointer
+			res.SuggestedPointerType = pointerType.String()
+			break
+		}
+	}
+	if res.Kind == "cw_contract" {
+		// contracts without a pointer are told apart by the queries they answer
+		contract := sdk.MustAccAddressFromBech32(req.Input)
+		switch {
+		case res.SuggestedPointerType == types.PointerType_CW20.String() || q.queryCWTokenMetadata(ctx, contract, `{"token_info":{}}`, &struct{}{}):
+			res.Kind, res.SuggestedPointerType = "cw20", types.PointerType_CW20.String()
+		case res.SuggestedPointerType == types.PointerType_CW721.String() || q.queryCWTokenMetadata(ctx, contract, `{"num_tokens":{}}`, &struct{}{}):
+			res.Kind, res.SuggestedPointerType = "cw721", types.PointerType_CW721.String()
+		}
+	} else if res.Kind != "evm_contract" {
+		res.SuggestedPointerType = types.PointerType_NATIVE.String()
+	}
+	return res, nil
+}
+
+// PointerInfo returns the current pointer of a pointee along with how it was
+// first registered.
+func (q Querier) PointerInfo(c context.Context, req *types.QueryPointerInfoRequest) (*types.QueryPointerInfoResponse, error) {
+	pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: req.PointerType, Pointee: req.Pointee})
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryPointerInfoResponse{Pointer: pointer.Pointer, CurrentVersion: pointer.Version, Exists: pointer.Exists}
+	if !pointer.Exists {
+		return res, nil
+	}
+	pointerKey, _ := PointerRegistryKey(req.PointerType, req.Pointee)
+	if info, ok := q.Keeper.GetPointerCreationInfo(sdk.UnwrapSDKContext(c), pointerKey); ok {
+		res.CreationInfo = *info
+	}
+	return res, nil
+}
+
+// Allowance returns an ERC20/CW20 allowance across the pointer boundary. Native
+// allowances are read from the ERC20 pointer, CW20 and ERC20 allowances from
+// the pointee itself. Owner and spender may be given as either hex or bech32
+// addresses and are converted through their associations, or cast directly if
+// unassociated.
+func (q Querier) Allowance(c context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	switch req.PointerType {
+	case types.PointerType_NATIVE, types.PointerType_CW20, types.PointerType_ERC20:
+	default:
+		return nil, errors.ErrUnsupported
+	}
+	pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: req.PointerType, Pointee: req.Pointee})
+	if err != nil {
+		return nil, err
+	}
+	if !pointer.Exists {
+		return &types.QueryAllowanceResponse{}, nil
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	var allowance *big.Int
+	switch req.PointerType {
+	case types.PointerType_CW20:
+		owner, err := q.resolveSeiAddress(ctx, req.Owner)
+		if err != nil {
+			return nil, err
+		}
+		spender, err := q.resolveSeiAddress(ctx, req.Spender)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := json.Marshal(map[string]interface{}{"allowance": map[string]string{"owner": owner.String(), "spender": spender.String()}})
+		if err != nil {
+			return nil, err
+		}
+		ret, err := q.wasmViewKeeper.QuerySmartSafe(ctx, sdk.MustAccAddressFromBech32(req.Pointee), msg)
+		if err != nil {
+			return nil, err
+		}
+		var out struct {
+			Allowance sdk.Int `json:"allowance"`
+		}
+		if err := json.Unmarshal(ret, &out); err != nil {
+			return nil, err
+		}
+		allowance = out.Allowance.BigInt()
+	default:
+		token := common.HexToAddress(pointer.Pointer)
+		if req.PointerType == types.PointerType_ERC20 {
+			token = common.HexToAddress(req.Pointee)
+		}
+		owner, err := q.resolveEVMAddress(ctx, req.Owner)
+		if err != nil {
+			return nil, err
+		}
+		spender, err := q.resolveEVMAddress(ctx, req.Spender)
+		if err != nil {
+			return nil, err
+		}
+		// the native pointer implements the standard ERC20 interface
+		erc20ABI := artifacts.GetParsedABI("native")
+		input, err := erc20ABI.Pack("allowance", owner, spender)
+		if err != nil {
+			return nil, err
+		}
+		ret, err := q.StaticCallEVM(ctx, q.AccountKeeper().GetModuleAddress(types.ModuleName), &token, input)
+		if err != nil {
+			return nil, err
+		}
+		out, err := erc20ABI.Unpack("allowance", ret)
+		if err != nil {
+			return nil, err
+		}
+		allowance = out[0].(*big.Int)
+	}
+	return &types.QueryAllowanceResponse{Allowance: allowance.String(), Exists: true}, nil
+}
+
+// NFTInfo returns the owner, token URI and approved address of an NFT across
+// the CW721/ERC721 pointer boundary. CW721 tokens are read with wasm queries on
+// the pointee and ERC721 tokens with static calls to the pointee; either way a
+// pointer must be registered. Owners are returned in both address forms if
+// associated. Tokens that do not exist are reported with Exists set to false
+// instead of an error. Token URIs are cut to MaxTokenURILength unless the full
+// token URI is requested.
+func (q Querier) NFTInfo(c context.Context, req *types.QueryNFTInfoRequest) (*types.QueryNFTInfoResponse, error) {
+	switch req.PointerType {
+	case types.PointerType_CW721, types.PointerType_ERC721:
+	default:
+		return nil, errors.ErrUnsupported
+	}
+	tokenID, ok := new(big.Int).SetString(req.TokenId, 10)
+	if !ok || tokenID.Sign() < 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid token id %s", req.TokenId)
+	}
+	pointer, err := q.Pointer(c, &types.QueryPointerRequest{PointerType: req.PointerType, Pointee: req.Pointee})
+	if err != nil {
+		return nil, err
+	}
+	if !pointer.Exists {
+		return &types.QueryNFTInfoResponse{}, nil
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	var res *types.QueryNFTInfoResponse
+	if req.PointerType == types.PointerType_CW721 {
+		res, err = q.cw721NFTInfo(ctx, sdk.MustAccAddressFromBech32(req.Pointee), tokenID)
+	} else {
+		res, err = q.erc721NFTInfo(ctx, common.HexToAddress(req.Pointee), tokenID)
+	}
+	if err != nil || !res.Exists {
+		return res, err
+	}
+	res.TokenUri = strings.ToValidUTF8(res.TokenUri, string(utf8.RuneError))
+	if !req.FullTokenUri && len(res.TokenUri) > MaxTokenURILength {
+		// cut at a character boundary so that the URI stays valid UTF-8
+		cut := MaxTokenURILength
+		for cut > 0 && !utf8.RuneStart(res.TokenUri[cut]) {
+			cut--
+		}
+		res.TokenUri, res.TokenUriTruncated = res.TokenUri[:cut], true
+	}
+	return res, nil
+}
+
+func (q Querier) cw721NFTInfo(ctx sdk.Context, contract sdk.AccAddress, tokenID *big.Int) (*types.QueryNFTInfoResponse, error) {
+	ownerOf, err := json.Marshal(map[string]interface{}{"owner_of": map[string]string{"token_id": tokenID.String()}})
+	if err != nil {
+		return nil, err
+	}
+	// cw721 fails queries for nonexistent tokens
+	ret, err := q.wasmViewKeeper.QuerySmartSafe(ctx, contract, ownerOf)
+	if err != nil {
+		return &types.QueryNFTInfoResponse{}, nil
+	}
+	var owner struct {
+		Owner     string `json:"owner"`
+		Approvals []struct {
+			Spender string `json:"spender"`
+		} `json:"approvals"`
+	}
+	if err := json.Unmarshal(ret, &owner); err != nil {
+		return nil, err
+	}
+	res := &types.QueryNFTInfoResponse{OwnerSeiAddress: owner.Owner, Exists: true}
+	if ownerAddr, err := sdk.AccAddressFromBech32(owner.Owner); err == nil {
+		if evmAddr, ok := q.GetEVMAddress(ctx, ownerAddr); ok {
+			res.Owner = evmAddr.Hex()
+		}
+	}
+	if len(owner.Approvals) > 0 {
+		res.Approved = owner.Approvals[0].Spender
+		if spenderAddr, err := sdk.AccAddressFromBech32(res.Approved); err == nil {
+			if evmAddr, ok := q.GetEVMAddress(ctx, spenderAddr); ok {
+				res.Approved = evmAddr.Hex()
+			}
+		}
+	}
+	nftInfo, err := json.Marshal(map[string]interface{}{"nft_info": map[string]string{"token_id": tokenID.String()}})
+	if err != nil {
+		return nil, err
+	}
+	ret, err = q.wasmViewKeeper.QuerySmartSafe(ctx, contract, nftInfo)
+	if err != nil {
+		return nil, err
+	}
+	var info struct {
+		TokenURI string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(ret, &info); err != nil {
+		return nil, err
+	}
+	res.TokenUri = info.TokenURI
+	return res, nil
+}
+
+func (q Querier) erc721NFTInfo(ctx sdk.Context, contract common.Address, tokenID *big.Int) (*types.QueryNFTInfoResponse, error) {
+	// the CW721 pointer implements the standard ERC721 interface
+	erc721ABI := artifacts.GetParsedABI("cw721")
+	call := func(method string) (interface{}, error) {
+		input, err := erc721ABI.Pack(method, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		ret, err := q.StaticCallEVM(ctx, q.AccountKeeper().GetModuleAddress(types.ModuleName), &contract, input)
+		if err != nil {
+			return nil, err
+		}
+		out, err := erc721ABI.Unpack(method, ret)
+		if err != nil {
+			return nil, err
+		}
+		return out[0], nil
+	}
+	owner, err := call("ownerOf")
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		// ERC721 requires ownerOf to revert for nonexistent tokens
+		return &types.QueryNFTInfoResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryNFTInfoResponse{Owner: owner.(common.Address).Hex(), Exists: true}
+	if seiAddr, ok := q.GetSeiAddress(ctx, owner.(common.Address)); ok {
+		res.OwnerSeiAddress = seiAddr.String()
+	}
+	uri, err := call("tokenURI")
+	if err != nil {
+		return nil, err
+	}
+	res.TokenUri = uri.(string)
+	approved, err := call("getApproved")
+	if err != nil {
+		return nil, err
+	}
+	if approved.(common.Address) != (common.Address{}) {
+		res.Approved = approved.(common.Address).Hex()
+	}
+	return res, nil
+}
+
+// Balance1155Batch reads multi-token balances with ERC1155 balanceOfBatch
+// semantics, returning the balance of each owner for the token id at the same
+// position. address may be either side of a CW1155/ERC1155 pointer pair; the
+// balances are read from the pointee, with a wasm query for CW1155 pointees and
+// a static call for ERC1155 pointees.
+func (q Querier) Balance1155Batch(c context.Context, req *types.QueryBalance1155BatchRequest) (*types.QueryBalance1155BatchResponse, error) {
+	if len(req.Owners) != len(req.TokenIds) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "got %d owners but %d token ids", len(req.Owners), len(req.TokenIds))
+	}
+	if len(req.Owners) > MaxBalance1155BatchSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot read more than %d balances at once", MaxBalance1155BatchSize)
+	}
+	tokenIDs := make([]*big.Int, len(req.TokenIds))
+	for i, id := range req.TokenIds {
+		tokenID, ok := new(big.Int).SetString(id, 10)
+		if !ok || tokenID.Sign() < 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid token id %s", id)
+		}
+		tokenIDs[i] = tokenID
+	}
+	ctx := q.withQueryGasLimit(sdk.UnwrapSDKContext(c))
+	var cw1155Addr string
+	var erc1155Addr common.Address
+	if common.IsHexAddress(req.Address) {
+		addr := common.HexToAddress(req.Address)
+		if entry, pointerType, ok := q.LookupPointer(ctx, addr); ok && pointerType == types.PointerType_CW1155 {
+			cw1155Addr = entry.Pointee
+		} else if _, _, ok := q.GetCW1155ERC1155Pointer(ctx, addr); ok {
+			erc1155Addr = addr
+		} else {
+			return &types.QueryBalance1155BatchResponse{}, nil
+		}
+	} else {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a hex or bech32 address", req.Address)
+		}
+		if entry, pointerType, ok := q.LookupPointer(ctx, common.BytesToAddress([]byte(req.Address))); ok && pointerType == types.PointerType_ERC1155 {
+			erc1155Addr = common.HexToAddress(entry.Pointee)
+		} else if _, _, ok := q.GetERC1155CW1155Pointer(ctx, req.Address); ok {
+			cw1155Addr = req.Address
+		} else {
+			return &types.QueryBalance1155BatchResponse{}, nil
+		}
+	}
+	res := &types.QueryBalance1155BatchResponse{Balances: make([]string, 0, len(req.Owners)), Exists: true}
+	if len(req.Owners) == 0 {
+		return res, nil
+	}
+	if cw1155Addr != "" {
+		type ownerToken struct {
+			Owner   string `json:"owner"`
+			TokenID string `json:"token_id"`
+		}
+		requests := make([]ownerToken, len(req.Owners))
+		for i, owner := range req.Owners {
+			seiAddr, err := q.resolveSeiAddress(ctx, owner)
+			if err != nil {
+				return nil, err
+			}
+			requests[i] = ownerToken{Owner: seiAddr.String(), TokenID: tokenIDs[i].String()}
+		}
+		msg, err := json.Marshal(map[string]interface{}{"balance_of_batch": requests})
+		if err != nil {
+			return nil, err
+		}
+		ret, err := q.wasmViewKeeper.QuerySmartSafe(ctx, sdk.MustAccAddressFromBech32(cw1155Addr), msg)
+		if err != nil {
+			return nil, err
+		}
+		var out struct {
+			Balances []struct {
+				Amount sdk.Int `json:"amount"`
+			} `json:"balances"`
+		}
+		if err := json.Unmarshal(ret, &out); err != nil {
+			return nil, err
+		}
+		if len(out.Balances) != len(req.Owners) {
+			return nil, fmt.Errorf("expected %d balances but got %d", len(req.Owners), len(out.Balances))
+		}
+		for _, balance := range out.Balances {
+			res.Balances = append(res.Balances, balance.Amount.String())
+		}
+		return res, nil
+	}
+	owners := make([]common.Address, len(req.Owners))
+	for i, owner := range req.Owners {
+		evmAddr, err := q.resolveEVMAddress(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		owners[i] = evmAddr
+	}
+	// the CW1155 pointer implements the standard ERC1155 interface
+	erc1155ABI := artifacts.GetParsedABI("cw1155")
+	input, err := erc1155ABI.Pack("balanceOfBatch", owners, tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := q.StaticCallEVM(ctx, q.AccountKeeper().GetModuleAddress(types.ModuleName), &erc1155Addr, input)
+	if err != nil {
+		return nil, err
+	}
+	out, err := erc1155ABI.Unpack("balanceOfBatch", ret)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range out[0].([]*big.Int) {
+		res.Balances = append(res.Balances, balance.String())
+	}
+	return res, nil
+}
+
+// GasPrice returns the current and next base fee, the minimum fee and a
+// suggested priority fee. The tip is the default while blocks use at most their
+// gas target; once the base fee is rising, the rise is added so that a price of
+// base fee plus tip still clears the next block. Base fees fall back to the
+// minimum fee before any block has adjusted them.
+func (q Querier) GasPrice(c context.Context, _ *types.QueryGasPriceRequest) (*types.QueryGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minFee := q.GetMinimumFeePerGas(ctx)
+	if minFee.IsNil() {
+		minFee = types.DefaultParams().MinimumFeePerGas
+	}
+	baseFee := q.GetCurrBaseFeePerGas(ctx).TruncateInt()
+	nextBaseFee := q.GetNextBaseFeePerGas(ctx).TruncateInt()
+	tip := sdk.NewInt(DefaultSuggestedTip)
+	if nextBaseFee.GT(baseFee) {
+		tip = tip.Add(nextBaseFee.Sub(baseFee))
+	}
+	return &types.QueryGasPriceResponse{
+		BaseFee:      baseFee.String(),
+		NextBaseFee:  nextBaseFee.String(),
+		MinimumFee:   minFee.TruncateInt().String(),
+		SuggestedTip: tip.String(),
+		Height:       ctx.BlockHeight(),
+	}, nil
+}
+
+// resolveEVMAddress parses a hex or bech32 address into an EVM address,
+// converting bech32 addresses through their association.
+func (q Querier) resolveEVMAddress(ctx sdk.Context, input string) (common.Address, error) {
+	if common.IsHexAddress(input) {
+		return common.HexToAddress(input), nil
+	}
+	seiAddr, err := sdk.AccAddressFromBech32(input)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a hex or bech32 address", input)
+	}
+	return q.Keeper.GetEVMAddressOrDefault(ctx, seiAddr), nil
+}
+
+// resolveSeiAddress parses a hex or bech32 address into a Sei address,
+// converting hex addresses through their association.
+func (q Querier) resolveSeiAddress(ctx sdk.Context, input string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(input) {
+		return q.Keeper.GetSeiAddressOrDefault(ctx, common.HexToAddress(input)), nil
+	}
+	seiAddr, err := sdk.AccAddressFromBech32(input)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a hex or bech32 address", input)
+	}
+	return seiAddr, nil
+}
+
+// boundedPageRequest copies a page request, capping its limit at the
+// configured maximum.
+func (q Querier) boundedPageRequest(pageReq *query.PageRequest) *query.PageRequest {
+	res := &query.PageRequest{}
+	if pageReq != nil {
+		*res = *pageReq
+	}
+	if res.Limit > q.QueryConfig.MaxPageLimit {
+		res.Limit = q.QueryConfig.MaxPageLimit
+	}
+	return res
+}
+
+// withQueryGasLimit caps gas for queries that execute EVM code if the
+// incoming context is unmetered.
+func (q Querier) withQueryGasLimit(ctx sdk.Context) sdk.Context {
+	if ctx.GasMeter().Limit() == 0 {
+		return ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, q.QueryConfig.GasLimit))
+	}
+	return ctx
+}
+
+// PredictPointerAddress returns the address a pointer of the pointee would be
+// deployed at if it were registered now.
+func (q Querier) PredictPointerAddress(c context.Context, req *types.QueryPredictPointerAddressRequest) (*types.QueryPredictPointerAddressResponse, error) {
+	if req.Pointee == "" {
+		return nil, ErrMustSpecifyPointee
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, registered, instanceDependent, err := q.Keeper.GetPredictedPointerAddress(ctx, req.PointerType, req.Pointee)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPredictPointerAddressResponse{PointerAddress: addr, Registered: registered, InstanceDependent: instanceDependent}, nil
+}
+
+// VerifyPointer compares the code a registered pointer runs against the
+// artifact of the version it is registered at. A mismatch is reported in the
+// response rather than as an error. The artifact of a CW pointer's version is
+// the wasm shipped with this binary for the current version, and the stored
+// pointer code for earlier ones; only the current ERC pointer artifact is
+// known.
+func (q Querier) VerifyPointer(c context.Context, req *types.QueryVerifyPointerRequest) (*types.QueryVerifyPointerResponse, error) {
+	if req.Pointee == "" {
+		return nil, ErrMustSpecifyPointee
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pointerKey, ok := PointerRegistryKey(req.PointerType, req.Pointee)
+	if !ok {
+		return nil, errors.ErrUnsupported
+	}
+	pointerBz, version, exists := q.GetPointerInfo(ctx, pointerKey)
+	if !exists {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no %s pointer registered for %s", req.PointerType, req.Pointee)
+	}
+	res := &types.QueryVerifyPointerResponse{Pointer: pointerAddressString(req.PointerType, pointerBz), ClaimedVersion: uint32(version)}
+	current := version == q.currentPointerVersion(ctx, req.PointerType)
+	if isCWPointerType(req.PointerType) {
+		if current {
+			checksum := sha256.Sum256(cwPointerWasm(req.PointerType))
+			res.ExpectedHash = hex.EncodeToString(checksum[:])
+		} else {
+			q.IteratePointerCodeIDs(ctx, req.PointerType, func(v uint16, codeID uint64) bool {
+				if v == version {
+					if codeInfo := q.wasmViewKeeper.GetCodeInfo(ctx, codeID); codeInfo != nil {
+						res.ExpectedHash = hex.EncodeToString(codeInfo.CodeHash)
+					}
+				}
+				return v >= version
+			})
+		}
+		addr, err := sdk.AccAddressFromBech32(res.Pointer)
+		if err != nil {
+			return nil, err
+		}
+		if info := q.wasmViewKeeper.GetContractInfo(ctx, addr); info != nil {
+			if codeInfo := q.wasmViewKeeper.GetCodeInfo(ctx, info.CodeID); codeInfo != nil {
+				res.ActualHash = hex.EncodeToString(codeInfo.CodeHash)
+			}
+		}
+	} else {
+		if current {
+			code, err := q.PointerDeploymentCode(ctx, ercPointerArtifactTypes[req.PointerType])
+			if err != nil {
+				return nil, err
+			}
+			res.ExpectedHash = crypto.Keccak256Hash(code).Hex()
+		}
+		if code := q.Keeper.GetCode(ctx, common.BytesToAddress(pointerBz)); len(code) > 0 {
+			res.ActualHash = crypto.Keccak256Hash(code).Hex()
+		}
+	}
+	res.Matches = res.ExpectedHash != "" && res.ExpectedHash == res.ActualHash
+	return res, nil
+}
+
+// cwPointerWasm returns the wasm of the current CW pointer code of the given
+// pointer type.
+func cwPointerWasm(pointerType types.PointerType) []byte {
+	switch pointerType {
+	case types.PointerType_ERC20:
+		return erc20.GetBin()
+	case types.PointerType_ERC721:
+		return erc721.GetBin()
+	default:
+		return erc1155.GetBin()
+	}
+}
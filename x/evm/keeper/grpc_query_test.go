@@ -1,12 +1,39 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sei-protocol/sei-chain/app"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw721"
@@ -15,8 +42,11 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/retention"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func TestQueryPointer(t *testing.T) {
@@ -85,6 +115,63 @@ func TestQueryPointer(t *testing.T) {
 	require.Equal(t, types.QueryPointerResponse{Exists: false}, *res)
 }
 
+func TestQueryPointerAllTypes(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).CacheContext()
+	q := keeper.Querier{k}
+	cwAddr, _ := testkeeper.MockAddressPair()
+	_, cw20Pointer := testkeeper.MockAddressPair()
+	_, cw721Pointer := testkeeper.MockAddressPair()
+
+	res, err := q.Pointer(sdk.WrapSDKContext(ctx), &types.QueryPointerRequest{Pointee: cwAddr.String(), AllTypes: true})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	require.Empty(t, res.Pointers)
+
+	// duplicates registered before they were rejected
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cwAddr.String(), cw20Pointer))
+	require.Nil(t, k.SetERC721CW721Pointer(ctx, cwAddr.String(), cw721Pointer))
+	res, err = q.Pointer(sdk.WrapSDKContext(ctx), &types.QueryPointerRequest{Pointee: cwAddr.String(), AllTypes: true})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.Equal(t, []*types.PointerOfType{
+		{PointerType: types.PointerType_CW20, Pointer: cw20Pointer.Hex(), Version: uint32(cw20.CurrentVersion(ctx))},
+		{PointerType: types.PointerType_CW721, Pointer: cw721Pointer.Hex(), Version: uint32(cw721.CurrentVersion)},
+	}, res.Pointers)
+	require.ErrorIs(t, k.CheckNoPointerOfOtherType(ctx, types.PointerType_CW1155, cwAddr.String()), keeper.ErrorPointeeHasPointerOfOtherType)
+}
+
+func TestQueryPointerContractInfo(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	sender, _ := testkeeper.MockAddressPair()
+	_, erc20Addr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+	registered, err := keeper.NewMsgServerImpl(k).RegisterPointer(goCtx, &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()})
+	require.Nil(t, err)
+
+	res, err := q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.Nil(t, res.PointeeContractInfo)
+	require.Nil(t, res.PointerContractInfo)
+
+	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex(), IncludeContractInfo: true})
+	require.Nil(t, err)
+	require.Equal(t, registered.PointerAddress, res.Pointer)
+	require.Equal(t, &types.PointerContractInfo{CodeHash: k.GetCodeHash(ctx, erc20Addr).Hex()}, res.PointeeContractInfo)
+	require.Equal(t, k.GetStoredPointerCodeID(ctx, types.PointerType_ERC20), res.PointerContractInfo.CodeId)
+	require.NotEmpty(t, res.PointerContractInfo.Creator)
+	require.Empty(t, res.PointerContractInfo.CodeHash)
+
+	// nothing is fetched for missing pointers
+	_, unknown := testkeeper.MockAddressPair()
+	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_ERC20, Pointee: unknown.Hex(), IncludeContractInfo: true})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerResponse{}, *res)
+}
+
 func TestQueryPointee(t *testing.T) {
 	k, ctx := testkeeper.MockEVMKeeper()
 	_, pointerAddr1 := testkeeper.MockAddressPair()
@@ -226,3 +313,2311 @@ func TestQueryPointee(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryStaticCallIntrinsicGas(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	_, to := testkeeper.MockAddressPair()
+	q := keeper.Querier{k}
+	data := bytes.Repeat([]byte{1}, 1000)
+	// PUSH1 1 PUSH1 1 POP POP
+	k.SetCode(ctx, to, common.FromHex("0x600160015050"))
+
+	// intrinsic gas of the calldata alone exceeds the limit, so the call is
+	// rejected before anything executes or consumes gas
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10000))
+	_, err := q.StaticCall(sdk.WrapSDKContext(limitedCtx), &types.QueryStaticCallRequest{To: to.Hex(), Data: data})
+	require.ErrorIs(t, err, core.ErrIntrinsicGas)
+	require.Zero(t, limitedCtx.GasMeter().GasConsumed())
+
+	// same calldata under a sufficient limit executes and consumes gas
+	meteredCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 1000000))
+	res, err := q.StaticCall(sdk.WrapSDKContext(meteredCtx), &types.QueryStaticCallRequest{To: to.Hex(), Data: data})
+	require.Nil(t, err)
+	require.Empty(t, res.Data)
+	require.NotZero(t, meteredCtx.GasMeter().GasConsumed())
+}
+
+func TestQueryStaticCallGasUsed(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	_, to := testkeeper.MockAddressPair()
+	q := keeper.Querier{k}
+	// PUSH1 1 PUSH1 1 POP POP, which costs 10 gas
+	k.SetCode(ctx, to, common.FromHex("0x600160015050"))
+
+	meteredCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 1000000))
+	res, err := q.StaticCall(sdk.WrapSDKContext(meteredCtx), &types.QueryStaticCallRequest{To: to.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), res.GasUsed)
+	require.Equal(t, k.GetEVMGasLimitFromCtx(meteredCtx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 1000000))), res.GasLimitApplied)
+}
+
+func TestQueryPointerDisplayMetadata(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "FOO", Symbol: "FOO", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	pointer, _, _ := k.GetERC20NativePointer(ctx, "ufoo")
+
+	// without bank metadata, values come from the pointer contract
+	res, err := q.PointerDisplayMetadata(goCtx, &types.QueryPointerDisplayMetadataRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerDisplayMetadataResponse{Pointer: pointer.Hex(), Name: "FOO", Symbol: "FOO", Decimals: 6, Exists: true}, *res)
+
+	// bank metadata takes precedence for native pointers
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "the foo token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "foo", Exponent: 6}},
+		Base:        "ufoo",
+		Display:     "foo",
+		Name:        "Foo",
+		Symbol:      "FOO",
+	})
+	res, err = q.PointerDisplayMetadata(goCtx, &types.QueryPointerDisplayMetadataRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerDisplayMetadataResponse{
+		Pointer: pointer.Hex(), Name: "Foo", Symbol: "FOO", Decimals: 6, Display: "foo", Description: "the foo token", Exists: true,
+	}, *res)
+
+	// fields missing from bank metadata fall back to the pointer contract
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}},
+		Base:       "ufoo",
+		Display:    "foo",
+	})
+	res, err = q.PointerDisplayMetadata(goCtx, &types.QueryPointerDisplayMetadataRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerDisplayMetadataResponse{
+		Pointer: pointer.Hex(), Name: "FOO", Symbol: "FOO", Decimals: 6, Display: "foo", Exists: true,
+	}, *res)
+
+	// pointer contracts that don't answer leave the fields empty
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetERC20CW20Pointer(ctx, seiAddr.String(), evmAddr)
+	res, err = q.PointerDisplayMetadata(goCtx, &types.QueryPointerDisplayMetadataRequest{PointerType: types.PointerType_CW20, Pointee: seiAddr.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerDisplayMetadataResponse{Pointer: evmAddr.Hex(), Exists: true}, *res)
+
+	res, err = q.PointerDisplayMetadata(goCtx, &types.QueryPointerDisplayMetadataRequest{PointerType: types.PointerType_NATIVE, Pointee: "ubar"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	_, err = q.PointerDisplayMetadata(goCtx, &types.QueryPointerDisplayMetadataRequest{PointerType: types.PointerType_CW721, Pointee: seiAddr.String()})
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestQueryNativePointerMetadata(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", pointer))
+
+	// without bank metadata
+	res, err := q.NativePointerMetadata(goCtx, &types.QueryNativePointerMetadataRequest{DenomOrPointer: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryNativePointerMetadataResponse{Exists: true, Pointer: pointer.Hex(), Denom: "ufoo", Version: uint32(native.CurrentVersion)}, *res)
+
+	metadata := banktypes.Metadata{
+		Description: "the foo token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "foo", Exponent: 6}},
+		Base:        "ufoo",
+		Display:     "foo",
+		Name:        "Foo",
+		Symbol:      "FOO",
+	}
+	k.BankKeeper().SetDenomMetaData(ctx, metadata)
+	expected := types.QueryNativePointerMetadataResponse{
+		Exists: true, Pointer: pointer.Hex(), Denom: "ufoo", Version: uint32(native.CurrentVersion), Metadata: metadata, DisplayExponent: 6,
+	}
+	res, err = q.NativePointerMetadata(goCtx, &types.QueryNativePointerMetadataRequest{DenomOrPointer: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, expected, *res)
+	res, err = q.NativePointerMetadata(goCtx, &types.QueryNativePointerMetadataRequest{DenomOrPointer: strings.ToLower(pointer.Hex())})
+	require.Nil(t, err)
+	require.Equal(t, expected, *res)
+
+	// pointers of other types are not native pointers
+	cw20, cw20Pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20.String(), cw20Pointer))
+	res, err = q.NativePointerMetadata(goCtx, &types.QueryNativePointerMetadataRequest{DenomOrPointer: cw20Pointer.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	res, err = q.NativePointerMetadata(goCtx, &types.QueryNativePointerMetadataRequest{DenomOrPointer: "ubar"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	_, err = q.NativePointerMetadata(goCtx, &types.QueryNativePointerMetadataRequest{})
+	require.ErrorIs(t, err, keeper.ErrMustSpecifyPointee)
+}
+
+func TestQueryNativePointerSupply(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("usupply", sdk.NewInt(1234567)))))
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "usupply", utils.ERCMetadata{Name: "SUPPLY", Symbol: "SUPPLY", Decimals: 8})
+		return err
+	}, func(string, string) {}))
+	pointer, _, _ := k.GetERC20NativePointer(ctx, "usupply")
+
+	expected := types.QueryNativePointerSupplyResponse{
+		Exists: true, Pointer: pointer.Hex(), Denom: "usupply", RawSupply: "1234567", Decimals: 8, Supply: "0.01234567",
+	}
+	for _, denomOrPointer := range []string{"usupply", pointer.Hex()} {
+		res, err := q.NativePointerSupply(goCtx, &types.QueryNativePointerSupplyRequest{DenomOrPointer: denomOrPointer})
+		require.Nil(t, err)
+		require.Equal(t, expected, *res)
+	}
+
+	res, err := q.NativePointerSupply(goCtx, &types.QueryNativePointerSupplyRequest{DenomOrPointer: "unone"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	_, err = q.NativePointerSupply(goCtx, &types.QueryNativePointerSupplyRequest{})
+	require.ErrorIs(t, err, keeper.ErrMustSpecifyPointee)
+}
+
+func TestQueryStaticCallInternalReverts(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, reverter := testkeeper.MockAddressPair()
+	_, caller := testkeeper.MockAddressPair()
+	// PUSH1 0 PUSH1 0 REVERT
+	k.SetCode(ctx, reverter, common.FromHex("0x60006000fd"))
+	// STATICCALL(GAS, reverter, 0, 0, 0, 0) POP STOP
+	k.SetCode(ctx, caller, append(append(common.FromHex("0x600060006000600073"), reverter[:]...), common.FromHex("0x5afa5000")...))
+
+	res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: caller.Hex(), ReportInternalReverts: true})
+	require.Nil(t, err)
+	require.True(t, res.InternalReverted)
+
+	// not reported unless requested
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: caller.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.InternalReverted)
+
+	// a top-level revert is returned as a revert error
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: reverter.Hex(), ReportInternalReverts: true})
+	require.Nil(t, err)
+	require.NotNil(t, res.RevertError)
+	require.Empty(t, res.Data)
+}
+
+func TestQueryContractTxParticipants(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	deployer := common.HexToAddress("0x2000000000000000000000000000000000000000")
+	caller := common.HexToAddress("0x3000000000000000000000000000000000000000")
+	token := common.HexToAddress("0x4000000000000000000000000000000000000000")
+	router := common.HexToAddress("0x5000000000000000000000000000000000000000")
+	unrelated := common.HexToAddress("0x6000000000000000000000000000000000000000")
+	// receipts are shaped the way WriteReceipt produces them
+	// the contract is created
+	require.Nil(t, k.SetTransientReceipt(ctx, common.Hash{1}, &types.Receipt{
+		From: deployer.Hex(), ContractAddress: contract.Hex(),
+	}))
+	// a call into the contract, which emits a log from a token it calls
+	require.Nil(t, k.SetTransientReceipt(ctx, common.Hash{2}, &types.Receipt{
+		From: caller.Hex(), To: contract.Hex(),
+		Logs: []*types.Log{{Address: contract.Hex()}, {Address: token.Hex()}},
+	}))
+	// the contract is called internally through a router and emits a log
+	require.Nil(t, k.SetTransientReceipt(ctx, common.Hash{3}, &types.Receipt{
+		From: caller.Hex(), To: router.Hex(), ContractAddress: router.Hex(),
+		Logs: []*types.Log{{Address: contract.Hex()}},
+	}))
+	// the contract is not involved
+	require.Nil(t, k.SetTransientReceipt(ctx, common.Hash{4}, &types.Receipt{
+		From: deployer.Hex(), To: unrelated.Hex(),
+	}))
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+	startHeight, err := k.GetReceiptIndexStartHeight()
+	require.Nil(t, err)
+	require.Equal(t, ctx.BlockHeight(), startHeight)
+
+	res, err := q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: 8, ToHeight: 20})
+	require.Nil(t, err)
+	require.Equal(t, []string{deployer.Hex(), caller.Hex(), token.Hex(), router.Hex()}, res.Addresses)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// paginate by key
+	res, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{
+		Address: contract.Hex(), FromHeight: 8, ToHeight: 8, Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{deployer.Hex(), caller.Hex(), token.Hex()}, res.Addresses)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	res, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{
+		Address: contract.Hex(), FromHeight: 8, ToHeight: 8, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{router.Hex()}, res.Addresses)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// paginate by offset
+	res, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{
+		Address: contract.Hex(), FromHeight: 8, ToHeight: 8, Pagination: &query.PageRequest{Offset: 2, Limit: 1},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{token.Hex()}, res.Addresses)
+
+	// no receipts in range
+	res, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: 9, ToHeight: 20})
+	require.Nil(t, err)
+	require.Empty(t, res.Addresses)
+
+	// reading receipts is metered
+	meteredCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 1000000))
+	_, err = q.ContractTxParticipants(sdk.WrapSDKContext(meteredCtx), &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: 8, ToHeight: 8})
+	require.Nil(t, err)
+	require.NotZero(t, meteredCtx.GasMeter().GasConsumed())
+
+	// heights before the block index existed are rejected rather than reported empty
+	_, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: 7, ToHeight: 8})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: 8, ToHeight: 8 + int64(k.QueryConfig.MaxContractTxParticipantsBlockRange)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: "bad", FromHeight: 8, ToHeight: 8})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryLogs(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	token := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	pointer := common.HexToAddress("0x2000000000000000000000000000000000000000")
+	transfer := common.HexToHash("0x01").Hex()
+	approval := common.HexToHash("0x02").Hex()
+	alice := common.HexToHash("0xa").Hex()
+	bob := common.HexToHash("0xb").Hex()
+	height := uint64(ctx.BlockHeight())
+	require.Nil(t, k.SetTransientReceipt(ctx, common.Hash{2}, &types.Receipt{
+		TxHashHex: common.Hash{2}.Hex(), BlockNumber: height, TransactionIndex: 1,
+		Logs: []*types.Log{
+			{Address: token.Hex(), Topics: []string{transfer, alice, bob}, Index: 1},
+			{Address: token.Hex(), Topics: []string{approval, alice, bob}, Index: 2},
+		},
+	}))
+	// receipts are flushed in hash order, but logs are returned in block order
+	require.Nil(t, k.SetTransientReceipt(ctx, common.Hash{1}, &types.Receipt{
+		TxHashHex: common.Hash{1}.Hex(), BlockNumber: height, TransactionIndex: 2,
+		Logs: []*types.Log{
+			{Address: pointer.Hex(), Topics: []string{transfer, bob, alice}, Data: []byte{1}, Index: 3, Synthetic: true},
+		},
+	}))
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+
+	res, err := q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 3)
+	require.Equal(t, []uint32{1, 2, 3}, []uint32{res.Logs[0].LogIndex, res.Logs[1].LogIndex, res.Logs[2].LogIndex})
+	require.Equal(t, &types.LogEntry{
+		Address: pointer.Hex(), Topics: []string{transfer, bob, alice}, Data: []byte{1},
+		BlockHeight: 8, TxHash: common.Hash{1}.Hex(), TxIndex: 2, LogIndex: 3, Synthetic: true,
+	}, res.Logs[2])
+
+	// addresses
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Addresses: []string{pointer.Hex()}})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 1)
+	require.Equal(t, uint32(3), res.Logs[0].LogIndex)
+
+	// topics by position, with wildcards
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Topics: []*types.TopicFilter{{Topics: []string{transfer}}}})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 2)
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Topics: []*types.TopicFilter{{}, {Topics: []string{alice}}}})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 2)
+	require.Equal(t, uint32(1), res.Logs[0].LogIndex)
+	require.Equal(t, uint32(2), res.Logs[1].LogIndex)
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Topics: []*types.TopicFilter{{Topics: []string{transfer}}, {}, {Topics: []string{alice, bob}}}})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 2)
+	// more topic positions than the logs have
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Topics: []*types.TopicFilter{{}, {}, {}, {}}})
+	require.Nil(t, err)
+	require.Empty(t, res.Logs)
+
+	// pagination
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Pagination: &query.PageRequest{Limit: 2}})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 2)
+	res, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 1)
+	require.Equal(t, uint32(3), res.Logs[0].LogIndex)
+
+	_, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8 + int64(k.QueryConfig.MaxLogsBlockRange)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 1, ToHeight: 8})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Topics: []*types.TopicFilter{{Topics: []string{"0x01"}}}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: 8, ToHeight: 8, Topics: make([]*types.TopicFilter, 5)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryReceiptRangePruned(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	k.ReceiptRetentionConfig = retention.Config{RetainBlocks: 2, PruneBatchSize: 10}
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	for h := ctx.BlockHeight(); h < ctx.BlockHeight()+4; h++ {
+		txHash := common.Hash{byte(h)}
+		require.Nil(t, k.SetTransientReceipt(ctx, txHash, &types.Receipt{
+			TxHashHex: txHash.Hex(), BlockNumber: uint64(h), To: contract.Hex(),
+			Logs: []*types.Log{{Address: contract.Hex()}},
+		}))
+		require.Nil(t, k.FlushTransientReceipts(ctx.WithBlockHeight(h)))
+		k.DeleteTransientReceipt(ctx, txHash)
+	}
+	prunedBefore, err := k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, ctx.BlockHeight()+2, prunedBefore)
+
+	// pruned heights are rejected rather than reported empty
+	_, err = q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: prunedBefore - 1, ToHeight: prunedBefore})
+	require.ErrorIs(t, err, types.ErrReceiptPruned)
+	_, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: prunedBefore - 1, ToHeight: prunedBefore})
+	require.ErrorIs(t, err, types.ErrReceiptPruned)
+
+	// retained heights are still served
+	res, err := q.Logs(goCtx, &types.QueryLogsRequest{FromHeight: prunedBefore, ToHeight: prunedBefore + 1})
+	require.Nil(t, err)
+	require.Len(t, res.Logs, 2)
+	_, err = q.ContractTxParticipants(goCtx, &types.QueryContractTxParticipantsRequest{Address: contract.Hex(), FromHeight: prunedBefore, ToHeight: prunedBefore + 1})
+	require.Nil(t, err)
+}
+
+func TestQueryStaticCallCustomErrors(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, reverter := testkeeper.MockAddressPair()
+	_, account := testkeeper.MockAddressPair()
+	errorABI := `[{"type":"error","name":"Unauthorized","inputs":[{"name":"account","type":"address"}]}]`
+	parsed, err := abi.JSON(strings.NewReader(errorABI))
+	require.Nil(t, err)
+	unauthorized := parsed.Errors["Unauthorized"]
+	revertData, err := unauthorized.Inputs.Pack(account)
+	require.Nil(t, err)
+	revertData = append(unauthorized.ID.Bytes()[:4], revertData...)
+	// CODECOPY the revert data appended after this 12-byte prefix and REVERT with it
+	prefix := []byte{0x60, byte(len(revertData)), 0x60, 12, 0x60, 0, 0x39, 0x60, byte(len(revertData)), 0x60, 0, 0xfd}
+	k.SetCode(ctx, reverter, append(prefix, revertData...))
+
+	res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: reverter.Hex(), ErrorAbis: []string{errorABI}})
+	require.Nil(t, err)
+	require.Equal(t, "Unauthorized", res.RevertError.Name)
+	require.Equal(t, []string{account.Hex()}, res.RevertError.Params)
+	require.Equal(t, revertData[:4], res.RevertError.Selector)
+	require.Equal(t, revertData, res.RevertError.Data)
+
+	// unknown selectors fall back to the raw revert data
+	otherABI := `[{"type":"error","name":"Paused","inputs":[]}]`
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: reverter.Hex(), ErrorAbis: []string{otherABI}})
+	require.Nil(t, err)
+	require.Empty(t, res.RevertError.Name)
+	require.Equal(t, revertData[:4], res.RevertError.Selector)
+	require.Equal(t, revertData, res.RevertError.Data)
+
+	// without error ABIs the revert data is still returned
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: reverter.Hex()})
+	require.Nil(t, err)
+	require.Empty(t, res.RevertError.Name)
+	require.Equal(t, revertData, res.RevertError.Data)
+
+	_, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: reverter.Hex(), ErrorAbis: []string{"not json"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQuerySmartResolve(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, nativePointer := testkeeper.MockAddressPair()
+	cw20Addr, erc20Pointer := testkeeper.MockAddressPair()
+	cwPointer, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20Addr.String(), erc20Pointer))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+
+	res, err := q.SmartResolve(goCtx, &types.QuerySmartResolveRequest{Input: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"denom"}, res.Interpretations)
+	require.Equal(t, []*types.SmartResolveMatch{{
+		Interpretation: "denom", PointerType: types.PointerType_NATIVE, Pointer: nativePointer.Hex(), Pointee: "ufoo", Version: uint32(native.CurrentVersion),
+	}}, res.Matches)
+	require.False(t, res.Ambiguous)
+
+	res, err = q.SmartResolve(goCtx, &types.QuerySmartResolveRequest{Input: nativePointer.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, []string{"hex"}, res.Interpretations)
+	require.Len(t, res.Matches, 1)
+	require.True(t, res.Matches[0].InputIsPointer)
+	require.Equal(t, "ufoo", res.Matches[0].Pointee)
+
+	// a bech32 address that is also registered as a native denom is ambiguous
+	_, otherPointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, cw20Addr.String(), otherPointer))
+	res, err = q.SmartResolve(goCtx, &types.QuerySmartResolveRequest{Input: cw20Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, []string{"bech32", "denom"}, res.Interpretations)
+	require.True(t, res.Ambiguous)
+	require.Len(t, res.Matches, 2)
+	require.Equal(t, types.PointerType_CW20, res.Matches[0].PointerType)
+	require.Equal(t, erc20Pointer.Hex(), res.Matches[0].Pointer)
+	require.Equal(t, types.PointerType_NATIVE, res.Matches[1].PointerType)
+	require.Equal(t, otherPointer.Hex(), res.Matches[1].Pointer)
+
+	// the ERC20 pointee resolves through its CW20 pointer
+	res, err = q.SmartResolve(goCtx, &types.QuerySmartResolveRequest{Input: cwPointer.String()})
+	require.Nil(t, err)
+	require.Len(t, res.Matches, 1)
+	require.Equal(t, types.PointerType_ERC20, res.Matches[0].PointerType)
+	require.True(t, res.Matches[0].InputIsPointer)
+	require.Equal(t, erc20Addr.Hex(), res.Matches[0].Pointee)
+
+	res, err = q.SmartResolve(goCtx, &types.QuerySmartResolveRequest{Input: "unregistered"})
+	require.Nil(t, err)
+	require.Empty(t, res.Matches)
+	_, err = q.SmartResolve(goCtx, &types.QuerySmartResolveRequest{Input: "!!"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryAddressesBatch(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	unassocSei, unassocEvm := testkeeper.MockAddressPair()
+
+	res, err := q.SeiAddressesByEVMAddresses(goCtx, &types.QuerySeiAddressesByEVMAddressesRequest{
+		EvmAddresses: []string{unassocEvm.Hex(), "0xnothex", evmAddr.Hex()},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.AddressAssociationResult{
+		{Input: unassocEvm.Hex()},
+		{Input: "0xnothex", Error: "invalid hex address"},
+		{Input: evmAddr.Hex(), Associated: true, Result: seiAddr.String()},
+	}, res.Results)
+
+	evmRes, err := q.EVMAddressesBySeiAddresses(goCtx, &types.QueryEVMAddressesBySeiAddressesRequest{
+		SeiAddresses: []string{seiAddr.String(), "sei1bad", unassocSei.String()},
+	})
+	require.Nil(t, err)
+	require.Len(t, evmRes.Results, 3)
+	require.Equal(t, &types.AddressAssociationResult{Input: seiAddr.String(), Associated: true, Result: evmAddr.Hex()}, evmRes.Results[0])
+	require.Equal(t, "sei1bad", evmRes.Results[1].Input)
+	require.NotEmpty(t, evmRes.Results[1].Error)
+	require.False(t, evmRes.Results[1].Associated)
+	require.Equal(t, &types.AddressAssociationResult{Input: unassocSei.String()}, evmRes.Results[2])
+
+	tooMany := make([]string, keeper.MaxAddressBatchSize+1)
+	_, err = q.SeiAddressesByEVMAddresses(goCtx, &types.QuerySeiAddressesByEVMAddressesRequest{EvmAddresses: tooMany})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.EVMAddressesBySeiAddresses(goCtx, &types.QueryEVMAddressesBySeiAddressesRequest{SeiAddresses: tooMany})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPointers(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, nativePointerA := testkeeper.MockAddressPair()
+	_, nativePointerB := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointerA))
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ubar", nativePointerB))
+	cwPointer, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+
+	res, err := q.Pointers(goCtx, &types.QueryPointersRequest{
+		PointerType: types.PointerType_NATIVE,
+		Pagination:  &query.PageRequest{Limit: 1},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.PointerEntry{{Pointee: "ubar", Pointer: nativePointerB.Hex(), Version: uint32(native.CurrentVersion)}}, res.Pointers)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, err = q.Pointers(goCtx, &types.QueryPointersRequest{
+		PointerType: types.PointerType_NATIVE,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.PointerEntry{{Pointee: "ufoo", Pointer: nativePointerA.Hex(), Version: uint32(native.CurrentVersion)}}, res.Pointers)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = q.Pointers(goCtx, &types.QueryPointersRequest{PointerType: types.PointerType_ERC20})
+	require.Nil(t, err)
+	require.Equal(t, []*types.PointerEntry{{Pointee: erc20Addr.Hex(), Pointer: cwPointer.String(), Version: uint32(erc20.CurrentVersion)}}, res.Pointers)
+
+	res, err = q.Pointers(goCtx, &types.QueryPointersRequest{PointerType: types.PointerType_CW721})
+	require.Nil(t, err)
+	require.Empty(t, res.Pointers)
+	_, err = q.Pointers(goCtx, &types.QueryPointersRequest{PointerType: types.PointerType(100)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPointees(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	cwPointerA, erc20AddrA := testkeeper.MockAddressPair()
+	cwPointerB, erc20AddrB := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20PointerWithVersion(ctx, erc20AddrA, cwPointerA.String(), erc20.CurrentVersion-1))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20AddrB, cwPointerB.String()))
+	_, nativePointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+
+	res, err := q.Pointees(goCtx, &types.QueryPointeesRequest{PointerType: types.PointerType_ERC20})
+	require.Nil(t, err)
+	require.Equal(t, uint32(erc20.CurrentVersion), res.CurrentVersion)
+	require.ElementsMatch(t, []*types.PointerEntry{
+		{Pointee: erc20AddrA.Hex(), Pointer: cwPointerA.String(), Version: uint32(erc20.CurrentVersion - 1)},
+		{Pointee: erc20AddrB.Hex(), Pointer: cwPointerB.String(), Version: uint32(erc20.CurrentVersion)},
+	}, res.Entries)
+
+	// entries of other pointer types don't count towards the page limit
+	res, err = q.Pointees(goCtx, &types.QueryPointeesRequest{PointerType: types.PointerType_NATIVE, Pagination: &query.PageRequest{Limit: 1}})
+	require.Nil(t, err)
+	require.Equal(t, []*types.PointerEntry{{Pointee: "ufoo", Pointer: nativePointer.Hex(), Version: uint32(native.CurrentVersion)}}, res.Entries)
+
+	res, err = q.Pointees(goCtx, &types.QueryPointeesRequest{PointerType: types.PointerType_CW20})
+	require.Nil(t, err)
+	require.Empty(t, res.Entries)
+	_, err = q.Pointees(goCtx, &types.QueryPointeesRequest{PointerType: types.PointerType(100)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPointerMetadata(t *testing.T) {
+	// the native pointer reads its supply through the bank precompile
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("umeta", sdk.NewInt(500)))))
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "umeta", utils.ERCMetadata{Name: "META", Symbol: "META", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	nativePointer, _, _ := k.GetERC20NativePointer(ctx, "umeta")
+	res, err := q.PointerMetadata(goCtx, &types.QueryPointerMetadataRequest{PointerType: types.PointerType_NATIVE, Pointee: "umeta"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerMetadataResponse{Pointer: nativePointer.Hex(), Name: "META", Symbol: "META", Decimals: 6, TotalSupply: "500", Exists: true}, *res)
+
+	// CW pointers are read through wasm smart queries
+	adminSeiAddr, _ := testkeeper.MockAddressPair()
+	code, err := os.ReadFile("../../../contracts/wasm/cw20_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, adminSeiAddr, code, nil)
+	require.Nil(t, err)
+	instantiateMsg, err := json.Marshal(map[string]interface{}{
+		"name": "Bar", "symbol": "BAR", "decimals": 8,
+		"initial_balances": []map[string]interface{}{{"address": adminSeiAddr.String(), "amount": "1000"}},
+	})
+	require.Nil(t, err)
+	cwPointer, _, err := k.WasmKeeper().Instantiate(ctx, codeID, adminSeiAddr, adminSeiAddr, instantiateMsg, "bar", sdk.NewCoins())
+	require.Nil(t, err)
+	_, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+	res, err = q.PointerMetadata(goCtx, &types.QueryPointerMetadataRequest{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerMetadataResponse{Pointer: cwPointer.String(), Name: "Bar", Symbol: "BAR", Decimals: 8, TotalSupply: "1000", Exists: true}, *res)
+
+	// pointer contracts that don't answer leave the fields empty
+	cw20Addr, evmPointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20Addr.String(), evmPointer))
+	res, err = q.PointerMetadata(goCtx, &types.QueryPointerMetadataRequest{PointerType: types.PointerType_CW20, Pointee: cw20Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerMetadataResponse{Pointer: evmPointer.Hex(), Exists: true}, *res)
+
+	// CW20 pointers include the marketing info of their pointee
+	instantiateMsg, err = json.Marshal(map[string]interface{}{
+		"name": "Baz", "symbol": "BAZ", "decimals": 6, "initial_balances": []interface{}{},
+		"marketing": map[string]interface{}{"project": "baz.io", "description": "the baz token", "logo": map[string]string{"url": "https://baz.io/logo.png"}},
+	})
+	require.Nil(t, err)
+	marketed, _, err := k.WasmKeeper().Instantiate(ctx, codeID, adminSeiAddr, adminSeiAddr, instantiateMsg, "baz", sdk.NewCoins())
+	require.Nil(t, err)
+	_, marketedPointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, marketed.String(), marketedPointer))
+	res, err = q.PointerMetadata(goCtx, &types.QueryPointerMetadataRequest{PointerType: types.PointerType_CW20, Pointee: marketed.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerMetadataResponse{
+		Pointer: marketedPointer.Hex(), Exists: true, Project: "baz.io", Description: "the baz token", Logo: "https://baz.io/logo.png",
+	}, *res)
+
+	res, err = q.PointerMetadata(goCtx, &types.QueryPointerMetadataRequest{PointerType: types.PointerType_NATIVE, Pointee: "unone"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerMetadataResponse{}, *res)
+	_, err = q.PointerMetadata(goCtx, &types.QueryPointerMetadataRequest{PointerType: types.PointerType_ERC1155, Pointee: erc20Addr.Hex()})
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestQueryStaticCallFrom(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, contract := testkeeper.MockAddressPair()
+	// MSTORE(0, CALLER) RETURN(0, 32)
+	k.SetCode(ctx, contract, common.FromHex("0x3360005260206000f3"))
+
+	res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName)), common.BytesToAddress(res.Data))
+
+	associatedSei, associated := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, associatedSei, associated)
+	_, unassociated := testkeeper.MockAddressPair()
+	for _, from := range []common.Address{associated, unassociated} {
+		res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), From: from.Hex()})
+		require.Nil(t, err)
+		require.Equal(t, from, common.BytesToAddress(res.Data))
+	}
+
+	// state writes fail regardless of the caller
+	_, writer := testkeeper.MockAddressPair()
+	// LOG0(0, 0) STOP
+	k.SetCode(ctx, writer, common.FromHex("0x60006000a000"))
+	_, proxy := testkeeper.MockAddressPair()
+	// MSTORE(0, CALL(GAS, writer, 0, 0, 0, 0, 0)) RETURN(0, 32)
+	k.SetCode(ctx, proxy, append(append(common.FromHex("0x6000600060006000600073"), writer[:]...), common.FromHex("0x5af160005260206000f3")...))
+	// the failed nested call burns nearly all of its gas, so leave headroom
+	// beyond the default query gas limit
+	meteredCtx := sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10000000)))
+	res, err = q.StaticCall(meteredCtx, &types.QueryStaticCallRequest{To: proxy.Hex(), From: associated.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, common.Hash{}, common.BytesToHash(res.Data))
+
+	_, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), From: "sei1notanevmaddress"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryStaticCallAtHeight(t *testing.T) {
+	a := app.Setup(false, false)
+	k := a.EvmKeeper
+	q := keeper.Querier{&k}
+	_, contract := testkeeper.MockAddressPair()
+	// MSTORE(0, SLOAD(0)) RETURN(0, 32)
+	k.SetCode(a.GetContextForDeliverTx([]byte{}), contract, common.FromHex("0x60005460005260206000f3"))
+	for height, value := range []byte{1, 2} {
+		_, err := a.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{Height: int64(height + 1)})
+		require.Nil(t, err)
+		k.SetState(a.GetContextForDeliverTx([]byte{}), contract, common.Hash{}, common.BytesToHash([]byte{value}))
+		a.SetDeliverStateToCommit()
+		_, err = a.Commit(context.Background())
+		require.Nil(t, err)
+	}
+	goCtx := sdk.WrapSDKContext(a.GetCheckCtx())
+
+	res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), Height: 1})
+	require.Nil(t, err)
+	require.Equal(t, common.BytesToHash([]byte{1}), common.BytesToHash(res.Data))
+	require.Equal(t, int64(1), res.Height)
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, common.BytesToHash([]byte{2}), common.BytesToHash(res.Data))
+	require.Equal(t, int64(2), res.Height)
+
+	_, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), Height: 100})
+	require.ErrorIs(t, err, types.ErrHeightNotAvailable)
+	_, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), Height: -1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryStaticCallOverrides(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, contract := testkeeper.MockAddressPair()
+	// MSTORE(0, SLOAD(1)) RETURN(0, 32)
+	k.SetCode(ctx, contract, common.FromHex("0x60015460005260206000f3"))
+	k.SetState(ctx, contract, common.BytesToHash([]byte{1}), common.BytesToHash([]byte{5}))
+	call := func(overrides map[string]*types.AccountOverride) common.Hash {
+		res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), Overrides: overrides})
+		require.Nil(t, err)
+		return common.BytesToHash(res.Data)
+	}
+
+	require.Equal(t, common.BytesToHash([]byte{5}), call(nil))
+	require.Equal(t, common.BytesToHash([]byte{42}), call(map[string]*types.AccountOverride{
+		contract.Hex(): {StateDiff: map[string]string{"0x01": "0x2a"}},
+	}))
+	// state replaces the whole storage
+	require.Equal(t, common.Hash{}, call(map[string]*types.AccountOverride{
+		contract.Hex(): {State: map[string]string{"0x00": "0x2a"}},
+	}))
+	// MSTORE(0, SELFBALANCE) RETURN(0, 32)
+	require.Equal(t, common.BigToHash(big.NewInt(1_000_000_000_001)), call(map[string]*types.AccountOverride{
+		contract.Hex(): {Code: "0x4760005260206000f3", Balance: "1000000000001", Nonce: "3"},
+	}))
+	// overrides are discarded after the call
+	require.Equal(t, common.BytesToHash([]byte{5}), call(nil))
+	require.Equal(t, common.BytesToHash([]byte{5}), k.GetState(ctx, contract, common.BytesToHash([]byte{1})))
+	require.Equal(t, uint64(0), k.GetNonce(ctx, contract))
+
+	tooMany := map[string]*types.AccountOverride{}
+	for i := 0; i <= keeper.MaxStateOverrideAccounts; i++ {
+		tooMany[common.BigToAddress(big.NewInt(int64(i+1))).Hex()] = &types.AccountOverride{}
+	}
+	for _, overrides := range []map[string]*types.AccountOverride{
+		{contract.Hex(): {State: map[string]string{"0x00": "0x01"}, StateDiff: map[string]string{"0x01": "0x01"}}},
+		{contract.Hex(): {Balance: "-1"}},
+		{contract.Hex(): {Nonce: "0x1"}},
+		{contract.Hex(): {Code: "60"}},
+		{contract.Hex(): {StateDiff: map[string]string{"0x" + strings.Repeat("00", 33): "0x01"}}},
+		{"sei1invalid": {}},
+		tooMany,
+	} {
+		_, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: contract.Hex(), Overrides: overrides})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
+}
+
+func TestQueryStaticCallBlockOverrides(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(8).WithBlockTime(time.Unix(1000, 0)))
+	_, timestamp := testkeeper.MockAddressPair()
+	_, number := testkeeper.MockAddressPair()
+	_, baseFee := testkeeper.MockAddressPair()
+	_, coinbase := testkeeper.MockAddressPair()
+	// MSTORE(0, <opcode>) RETURN(0, 32)
+	k.SetCode(ctx, timestamp, common.FromHex("0x4260005260206000f3"))
+	k.SetCode(ctx, number, common.FromHex("0x4360005260206000f3"))
+	k.SetCode(ctx, baseFee, common.FromHex("0x4860005260206000f3"))
+	k.SetCode(ctx, coinbase, common.FromHex("0x4160005260206000f3"))
+	call := func(to common.Address, overrides *types.BlockOverrides) *big.Int {
+		res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: to.Hex(), BlockOverrides: overrides})
+		require.Nil(t, err)
+		return new(big.Int).SetBytes(res.Data)
+	}
+
+	// a timestamp before the block time is applied as given
+	overrides := &types.BlockOverrides{Number: "1000000", Time: "5", BaseFee: "123", Coinbase: timestamp.Hex()}
+	require.Equal(t, big.NewInt(5), call(timestamp, overrides))
+	require.Equal(t, big.NewInt(1000000), call(number, overrides))
+	require.Equal(t, big.NewInt(123), call(baseFee, overrides))
+	require.Equal(t, new(big.Int).SetBytes(timestamp.Bytes()), call(coinbase, overrides))
+	// overrides don't carry over to later calls
+	require.Equal(t, big.NewInt(1000), call(timestamp, nil))
+	require.Equal(t, big.NewInt(8), call(number, &types.BlockOverrides{Time: "5"}))
+
+	for _, overrides := range []*types.BlockOverrides{{Number: "-1"}, {Time: "soon"}, {BaseFee: "1.5"}, {Coinbase: "0x12"}} {
+		_, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: timestamp.Hex(), BlockOverrides: overrides})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
+}
+
+func TestQueryStaticCalls(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, echo := testkeeper.MockAddressPair()
+	// MSTORE(0, CALLER) RETURN(0, 32)
+	k.SetCode(ctx, echo, common.FromHex("0x3360005260206000f3"))
+	_, reverter := testkeeper.MockAddressPair()
+	// PUSH1 0 PUSH1 0 REVERT
+	k.SetCode(ctx, reverter, common.FromHex("0x60006000fd"))
+	_, caller := testkeeper.MockAddressPair()
+
+	res, err := q.StaticCalls(goCtx, &types.QueryStaticCallsRequest{Calls: []*types.StaticCallEntry{
+		{To: echo.Hex(), From: caller.Hex()},
+		{To: reverter.Hex()},
+		{To: "0xnothex"},
+		{To: echo.Hex(), From: "sei1notanevmaddress"},
+		{To: echo.Hex()},
+	}})
+	require.Nil(t, err)
+	require.Len(t, res.Results, 5)
+	require.Equal(t, caller, common.BytesToAddress(res.Results[0].Data))
+	require.Empty(t, res.Results[0].Error)
+	require.NotZero(t, res.Results[0].GasUsed)
+	require.Equal(t, vm.ErrExecutionReverted.Error(), res.Results[1].Error)
+	require.Equal(t, "invalid to address", res.Results[2].Error)
+	require.Equal(t, "invalid from address", res.Results[3].Error)
+	require.Zero(t, res.Results[3].GasUsed)
+	require.Equal(t, k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName)), common.BytesToAddress(res.Results[4].Data))
+
+	// calls share the query gas limit; once it is spent, later calls fail
+	_, looper := testkeeper.MockAddressPair()
+	// JUMPDEST PUSH1 0 JUMP
+	k.SetCode(ctx, looper, common.FromHex("0x5b600056"))
+	res, err = q.StaticCalls(goCtx, &types.QueryStaticCallsRequest{Calls: []*types.StaticCallEntry{
+		{To: looper.Hex()},
+		{To: echo.Hex()},
+	}})
+	require.Nil(t, err)
+	require.NotEmpty(t, res.Results[0].Error)
+	require.Equal(t, k.QueryConfig.GasLimit, res.Results[0].GasUsed)
+	require.Contains(t, res.Results[1].Error, "out of gas")
+
+	_, err = q.StaticCalls(goCtx, &types.QueryStaticCallsRequest{Calls: make([]*types.StaticCallEntry, keeper.MaxStaticCallBatchSize+1)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryStaticCallRevertReasons(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	setReverter := func(revertData []byte) common.Address {
+		_, reverter := testkeeper.MockAddressPair()
+		// CODECOPY the revert data appended after this 12-byte prefix and REVERT with it
+		prefix := []byte{0x60, byte(len(revertData)), 0x60, 12, 0x60, 0, 0x39, 0x60, byte(len(revertData)), 0x60, 0, 0xfd}
+		k.SetCode(ctx, reverter, append(prefix, revertData...))
+		return reverter
+	}
+	stringType, _ := abi.NewType("string", "", nil)
+	uintType, _ := abi.NewType("uint256", "", nil)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("not allowed")
+	require.Nil(t, err)
+	errorData := append(common.FromHex("0x08c379a0"), reason...)
+	code, err := abi.Arguments{{Type: uintType}}.Pack(big.NewInt(0x11))
+	require.Nil(t, err)
+	panicData := append(common.FromHex("0x4e487b71"), code...)
+	customData := common.FromHex("0xdeadbeef")
+
+	res, err := q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: setReverter(errorData).Hex()})
+	require.Nil(t, err)
+	require.Empty(t, res.Data)
+	require.Equal(t, "not allowed", res.RevertError.Reason)
+	require.Equal(t, errorData, res.RevertError.Data)
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: setReverter(panicData).Hex()})
+	require.Nil(t, err)
+	require.Contains(t, res.RevertError.Reason, "arithmetic underflow or overflow")
+	require.Equal(t, panicData, res.RevertError.Data)
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: setReverter(customData).Hex()})
+	require.Nil(t, err)
+	require.Empty(t, res.RevertError.Reason)
+	require.Equal(t, customData, res.RevertError.Selector)
+	require.Equal(t, customData, res.RevertError.Data)
+
+	// standard reverts are decoded alongside custom errors
+	errorABI := `[{"type":"error","name":"Paused","inputs":[]}]`
+	res, err = q.StaticCall(goCtx, &types.QueryStaticCallRequest{To: setReverter(errorData).Hex(), ErrorAbis: []string{errorABI}})
+	require.Nil(t, err)
+	require.Equal(t, "not allowed", res.RevertError.Reason)
+	require.Equal(t, errorData, res.RevertError.Data)
+}
+
+func TestQueryEstimateGas(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, echo := testkeeper.MockAddressPair()
+	// MSTORE(0, CALLER) RETURN(0, 32)
+	echoCode := common.FromHex("0x3360005260206000f3")
+	k.SetCode(ctx, echo, echoCode)
+
+	res, err := q.EstimateGas(goCtx, &types.QueryEstimateGasRequest{To: echo.Hex()})
+	require.Nil(t, err)
+	require.Greater(t, res.Gas, uint64(21000))
+	require.Equal(t, res.LowerBound+1, res.Gas)
+	require.Greater(t, res.UpperBound, res.Gas)
+	require.Empty(t, res.RevertReason)
+
+	// CODECOPY the runtime code appended after this 12-byte prefix and RETURN it
+	initCode := append(common.FromHex("0x6009600c60003960096000f3"), echoCode...)
+	creation, err := q.EstimateGas(goCtx, &types.QueryEstimateGasRequest{Data: initCode})
+	require.Nil(t, err)
+	require.Greater(t, creation.Gas, uint64(53000))
+	require.Empty(t, k.GetCode(ctx, crypto.CreateAddress(k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName)), 0)))
+
+	stringType, _ := abi.NewType("string", "", nil)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("not allowed")
+	require.Nil(t, err)
+	revertData := append(common.FromHex("0x08c379a0"), reason...)
+	_, reverter := testkeeper.MockAddressPair()
+	// CODECOPY the revert data appended after this 12-byte prefix and REVERT with it
+	prefix := []byte{0x60, byte(len(revertData)), 0x60, 12, 0x60, 0, 0x39, 0x60, byte(len(revertData)), 0x60, 0, 0xfd}
+	k.SetCode(ctx, reverter, append(prefix, revertData...))
+	res, err = q.EstimateGas(goCtx, &types.QueryEstimateGasRequest{To: reverter.Hex()})
+	require.Nil(t, err)
+	require.Zero(t, res.Gas)
+	require.Equal(t, "not allowed", res.RevertReason)
+	require.Equal(t, revertData, res.RevertData)
+
+	_, looper := testkeeper.MockAddressPair()
+	// JUMPDEST PUSH1 0 JUMP
+	k.SetCode(ctx, looper, common.FromHex("0x5b600056"))
+	_, err = q.EstimateGas(goCtx, &types.QueryEstimateGasRequest{To: looper.Hex()})
+	require.ErrorIs(t, err, keeper.ErrGasCapExceeded)
+
+	_, err = q.EstimateGas(goCtx, &types.QueryEstimateGasRequest{To: echo.Hex(), Value: "-1"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.EstimateGas(goCtx, &types.QueryEstimateGasRequest{To: "0xnothex"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryAccessList(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, reader := testkeeper.MockAddressPair()
+	_, other := testkeeper.MockAddressPair()
+	// SLOAD(1) POP BALANCE(other) POP STOP
+	code := append(common.FromHex("0x6001545073"), other.Bytes()...)
+	k.SetCode(ctx, reader, append(code, common.FromHex("0x315000")...))
+
+	res, err := q.AccessList(goCtx, &types.QueryAccessListRequest{To: reader.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.Reverted)
+	require.Greater(t, res.Gas, uint64(21000))
+	require.ElementsMatch(t, []*types.AccessTuple{
+		{Address: reader.Hex(), StorageKeys: []string{common.BigToHash(big.NewInt(1)).Hex()}},
+		{Address: other.Hex(), StorageKeys: []string{}},
+	}, res.AccessList)
+
+	_, reverter := testkeeper.MockAddressPair()
+	// SLOAD(2) POP REVERT(0, 0)
+	k.SetCode(ctx, reverter, common.FromHex("0x60025450600060006000fd"))
+	res, err = q.AccessList(goCtx, &types.QueryAccessListRequest{To: reverter.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.Reverted)
+	require.Zero(t, res.Gas)
+	require.Equal(t, []*types.AccessTuple{
+		{Address: reverter.Hex(), StorageKeys: []string{common.BigToHash(big.NewInt(2)).Hex()}},
+	}, res.AccessList)
+
+	_, looper := testkeeper.MockAddressPair()
+	// JUMPDEST PUSH1 0 JUMP
+	k.SetCode(ctx, looper, common.FromHex("0x5b600056"))
+	_, err = q.AccessList(goCtx, &types.QueryAccessListRequest{To: looper.Hex()})
+	require.ErrorIs(t, err, keeper.ErrGasCapExceeded)
+
+	_, err = q.AccessList(goCtx, &types.QueryAccessListRequest{To: reader.Hex(), Value: "abc"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.AccessList(goCtx, &types.QueryAccessListRequest{To: reader.Hex(), Height: -1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryTraceCall(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, reader := testkeeper.MockAddressPair()
+	_, other := testkeeper.MockAddressPair()
+	// SLOAD(1) POP BALANCE(other) POP STOP
+	code := append(common.FromHex("0x6001545073"), other.Bytes()...)
+	k.SetCode(ctx, reader, append(code, common.FromHex("0x315000")...))
+	_, caller := testkeeper.MockAddressPair()
+	// STATICCALL(GAS, reader, 0, 0, 0, 0) POP STOP
+	code = append(common.FromHex("0x600060006000600073"), reader.Bytes()...)
+	k.SetCode(ctx, caller, append(code, common.FromHex("0x5afa5000")...))
+
+	type structLogs struct {
+		Failed     bool `json:"failed"`
+		StructLogs []struct {
+			Op    string `json:"op"`
+			Depth int    `json:"depth"`
+		} `json:"structLogs"`
+	}
+	res, err := q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex()})
+	require.Nil(t, err)
+	var trace structLogs
+	require.Nil(t, json.Unmarshal(res.Result, &trace))
+	require.False(t, trace.Failed)
+	require.Len(t, trace.StructLogs, 16)
+	require.Equal(t, 2, trace.StructLogs[8].Depth)
+
+	res, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex(), StructLogConfig: &types.StructLogConfig{MaxDepth: 1}})
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(res.Result, &trace))
+	require.Len(t, trace.StructLogs, 9)
+	res, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex(), StructLogConfig: &types.StructLogConfig{Limit: 3}})
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(res.Result, &trace))
+	require.Len(t, trace.StructLogs, 3)
+
+	res, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex(), Tracer: "callTracer"})
+	require.Nil(t, err)
+	var call struct {
+		Type  string `json:"type"`
+		To    string `json:"to"`
+		Calls []struct {
+			Type string `json:"type"`
+			To   string `json:"to"`
+		} `json:"calls"`
+	}
+	require.Nil(t, json.Unmarshal(res.Result, &call))
+	require.Equal(t, "CALL", call.Type)
+	require.Equal(t, strings.ToLower(caller.Hex()), call.To)
+	require.Len(t, call.Calls, 1)
+	require.Equal(t, "STATICCALL", call.Calls[0].Type)
+	require.Equal(t, strings.ToLower(reader.Hex()), call.Calls[0].To)
+
+	res, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex(), Tracer: "prestateTracer"})
+	require.Nil(t, err)
+	var prestate map[string]json.RawMessage
+	require.Nil(t, json.Unmarshal(res.Result, &prestate))
+	require.Contains(t, prestate, strings.ToLower(reader.Hex()))
+	require.Contains(t, prestate, strings.ToLower(other.Hex()))
+
+	_, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex(), Tracer: "4byteTracer"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex(), Tracer: "callTracer", TracerConfig: []byte("{")})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	k.QueryConfig.MaxTraceSize = 10
+	_, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex()})
+	require.ErrorContains(t, err, "exceeds the limit")
+	k.QueryConfig.DisableTracing = true
+	_, err = q.TraceCall(goCtx, &types.QueryTraceCallRequest{To: caller.Hex()})
+	require.ErrorIs(t, err, keeper.ErrTracingDisabled)
+}
+
+func TestQueryContractInfo(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithTxSum([32]byte{1, 2, 3})
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, legacy := testkeeper.MockAddressPair()
+	k.SetCode(ctx, legacy, common.FromHex("0x00"))
+	res, err := q.ContractInfo(goCtx, &types.QueryContractInfoRequest{Address: legacy.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.HasCode)
+	require.False(t, res.Exists)
+
+	_, deployer := testkeeper.MockAddressPair()
+	// PUSH5 <init code returning a single STOP> MSTORE(0) CREATE2(0, 27, 5, 0) STOP
+	factoryCode := common.FromHex("0x6460016000f360005260006005601b6000f500")
+	// CODECOPY the runtime code appended after this 12-byte prefix and RETURN it
+	initCode := append([]byte{0x60, byte(len(factoryCode)), 0x60, 12, 0x60, 0, 0x39, 0x60, byte(len(factoryCode)), 0x60, 0, 0xf3}, factoryCode...)
+	factory := crypto.CreateAddress(deployer, k.GetNonce(ctx, deployer))
+	_, err = k.CallEVM(ctx, deployer, nil, nil, initCode)
+	require.Nil(t, err)
+	res, err = q.ContractInfo(goCtx, &types.QueryContractInfoRequest{Address: factory.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.HasCode)
+	require.True(t, res.Exists)
+	txHash := common.Hash{1, 2, 3}.Hex()
+	require.Equal(t, types.ContractCreationInfo{Deployer: deployer.Hex(), TxHash: txHash, Height: ctx.BlockHeight()}, res.CreationInfo)
+
+	_, caller := testkeeper.MockAddressPair()
+	_, err = k.CallEVM(ctx, caller, &factory, nil, nil)
+	require.Nil(t, err)
+	child := crypto.CreateAddress2(factory, common.Hash{}, crypto.Keccak256(common.FromHex("0x60016000f3")))
+	res, err = q.ContractInfo(goCtx, &types.QueryContractInfoRequest{Address: child.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.HasCode)
+	require.True(t, res.Exists)
+	require.Equal(t, caller.Hex(), res.CreationInfo.Deployer)
+
+	_, err = q.ContractInfo(goCtx, &types.QueryContractInfoRequest{Address: "0xnothex"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryEstimateRegisterPointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	sender, _ := testkeeper.MockAddressPair()
+	_, erc20Addr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+	req := &types.QueryEstimateRegisterPointerRequest{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()}
+	globalMinGasPrice := sdk.NewDecWithPrec(2, 2)
+	feesParams := k.ParamsKeeper.GetFeesParams(ctx)
+	feesParams.GlobalMinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("usei", globalMinGasPrice))
+	k.ParamsKeeper.SetFeesParams(ctx, feesParams)
+
+	res, err := q.EstimateRegisterPointer(goCtx, req)
+	require.Nil(t, err)
+	require.NotZero(t, res.Gas)
+	// the fee prices SDK gas at the minimum gas price, not the EVM base fee
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("usei", globalMinGasPrice.MulInt64(int64(res.Gas)).Ceil().RoundInt())).String(), res.Fee)
+	// a higher minimum gas price of the node takes precedence
+	nodeMinGasPrice := sdk.NewDecWithPrec(1, 1)
+	nodeRes, err := q.EstimateRegisterPointer(sdk.WrapSDKContext(ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("usei", nodeMinGasPrice)))), req)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("usei", nodeMinGasPrice.MulInt64(int64(nodeRes.Gas)).Ceil().RoundInt())).String(), nodeRes.Fee)
+	// nothing is committed
+	_, _, exists := k.GetCW20ERC20Pointer(ctx, erc20Addr)
+	require.False(t, exists)
+
+	registered, err := keeper.NewMsgServerImpl(k).RegisterPointer(goCtx, &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, registered.PointerAddress, res.PointerAddress)
+	_, err = q.EstimateRegisterPointer(goCtx, req)
+	require.ErrorContains(t, err, "already registered")
+
+	_, missing := testkeeper.MockAddressPair()
+	_, err = q.EstimateRegisterPointer(goCtx, &types.QueryEstimateRegisterPointerRequest{Sender: sender.String(), PointerType: types.PointerType_ERC721, ErcAddress: missing.Hex()})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	// pointers to CW contracts are not supported
+	_, err = q.EstimateRegisterPointer(goCtx, &types.QueryEstimateRegisterPointerRequest{Sender: sender.String(), PointerType: types.PointerType_CW20, ErcAddress: erc20Addr.Hex()})
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+	require.ErrorContains(t, err, "CW20 pointers cannot be estimated")
+}
+
+func TestQueryCode(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, contract := testkeeper.MockAddressPair()
+	code := common.FromHex("0x3360005260206000f3")
+	k.SetCode(ctx, contract, code)
+
+	res, err := q.Code(goCtx, &types.QueryCodeRequest{Address: contract.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, code, res.Code)
+	require.Equal(t, crypto.Keccak256Hash(code).Hex(), res.CodeHash)
+	require.True(t, res.Exists)
+	require.False(t, res.IsPointer)
+
+	_, eoa := testkeeper.MockAddressPair()
+	res, err = q.Code(goCtx, &types.QueryCodeRequest{Address: eoa.Hex()})
+	require.Nil(t, err)
+	require.Empty(t, res.Code)
+	require.False(t, res.Exists)
+
+	_, pointer := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointer, code)
+	require.Nil(t, k.SetERC20NativePointer(ctx, "test", pointer))
+	res, err = q.Code(goCtx, &types.QueryCodeRequest{Address: pointer.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.IsPointer)
+
+	_, err = q.Code(goCtx, &types.QueryCodeRequest{Address: "0xnothex"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Code(goCtx, &types.QueryCodeRequest{Address: contract.Hex(), Height: -1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Code(goCtx, &types.QueryCodeRequest{Address: contract.Hex(), Height: 100})
+	require.ErrorIs(t, err, types.ErrHeightNotAvailable)
+}
+
+func TestQueryStorage(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, contract := testkeeper.MockAddressPair()
+	k.SetCode(ctx, contract, common.FromHex("0x00"))
+	slot := common.BytesToHash([]byte{1})
+	k.SetState(ctx, contract, slot, common.BytesToHash([]byte{42}))
+
+	res, err := q.Storage(goCtx, &types.QueryStorageRequest{Address: contract.Hex(), Slot: "0x01"})
+	require.Nil(t, err)
+	require.Equal(t, common.BytesToHash([]byte{42}).Hex(), res.Value)
+	require.True(t, res.AccountExists)
+	res, err = q.Storage(goCtx, &types.QueryStorageRequest{Address: contract.Hex(), Slot: slot.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, common.BytesToHash([]byte{42}).Hex(), res.Value)
+
+	_, missing := testkeeper.MockAddressPair()
+	res, err = q.Storage(goCtx, &types.QueryStorageRequest{Address: missing.Hex(), Slot: "0x01"})
+	require.Nil(t, err)
+	require.Equal(t, common.Hash{}.Hex(), res.Value)
+	require.False(t, res.AccountExists)
+
+	for _, slot := range []string{"", "01", "0xzz", "0x" + strings.Repeat("00", 33)} {
+		_, err = q.Storage(goCtx, &types.QueryStorageRequest{Address: contract.Hex(), Slot: slot})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
+	_, err = q.Storage(goCtx, &types.QueryStorageRequest{Address: "0xnothex", Slot: "0x01"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Storage(goCtx, &types.QueryStorageRequest{Address: contract.Hex(), Slot: "0x01", Height: 100})
+	require.ErrorIs(t, err, types.ErrHeightNotAvailable)
+}
+
+func TestQueryNonce(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	k.SetNonce(ctx, evmAddr, 7)
+
+	res, err := q.Nonce(goCtx, &types.QueryNonceRequest{Address: evmAddr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), res.Nonce)
+	require.True(t, res.Associated)
+	res, err = q.Nonce(goCtx, &types.QueryNonceRequest{Address: seiAddr.String()})
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), res.Nonce)
+	require.Equal(t, evmAddr.Hex(), res.EvmAddress)
+	require.True(t, res.Associated)
+
+	// unassociated Sei addresses resolve to their directly cast EVM address
+	unassociated, _ := testkeeper.MockAddressPair()
+	k.SetNonce(ctx, common.BytesToAddress(unassociated), 3)
+	res, err = q.Nonce(goCtx, &types.QueryNonceRequest{Address: unassociated.String()})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), res.Nonce)
+	require.Equal(t, common.BytesToAddress(unassociated).Hex(), res.EvmAddress)
+	require.False(t, res.Associated)
+
+	_, err = q.Nonce(goCtx, &types.QueryNonceRequest{Address: "notanaddress"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPendingNonce(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	k.SetNonce(ctx, evmAddr, 7)
+
+	// nothing has been checked into the mempool yet
+	res, err := q.PendingNonce(goCtx, &types.QueryPendingNonceRequest{Address: evmAddr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPendingNonceResponse{CommittedNonce: 7, PendingNonce: 7, PendingUnavailable: true, EvmAddress: evmAddr.Hex()}, *res)
+
+	k.AddPendingNonce(tmtypes.TxKey{1}, evmAddr, 7, 1)
+	k.AddPendingNonce(tmtypes.TxKey{2}, evmAddr, 8, 1)
+	k.AddPendingNonce(tmtypes.TxKey{3}, evmAddr, 10, 1)
+	res, err = q.PendingNonce(goCtx, &types.QueryPendingNonceRequest{Address: seiAddr.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPendingNonceResponse{CommittedNonce: 7, PendingNonce: 9, EvmAddress: evmAddr.Hex()}, *res)
+
+	_, other := testkeeper.MockAddressPair()
+	res, err = q.PendingNonce(goCtx, &types.QueryPendingNonceRequest{Address: other.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.PendingUnavailable)
+	require.Zero(t, res.PendingNonce)
+
+	_, err = q.PendingNonce(goCtx, &types.QueryPendingNonceRequest{Address: "notanaddress"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryBalance(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(2)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, seiAddr, amt))
+	require.Nil(t, k.BankKeeper().AddWei(ctx, seiAddr, sdk.NewInt(5)))
+
+	res, err := q.Balance(goCtx, &types.QueryBalanceRequest{Address: evmAddr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, "2000000000005", res.Balance)
+	require.Equal(t, seiAddr.String(), res.SeiAddress)
+
+	_, untouched := testkeeper.MockAddressPair()
+	res, err = q.Balance(goCtx, &types.QueryBalanceRequest{Address: untouched.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, "0", res.Balance)
+	require.Equal(t, sdk.AccAddress(untouched[:]).String(), res.SeiAddress)
+
+	_, err = q.Balance(goCtx, &types.QueryBalanceRequest{Address: "0xnothex"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Balance(goCtx, &types.QueryBalanceRequest{Address: evmAddr.Hex(), Height: 100})
+	require.ErrorIs(t, err, types.ErrHeightNotAvailable)
+}
+
+func TestQueryAccount(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(2)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, seiAddr, amt))
+	k.SetNonce(ctx, evmAddr, 3)
+
+	for _, input := range []string{evmAddr.Hex(), seiAddr.String()} {
+		res, err := q.Account(goCtx, &types.QueryAccountRequest{Address: input})
+		require.Nil(t, err)
+		require.Equal(t, evmAddr.Hex(), res.EvmAddress)
+		require.Equal(t, "2000000000000", res.WeiBalance)
+		require.Equal(t, uint64(3), res.Nonce)
+		require.Equal(t, k.GetCodeHash(ctx, evmAddr).Hex(), res.CodeHash)
+		require.False(t, res.IsContract)
+		require.True(t, res.Associated)
+	}
+	res, _ := q.Account(goCtx, &types.QueryAccountRequest{Address: seiAddr.String()})
+	require.Equal(t, evmAddr.Hex(), res.CounterpartAddress)
+
+	_, pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "test", pointer))
+	k.SetCode(ctx, pointer, []byte{1})
+	res, err := q.Account(goCtx, &types.QueryAccountRequest{Address: pointer.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.IsContract)
+	require.True(t, res.IsPointer)
+
+	unknownSei, _ := testkeeper.MockAddressPair()
+	res, err = q.Account(goCtx, &types.QueryAccountRequest{Address: unknownSei.String()})
+	require.Nil(t, err)
+	require.False(t, res.Associated)
+	require.Equal(t, common.BytesToAddress(unknownSei).Hex(), res.EvmAddress)
+	require.Equal(t, "0", res.WeiBalance)
+	require.Equal(t, uint64(0), res.Nonce)
+	require.Equal(t, common.Hash{}.Hex(), res.CodeHash)
+
+	_, err = q.Account(goCtx, &types.QueryAccountRequest{Address: "notanaddress"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryReceipt(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, contract := testkeeper.MockAddressPair()
+	txHash := common.Hash{1}
+	require.Nil(t, k.SetTransientReceipt(ctx, txHash, &types.Receipt{
+		TxHashHex: txHash.Hex(), Status: 1, GasUsed: 21000, CumulativeGasUsed: 42000, EffectiveGasPrice: 100,
+		ContractAddress: contract.Hex(),
+		Logs:            []*types.Log{{Address: contract.Hex(), Topics: []string{common.Hash{2}.Hex()}}},
+	}))
+	// receipt synthesized for a CW20 transfer through a pointer
+	cosmosTxHash := common.Hash{3}
+	require.Nil(t, k.SetTransientReceipt(ctx, cosmosTxHash, &types.Receipt{
+		TxType: math.MaxUint32, TxHashHex: cosmosTxHash.Hex(), Status: 1,
+		Logs: []*types.Log{{Address: contract.Hex(), Synthetic: true}},
+	}))
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+
+	res, err := q.Receipt(goCtx, &types.QueryReceiptRequest{TxHash: txHash.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.False(t, res.Synthetic)
+	require.Equal(t, uint64(21000), res.Receipt.GasUsed)
+	require.Equal(t, uint64(42000), res.Receipt.CumulativeGasUsed)
+	require.Equal(t, uint64(100), res.Receipt.EffectiveGasPrice)
+	require.Equal(t, contract.Hex(), res.Receipt.ContractAddress)
+	require.Equal(t, []string{common.Hash{2}.Hex()}, res.Receipt.Logs[0].Topics)
+
+	res, err = q.Receipt(goCtx, &types.QueryReceiptRequest{TxHash: cosmosTxHash.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.True(t, res.Synthetic)
+	require.True(t, res.Receipt.Logs[0].Synthetic)
+
+	res, err = q.Receipt(goCtx, &types.QueryReceiptRequest{TxHash: common.Hash{4}.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	require.Nil(t, res.Receipt)
+
+	_, err = q.Receipt(goCtx, &types.QueryReceiptRequest{TxHash: "0x1234"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryParams(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	q := keeper.Querier{k}
+	res, err := q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, k.GetParams(ctx), res.Params)
+	require.Equal(t, k.GetStoredPointerCodeID(ctx, types.PointerType_ERC20), res.Erc20PointerCodeId)
+	require.NotZero(t, res.Erc20PointerCodeId)
+	require.NotZero(t, res.Erc721PointerCodeId)
+	require.NotZero(t, res.Erc1155PointerCodeId)
+}
+
+func TestQueryPointersByPointees(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, nativePointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "test", nativePointer))
+	cw20, _ := testkeeper.MockAddressPair()
+	_, cw20Pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20.String(), cw20Pointer))
+	unknown, _ := testkeeper.MockAddressPair()
+
+	res, err := q.PointersByPointees(goCtx, &types.QueryPointersByPointeesRequest{Queries: []*types.QueryPointerRequest{
+		{PointerType: types.PointerType_NATIVE, Pointee: "test"},
+		{PointerType: types.PointerType_CW20, Pointee: "notbech32"},
+		{PointerType: types.PointerType_CW20, Pointee: cw20.String()},
+		{PointerType: types.PointerType_CW20, Pointee: unknown.String()},
+		{PointerType: types.PointerType_ERC20, Pointee: "0xnothex"},
+	}})
+	require.Nil(t, err)
+	require.Len(t, res.Results, 5)
+	require.True(t, res.Results[0].Exists)
+	require.Equal(t, nativePointer.Hex(), res.Results[0].Pointer)
+	require.Equal(t, "test", res.Results[0].Pointee)
+	require.NotEmpty(t, res.Results[1].Error)
+	require.False(t, res.Results[1].Exists)
+	require.True(t, res.Results[2].Exists)
+	require.Equal(t, cw20Pointer.Hex(), res.Results[2].Pointer)
+	require.Equal(t, types.PointerType_CW20, res.Results[2].PointerType)
+	require.False(t, res.Results[3].Exists)
+	require.Empty(t, res.Results[3].Error)
+	require.Equal(t, "invalid hex address", res.Results[4].Error)
+
+	_, err = q.PointersByPointees(goCtx, &types.QueryPointersByPointeesRequest{Queries: make([]*types.QueryPointerRequest, keeper.MaxPointerBatchSize+1)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPointeesByPointers(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, nativePointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "test", nativePointer))
+	cwPointer, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+	_, unknown := testkeeper.MockAddressPair()
+
+	res, err := q.PointeesByPointers(goCtx, &types.QueryPointeesByPointersRequest{Queries: []*types.PointeeLookup{
+		{PointerType: types.PointerType_NATIVE, Pointer: nativePointer.Hex()},
+		{PointerType: types.PointerType_CW20, Pointer: nativePointer.Hex()},
+		{AnyType: true, Pointer: cwPointer.String()},
+		{PointerType: types.PointerType_ERC20, Pointer: nativePointer.Hex()},
+		{AnyType: true, Pointer: unknown.Hex()},
+		{AnyType: true, Pointer: "notanaddress"},
+	}})
+	require.Nil(t, err)
+	require.Len(t, res.Results, 6)
+	require.True(t, res.Results[0].Exists)
+	require.Equal(t, "test", res.Results[0].Pointee)
+	require.False(t, res.Results[1].Exists)
+	require.Empty(t, res.Results[1].Error)
+	require.True(t, res.Results[2].Exists)
+	require.Equal(t, types.PointerType_ERC20, res.Results[2].PointerType)
+	require.Equal(t, erc20Addr.Hex(), res.Results[2].Pointee)
+	require.NotEmpty(t, res.Results[3].Error)
+	require.False(t, res.Results[4].Exists)
+	require.Empty(t, res.Results[4].Error)
+	require.Equal(t, "invalid hex or bech32 address", res.Results[5].Error)
+
+	_, err = q.PointeesByPointers(goCtx, &types.QueryPointeesByPointersRequest{Queries: make([]*types.PointeeLookup, keeper.MaxPointerBatchSize+1)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryAssociations(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	associated := map[string]string{}
+	for i := 0; i < 3; i++ {
+		seiAddr, evmAddr := testkeeper.MockAddressPair()
+		k.SetAddressMapping(ctx, seiAddr, evmAddr)
+		associated[evmAddr.Hex()] = seiAddr.String()
+	}
+
+	// page through everything, resuming from page keys
+	var all []*types.Association
+	pageReq := &query.PageRequest{Limit: 2}
+	for {
+		res, err := q.Associations(goCtx, &types.QueryAssociationsRequest{Pagination: pageReq})
+		require.Nil(t, err)
+		require.LessOrEqual(t, len(res.Associations), 2)
+		all = append(all, res.Associations...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	found := 0
+	for i, association := range all {
+		if i > 0 {
+			require.Negative(t, bytes.Compare(common.HexToAddress(all[i-1].EvmAddress).Bytes(), common.HexToAddress(association.EvmAddress).Bytes()))
+		}
+		if seiAddr, ok := associated[association.EvmAddress]; ok {
+			require.Equal(t, seiAddr, association.SeiAddress)
+			found++
+		}
+	}
+	require.Equal(t, 3, found)
+
+	// filtered mode returns only the associated subset
+	_, unassociated := testkeeper.MockAddressPair()
+	var first string
+	for evmAddr := range associated {
+		first = evmAddr
+		break
+	}
+	res, err := q.Associations(goCtx, &types.QueryAssociationsRequest{EvmAddresses: []string{unassociated.Hex(), first}})
+	require.Nil(t, err)
+	require.Equal(t, []*types.Association{{EvmAddress: first, SeiAddress: associated[first]}}, res.Associations)
+
+	_, err = q.Associations(goCtx, &types.QueryAssociationsRequest{EvmAddresses: []string{"0xnothex"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPointerVersions(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	res, err := q.PointerVersions(goCtx, &types.QueryPointerVersionsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(types.ConsensusVersion), res.ConsensusVersion)
+	require.Len(t, res.Versions, len(types.PointerType_name))
+	for i, entry := range res.Versions {
+		require.Equal(t, types.PointerType(i), entry.PointerType)
+		version, err := q.PointerVersion(goCtx, &types.QueryPointerVersionRequest{PointerType: entry.PointerType})
+		require.Nil(t, err)
+		require.Equal(t, version.Version, entry.Version)
+		require.Equal(t, version.CwCodeId, entry.CwCodeId)
+	}
+	require.NotZero(t, res.Versions[types.PointerType_ERC20].CwCodeId)
+	require.Zero(t, res.Versions[types.PointerType_NATIVE].CwCodeId)
+}
+
+func TestQueryPointerRegistrationAllowlist(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	res, err := q.PointerRegistrationAllowlist(sdk.WrapSDKContext(ctx), &types.QueryPointerRegistrationAllowlistRequest{})
+	require.Nil(t, err)
+	require.Empty(t, res.Addresses)
+	require.True(t, res.Permissionless)
+
+	allowed, _ := testkeeper.MockAddressPair()
+	params := k.GetParams(ctx)
+	params.PointerRegistrationAllowlist = []string{allowed.String()}
+	k.SetParams(ctx, params)
+	res, err = q.PointerRegistrationAllowlist(sdk.WrapSDKContext(ctx), &types.QueryPointerRegistrationAllowlistRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []string{allowed.String()}, res.Addresses)
+	require.False(t, res.Permissionless)
+}
+
+func TestQueryPointerCodeIDs(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	currentCodeID := k.GetStoredPointerCodeID(ctx, types.PointerType_ERC20)
+	require.NotZero(t, currentCodeID)
+
+	// code IDs of earlier versions are kept
+	k.SetStoredPointerCodeID(ctx, types.PointerType_ERC20, 1, 7)
+	res, err := q.PointerCodeIDs(goCtx, &types.QueryPointerCodeIDsRequest{PointerType: types.PointerType_ERC20})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryPointerCodeIDsResponse{
+		CodeIds: []*types.PointerCodeID{
+			{Version: 1, CodeId: 7},
+			{Version: uint32(erc20.CurrentVersion), CodeId: currentCodeID},
+		},
+		CurrentVersion: uint32(erc20.CurrentVersion),
+	}, res)
+	require.Equal(t, currentCodeID, k.GetStoredPointerCodeID(ctx, types.PointerType_ERC20))
+
+	_, err = q.PointerCodeIDs(goCtx, &types.QueryPointerCodeIDsRequest{PointerType: types.PointerType_CW20})
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestQueryPointersByCodeID(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	sender, _ := testkeeper.MockAddressPair()
+	msgServer := keeper.NewMsgServerImpl(k)
+	var pointers []*types.PointerEntry
+	for i := 0; i < 2; i++ {
+		_, erc20Addr := testkeeper.MockAddressPair()
+		k.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+		res, err := msgServer.RegisterPointer(goCtx, &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()})
+		require.Nil(t, err)
+		pointers = append(pointers, &types.PointerEntry{Pointee: erc20Addr.Hex(), Pointer: res.PointerAddress, Version: uint32(erc20.CurrentVersion)})
+	}
+	sort.Slice(pointers, func(i, j int) bool {
+		return strings.ToLower(pointers[i].Pointee) < strings.ToLower(pointers[j].Pointee)
+	})
+	// registered pointers that are not instantiated from the code are skipped
+	cwAddr, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwAddr.String()))
+
+	codeID := k.GetStoredPointerCodeID(ctx, types.PointerType_ERC20)
+	res, err := q.PointersByCodeID(goCtx, &types.QueryPointersByCodeIDRequest{CodeId: codeID, Pagination: &query.PageRequest{Limit: 1}})
+	require.Nil(t, err)
+	require.True(t, res.IsPointerCode)
+	require.Equal(t, types.PointerType_ERC20, res.PointerType)
+	require.Equal(t, uint32(erc20.CurrentVersion), res.Version)
+	require.Equal(t, pointers[:1], res.Pointers)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, err = q.PointersByCodeID(goCtx, &types.QueryPointersByCodeIDRequest{CodeId: codeID, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.Nil(t, err)
+	require.Equal(t, pointers[1:], res.Pointers)
+
+	// historical code IDs are matched too
+	k.SetStoredPointerCodeID(ctx, types.PointerType_ERC721, 1, 999)
+	res, err = q.PointersByCodeID(goCtx, &types.QueryPointersByCodeIDRequest{CodeId: 999})
+	require.Nil(t, err)
+	require.True(t, res.IsPointerCode)
+	require.Equal(t, types.PointerType_ERC721, res.PointerType)
+	require.Equal(t, uint32(1), res.Version)
+	require.Empty(t, res.Pointers)
+
+	res, err = q.PointersByCodeID(goCtx, &types.QueryPointersByCodeIDRequest{CodeId: 1000})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryPointersByCodeIDResponse{}, res)
+}
+
+func TestQueryPointerArtifact(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "FOO", Symbol: "FOO", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	nativePointer, _, _ := k.GetERC20NativePointer(ctx, "ufoo")
+
+	res, err := q.PointerArtifact(goCtx, &types.QueryPointerArtifactRequest{PointerType: types.PointerType_NATIVE, Version: uint32(native.CurrentVersion), Address: nativePointer.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, k.GetCode(ctx, nativePointer), res.Bytecode)
+	require.Empty(t, res.WasmCodeHash)
+	require.True(t, res.Matches)
+	_, other := testkeeper.MockAddressPair()
+	res, err = q.PointerArtifact(goCtx, &types.QueryPointerArtifactRequest{PointerType: types.PointerType_NATIVE, Version: uint32(native.CurrentVersion), Address: other.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.Matches)
+
+	sender, _ := testkeeper.MockAddressPair()
+	_, erc20Addr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+	registered, err := keeper.NewMsgServerImpl(k).RegisterPointer(goCtx, &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()})
+	require.Nil(t, err)
+	res, err = q.PointerArtifact(goCtx, &types.QueryPointerArtifactRequest{PointerType: types.PointerType_ERC20, Version: uint32(erc20.CurrentVersion), Address: registered.PointerAddress})
+	require.Nil(t, err)
+	checksum := sha256.Sum256(erc20.GetBin())
+	require.Equal(t, hex.EncodeToString(checksum[:]), res.WasmCodeHash)
+	require.Empty(t, res.Bytecode)
+	require.True(t, res.Matches)
+
+	_, err = q.PointerArtifact(goCtx, &types.QueryPointerArtifactRequest{PointerType: types.PointerType_ERC20, Version: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), fmt.Sprintf("known versions: [%d]", erc20.CurrentVersion))
+}
+
+func TestQueryResolve(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, nativePointer := testkeeper.MockAddressPair()
+	cw20Addr, erc20Pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20Addr.String(), erc20Pointer))
+
+	res, err := q.Resolve(goCtx, &types.QueryResolveRequest{Input: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryResolveResponse{Exists: true, PointerType: types.PointerType_NATIVE, Counterpart: nativePointer.Hex(), Version: uint32(native.CurrentVersion)}, res)
+
+	res, err = q.Resolve(goCtx, &types.QueryResolveRequest{Input: erc20Pointer.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.True(t, res.InputIsPointer)
+	require.Equal(t, types.PointerType_CW20, res.PointerType)
+	require.Equal(t, cw20Addr.String(), res.Counterpart)
+
+	// the hint restricts which pointer types are checked
+	res, err = q.Resolve(goCtx, &types.QueryResolveRequest{Input: erc20Pointer.Hex(), PointerTypeHint: "CW20"})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	res, err = q.Resolve(goCtx, &types.QueryResolveRequest{Input: erc20Pointer.Hex(), PointerTypeHint: "CW721"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+
+	res, err = q.Resolve(goCtx, &types.QueryResolveRequest{Input: "unregistered"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	_, err = q.Resolve(goCtx, &types.QueryResolveRequest{Input: "ufoo", PointerTypeHint: "CW9000"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryIsPointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, nativePointer := testkeeper.MockAddressPair()
+	cw20Addr, erc20Pointer := testkeeper.MockAddressPair()
+	cwPointer, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20Addr.String(), erc20Pointer))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+
+	res, err := q.IsPointer(goCtx, &types.QueryIsPointerRequest{Address: nativePointer.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryIsPointerResponse{IsPointer: true, PointerType: types.PointerType_NATIVE, Pointee: "ufoo", Version: uint32(native.CurrentVersion)}, res)
+	res, err = q.IsPointer(goCtx, &types.QueryIsPointerRequest{Address: erc20Pointer.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.IsPointer)
+	require.Equal(t, types.PointerType_CW20, res.PointerType)
+	require.Equal(t, cw20Addr.String(), res.Pointee)
+	res, err = q.IsPointer(goCtx, &types.QueryIsPointerRequest{Address: cwPointer.String()})
+	require.Nil(t, err)
+	require.True(t, res.IsPointer)
+	require.Equal(t, types.PointerType_ERC20, res.PointerType)
+	require.Equal(t, erc20Addr.Hex(), res.Pointee)
+
+	// pointees are not pointers
+	res, err = q.IsPointer(goCtx, &types.QueryIsPointerRequest{Address: cw20Addr.String()})
+	require.Nil(t, err)
+	require.False(t, res.IsPointer)
+	res, err = q.IsPointer(goCtx, &types.QueryIsPointerRequest{Address: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.IsPointer)
+
+	_, err = q.IsPointer(goCtx, &types.QueryIsPointerRequest{Address: "ufoo"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryClassifyAsset(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	creator, _ := testkeeper.MockAddressPair()
+	amt := sdk.NewCoins(sdk.NewCoin("ufoo", sdk.NewInt(10)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	_, nativePointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+	code, err := os.ReadFile("../../../contracts/wasm/cw20_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, creator, code, nil)
+	require.Nil(t, err)
+	instantiateMsg, err := json.Marshal(map[string]interface{}{"name": "Bar", "symbol": "BAR", "decimals": 8, "initial_balances": []interface{}{}})
+	require.Nil(t, err)
+	cw20Addr, _, err := k.WasmKeeper().Instantiate(ctx, codeID, creator, creator, instantiateMsg, "bar", sdk.NewCoins())
+	require.Nil(t, err)
+	_, evmContract := testkeeper.MockAddressPair()
+	k.SetCode(ctx, evmContract, []byte{1})
+
+	for _, tc := range []struct {
+		input    string
+		expected types.QueryClassifyAssetResponse
+	}{
+		{"ufoo", types.QueryClassifyAssetResponse{Kind: "native", SuggestedPointerType: "NATIVE", Exists: true, AlreadyHasPointer: true, ExistingPointer: nativePointer.Hex()}},
+		{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", types.QueryClassifyAssetResponse{Kind: "ibc", SuggestedPointerType: "NATIVE"}},
+		{"factory/" + creator.String() + "/bar", types.QueryClassifyAssetResponse{Kind: "tokenfactory", SuggestedPointerType: "NATIVE"}},
+		{cw20Addr.String(), types.QueryClassifyAssetResponse{Kind: "cw20", SuggestedPointerType: "CW20", Exists: true}},
+		{evmContract.Hex(), types.QueryClassifyAssetResponse{Kind: "evm_contract", Exists: true}},
+		{nativePointer.Hex(), types.QueryClassifyAssetResponse{Kind: "pointer", Exists: true}},
+		{creator.String(), types.QueryClassifyAssetResponse{Kind: "account"}},
+	} {
+		res, err := q.ClassifyAsset(goCtx, &types.QueryClassifyAssetRequest{Input: tc.input})
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, *res, tc.input)
+	}
+
+	_, err = q.ClassifyAsset(goCtx, &types.QueryClassifyAssetRequest{Input: "!"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryPointerInfo(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	sender, _ := testkeeper.MockAddressPair()
+	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+	registered, err := keeper.NewMsgServerImpl(k).RegisterPointer(goCtx, &types.MsgRegisterPointer{
+		Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: pointee.Hex(),
+	})
+	require.Nil(t, err)
+
+	res, err := q.PointerInfo(goCtx, &types.QueryPointerInfoRequest{PointerType: types.PointerType_ERC20, Pointee: pointee.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.Equal(t, registered.PointerAddress, res.Pointer)
+	require.Equal(t, uint32(erc20.CurrentVersion), res.CurrentVersion)
+	require.Equal(t, sender.String(), res.CreationInfo.Creator)
+	require.Equal(t, ctx.BlockHeight(), res.CreationInfo.Height)
+	require.Equal(t, uint32(erc20.CurrentVersion), res.CreationInfo.InitialVersion)
+
+	// pointers registered without creation info leave it empty
+	cw20Addr, evmPointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20Addr.String(), evmPointer))
+	res, err = q.PointerInfo(goCtx, &types.QueryPointerInfoRequest{PointerType: types.PointerType_CW20, Pointee: cw20Addr.String()})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.Equal(t, types.PointerCreationInfo{}, res.CreationInfo)
+
+	res, err = q.PointerInfo(goCtx, &types.QueryPointerInfoRequest{PointerType: types.PointerType_NATIVE, Pointee: "unone"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+}
+
+func TestQueryAllowance(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	ownerSeiAddr, ownerEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, ownerSeiAddr, ownerEVMAddr)
+	spenderSeiAddr, spenderEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, spenderSeiAddr, spenderEVMAddr)
+
+	// native allowances are read from the ERC20 pointer
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "uallow", utils.ERCMetadata{Name: "ALLOW", Symbol: "ALLOW", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	nativePointer, _, _ := k.GetERC20NativePointer(ctx, "uallow")
+	approve, err := artifacts.GetParsedABI("native").Pack("approve", spenderEVMAddr, big.NewInt(100))
+	require.Nil(t, err)
+	_, err = k.CallEVM(ctx, ownerEVMAddr, &nativePointer, nil, approve)
+	require.Nil(t, err)
+	res, err := q.Allowance(goCtx, &types.QueryAllowanceRequest{PointerType: types.PointerType_NATIVE, Pointee: "uallow", Owner: ownerSeiAddr.String(), Spender: spenderEVMAddr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryAllowanceResponse{Allowance: "100", Exists: true}, res)
+
+	// ERC20 allowances are read from the pointee
+	_, erc20Addr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Addr, k.GetCode(ctx, nativePointer))
+	approve, err = artifacts.GetParsedABI("native").Pack("approve", spenderEVMAddr, big.NewInt(7))
+	require.Nil(t, err)
+	_, err = k.CallEVM(ctx, ownerEVMAddr, &erc20Addr, nil, approve)
+	require.Nil(t, err)
+	cwPointer, _ := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+	res, err = q.Allowance(goCtx, &types.QueryAllowanceRequest{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex(), Owner: ownerEVMAddr.Hex(), Spender: spenderSeiAddr.String()})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryAllowanceResponse{Allowance: "7", Exists: true}, res)
+
+	// CW20 allowances are read with a wasm query on the pointee
+	code, err := os.ReadFile("../../../contracts/wasm/cw20_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, ownerSeiAddr, code, nil)
+	require.Nil(t, err)
+	instantiateMsg, err := json.Marshal(map[string]interface{}{
+		"name": "Bar", "symbol": "BAR", "decimals": 8,
+		"initial_balances": []map[string]interface{}{{"address": ownerSeiAddr.String(), "amount": "1000"}},
+	})
+	require.Nil(t, err)
+	cw20Addr, _, err := k.WasmKeeper().Instantiate(ctx, codeID, ownerSeiAddr, ownerSeiAddr, instantiateMsg, "bar", sdk.NewCoins())
+	require.Nil(t, err)
+	increaseAllowance, err := json.Marshal(map[string]interface{}{"increase_allowance": map[string]string{"spender": spenderSeiAddr.String(), "amount": "42"}})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, cw20Addr, ownerSeiAddr, increaseAllowance, sdk.NewCoins())
+	require.Nil(t, err)
+	_, erc20Pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, cw20Addr.String(), erc20Pointer))
+	res, err = q.Allowance(goCtx, &types.QueryAllowanceRequest{PointerType: types.PointerType_CW20, Pointee: cw20Addr.String(), Owner: ownerEVMAddr.Hex(), Spender: spenderEVMAddr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryAllowanceResponse{Allowance: "42", Exists: true}, res)
+
+	res, err = q.Allowance(goCtx, &types.QueryAllowanceRequest{PointerType: types.PointerType_NATIVE, Pointee: "unone", Owner: ownerEVMAddr.Hex(), Spender: spenderEVMAddr.Hex()})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+	_, err = q.Allowance(goCtx, &types.QueryAllowanceRequest{PointerType: types.PointerType_NATIVE, Pointee: "uallow", Owner: "nobody", Spender: spenderEVMAddr.Hex()})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Allowance(goCtx, &types.QueryAllowanceRequest{PointerType: types.PointerType_CW721, Pointee: cw20Addr.String()})
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestQueryNFTInfo(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	ownerSeiAddr, ownerEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, ownerSeiAddr, ownerEVMAddr)
+	spenderSeiAddr, spenderEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, spenderSeiAddr, spenderEVMAddr)
+
+	code, err := os.ReadFile("../../../contracts/wasm/cw721_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, ownerSeiAddr, code, nil)
+	require.Nil(t, err)
+	instantiateMsg, err := json.Marshal(map[string]string{"name": "Nft", "symbol": "NFT", "minter": ownerSeiAddr.String()})
+	require.Nil(t, err)
+	cw721Addr, _, err := k.WasmKeeper().Instantiate(ctx, codeID, ownerSeiAddr, ownerSeiAddr, instantiateMsg, "nft", sdk.NewCoins())
+	require.Nil(t, err)
+	longURI := "data:application/json;base64," + strings.Repeat("A", keeper.MaxTokenURILength)
+	for id, uri := range map[string]string{"1": "ipfs://one", "2": longURI} {
+		mint, err := json.Marshal(map[string]interface{}{"mint": map[string]string{"token_id": id, "owner": ownerSeiAddr.String(), "token_uri": uri}})
+		require.Nil(t, err)
+		_, err = k.WasmKeeper().Execute(ctx, cw721Addr, ownerSeiAddr, mint, sdk.NewCoins())
+		require.Nil(t, err)
+	}
+	approve, err := json.Marshal(map[string]interface{}{"approve": map[string]string{"spender": spenderSeiAddr.String(), "token_id": "1"}})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, cw721Addr, ownerSeiAddr, approve, sdk.NewCoins())
+	require.Nil(t, err)
+
+	// no pointer registered yet
+	res, err := q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "1"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCCW721Pointer(ctx, e, cw721Addr.String(), utils.ERCMetadata{Name: "Nft", Symbol: "NFT"})
+		return err
+	}, func(string, string) {}))
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "1"})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryNFTInfoResponse{
+		Owner:           ownerEVMAddr.Hex(),
+		OwnerSeiAddress: ownerSeiAddr.String(),
+		TokenUri:        "ipfs://one",
+		Approved:        spenderEVMAddr.Hex(),
+		Exists:          true,
+	}, res)
+
+	// oversized token URIs are truncated
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "2"})
+	require.Nil(t, err)
+	require.True(t, res.TokenUriTruncated)
+	require.Equal(t, longURI[:keeper.MaxTokenURILength], res.TokenUri)
+	require.Empty(t, res.Approved)
+
+	// nonexistent tokens are not an error
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "3"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+
+	// full token URIs are returned intact, and cut ones stay valid UTF-8
+	hugeURI := "data:application/json;base64," + strings.Repeat("A", 100*1024)
+	multiByteURI := "x" + strings.Repeat("é", keeper.MaxTokenURILength)
+	for id, uri := range map[string]string{"4": hugeURI, "5": multiByteURI} {
+		mint, err := json.Marshal(map[string]interface{}{"mint": map[string]string{"token_id": id, "owner": ownerSeiAddr.String(), "token_uri": uri}})
+		require.Nil(t, err)
+		_, err = k.WasmKeeper().Execute(ctx, cw721Addr, ownerSeiAddr, mint, sdk.NewCoins())
+		require.Nil(t, err)
+	}
+	// reading 100KB of metadata takes more than the default query gas limit
+	meteredCtx := sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10000000)))
+	res, err = q.NFTInfo(meteredCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "4", FullTokenUri: true})
+	require.Nil(t, err)
+	require.False(t, res.TokenUriTruncated)
+	require.Equal(t, hugeURI, res.TokenUri)
+	meteredCtx = sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10000000)))
+	res, err = q.NFTInfo(meteredCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "4"})
+	require.Nil(t, err)
+	require.True(t, res.TokenUriTruncated)
+	require.Equal(t, hugeURI[:keeper.MaxTokenURILength], res.TokenUri)
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "5"})
+	require.Nil(t, err)
+	require.True(t, res.TokenUriTruncated)
+	require.True(t, utf8.ValidString(res.TokenUri))
+	require.Equal(t, multiByteURI[:keeper.MaxTokenURILength-1], res.TokenUri)
+
+	// tokens without a token URI
+	mint, err := json.Marshal(map[string]interface{}{"mint": map[string]string{"token_id": "6", "owner": ownerSeiAddr.String()}})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, cw721Addr, ownerSeiAddr, mint, sdk.NewCoins())
+	require.Nil(t, err)
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "6"})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.Empty(t, res.TokenUri)
+	require.False(t, res.TokenUriTruncated)
+
+	_, erc721Addr := testkeeper.MockAddressPair()
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_ERC721, Pointee: erc721Addr.Hex(), TokenId: "1"})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+
+	// invalid UTF-8 in the token URI of an ERC721 is replaced. The contract
+	// returns the ABI encoding of the string "\xff\xfe" to every call, which
+	// also decodes as the address 0x20.
+	// CODECOPY(0, 12, 96) RETURN(0, 96)
+	returnURI := append(common.FromHex("0x6060600c60003960606000f3"), common.LeftPadBytes([]byte{0x20}, 32)...)
+	returnURI = append(returnURI, common.LeftPadBytes([]byte{2}, 32)...)
+	returnURI = append(returnURI, common.RightPadBytes([]byte{0xff, 0xfe}, 32)...)
+	k.SetCode(ctx, erc721Addr, returnURI)
+	cwPointer, _ := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW721ERC721Pointer(ctx, erc721Addr, cwPointer.String()))
+	res, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_ERC721, Pointee: erc721Addr.Hex(), TokenId: "1"})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.Equal(t, string(utf8.RuneError), res.TokenUri)
+	_, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW721, Pointee: cw721Addr.String(), TokenId: "-1"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.NFTInfo(goCtx, &types.QueryNFTInfoRequest{PointerType: types.PointerType_CW20, Pointee: cw721Addr.String(), TokenId: "1"})
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestQueryBalance1155Batch(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
+	ctx, _ = ctx.WithBlockTime(time.Now()).CacheContext()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	ownerSeiAddr, ownerEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, ownerSeiAddr, ownerEVMAddr)
+	otherSeiAddr, _ := testkeeper.MockAddressPair()
+
+	// CW1155 balances are read with a wasm query on the pointee
+	code, err := os.ReadFile("../../../contracts/wasm/cw1155_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, ownerSeiAddr, code, nil)
+	require.Nil(t, err)
+	instantiateMsg, err := json.Marshal(map[string]string{"name": "Game", "symbol": "GAME", "minter": ownerSeiAddr.String()})
+	require.Nil(t, err)
+	cw1155Addr, _, err := k.WasmKeeper().Instantiate(ctx, codeID, ownerSeiAddr, ownerSeiAddr, instantiateMsg, "game", sdk.NewCoins())
+	require.Nil(t, err)
+	mint, err := json.Marshal(map[string]interface{}{"mint_batch": map[string]interface{}{
+		"recipient": ownerSeiAddr.String(),
+		"msgs":      []map[string]string{{"token_id": "1", "amount": "30"}, {"token_id": "2", "amount": "40"}},
+	}})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, cw1155Addr, ownerSeiAddr, mint, sdk.NewCoins())
+	require.Nil(t, err)
+
+	res, err := q.Balance1155Batch(goCtx, &types.QueryBalance1155BatchRequest{Address: cw1155Addr.String(), Owners: []string{ownerSeiAddr.String()}, TokenIds: []string{"1"}})
+	require.Nil(t, err)
+	require.False(t, res.Exists)
+
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCCW1155Pointer(ctx, e, cw1155Addr.String(), utils.ERCMetadata{Name: "Game", Symbol: "GAME"})
+		return err
+	}, func(string, string) {}))
+	erc1155Pointer, _, _ := k.GetERC1155CW1155Pointer(ctx, cw1155Addr.String())
+	req := &types.QueryBalance1155BatchRequest{
+		Owners:   []string{ownerEVMAddr.Hex(), ownerSeiAddr.String(), otherSeiAddr.String(), ownerSeiAddr.String()},
+		TokenIds: []string{"2", "1", "1", "3"},
+	}
+	for _, addr := range []string{cw1155Addr.String(), erc1155Pointer.Hex()} {
+		req.Address = addr
+		res, err = q.Balance1155Batch(goCtx, req)
+		require.Nil(t, err)
+		require.Equal(t, &types.QueryBalance1155BatchResponse{Balances: []string{"40", "30", "0", "0"}, Exists: true}, res)
+	}
+
+	// ERC1155 balances are read with a static call to the pointee
+	bin, err := os.ReadFile("../../../example/contracts/erc1155/ERC1155Example.bin")
+	require.Nil(t, err)
+	erc1155Addr := crypto.CreateAddress(ownerEVMAddr, k.GetNonce(ctx, ownerEVMAddr))
+	_, err = k.CallEVM(ctx, ownerEVMAddr, nil, nil, common.FromHex(string(bin)))
+	require.Nil(t, err)
+	cw1155Pointer, _ := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW1155ERC1155Pointer(ctx, erc1155Addr, cw1155Pointer.String()))
+	// minted to this address by the example contract's constructor
+	holder := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	req = &types.QueryBalance1155BatchRequest{
+		Owners:   []string{holder, holder, ownerSeiAddr.String()},
+		TokenIds: []string{"5", "0", "0"},
+	}
+	for _, addr := range []string{erc1155Addr.Hex(), cw1155Pointer.String()} {
+		req.Address = addr
+		res, err = q.Balance1155Batch(goCtx, req)
+		require.Nil(t, err)
+		require.Equal(t, &types.QueryBalance1155BatchResponse{Balances: []string{"15", "10", "0"}, Exists: true}, res)
+	}
+
+	_, err = q.Balance1155Batch(goCtx, &types.QueryBalance1155BatchRequest{Address: erc1155Addr.Hex(), Owners: []string{holder}, TokenIds: []string{"0", "1"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Balance1155Batch(goCtx, &types.QueryBalance1155BatchRequest{Address: erc1155Addr.Hex(), Owners: []string{holder}, TokenIds: []string{"one"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = q.Balance1155Batch(goCtx, &types.QueryBalance1155BatchRequest{Address: "nothing"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryGasPrice(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithBlockHeight(8)
+	q := keeper.Querier{k}
+	minFee := k.GetMinimumFeePerGas(ctx).TruncateInt().String()
+
+	// no base fee has been adjusted right after genesis
+	res, err := q.GasPrice(sdk.WrapSDKContext(ctx), &types.QueryGasPriceRequest{})
+	require.Nil(t, err)
+	require.Equal(t, &types.QueryGasPriceResponse{
+		BaseFee:      minFee,
+		NextBaseFee:  minFee,
+		MinimumFee:   minFee,
+		SuggestedTip: "1000000000",
+		Height:       8,
+	}, res)
+
+	// a rising base fee is added to the tip
+	k.SetCurrBaseFeePerGas(ctx, sdk.NewDec(2_000_000_000))
+	k.SetNextBaseFeePerGas(ctx, sdk.NewDec(2_500_000_000))
+	res, err = q.GasPrice(sdk.WrapSDKContext(ctx), &types.QueryGasPriceRequest{})
+	require.Nil(t, err)
+	require.Equal(t, "2000000000", res.BaseFee)
+	require.Equal(t, "2500000000", res.NextBaseFee)
+	require.Equal(t, "1500000000", res.SuggestedTip)
+
+	k.SetNextBaseFeePerGas(ctx, sdk.NewDec(1_500_000_000))
+	res, err = q.GasPrice(sdk.WrapSDKContext(ctx), &types.QueryGasPriceRequest{})
+	require.Nil(t, err)
+	require.Equal(t, "1000000000", res.SuggestedTip)
+}
+
+func TestQueryEVMAddressByPubkey(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	privKey := testkeeper.MockPrivateKey()
+	seiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	pubkey := privKey.PubKey().Bytes()
+
+	expected := types.QueryEVMAddressByPubkeyResponse{EvmAddress: evmAddr.Hex(), SeiAddress: seiAddr.String()}
+	for _, encoded := range []string{hex.EncodeToString(pubkey), hexutil.Encode(pubkey), base64.StdEncoding.EncodeToString(pubkey)} {
+		res, err := q.EVMAddressByPubkey(goCtx, &types.QueryEVMAddressByPubkeyRequest{Pubkey: encoded})
+		require.Nil(t, err)
+		require.Equal(t, expected, *res)
+	}
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	res, err := q.EVMAddressByPubkey(goCtx, &types.QueryEVMAddressByPubkeyRequest{Pubkey: hex.EncodeToString(pubkey)})
+	require.Nil(t, err)
+	require.True(t, res.Associated)
+
+	for _, tc := range []struct {
+		pubkey string
+		err    string
+	}{
+		{"", "must specify a public key"},
+		{"not a key!", "neither hex nor base64"},
+		{hex.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()), "ed25519"},
+		{hex.EncodeToString(pubkey[1:]), "ed25519"},
+		{hex.EncodeToString(pubkey[:20]), "expected a 33-byte compressed secp256k1 key"},
+		{hex.EncodeToString(append([]byte{0x05}, pubkey[1:]...)), "invalid secp256k1 public key"},
+	} {
+		_, err := q.EVMAddressByPubkey(goCtx, &types.QueryEVMAddressByPubkeyRequest{Pubkey: tc.pubkey})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+		require.Contains(t, err.Error(), tc.err)
+	}
+}
+
+func TestQueryAssociationPreflight(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	otherSeiAddr, otherEVMAddr := testkeeper.MockAddressPair()
+	preflight := func(seiAddr sdk.AccAddress, evmAddr common.Address) types.QueryAssociationPreflightResponse {
+		res, err := q.AssociationPreflight(goCtx, &types.QueryAssociationPreflightRequest{SeiAddress: seiAddr.String(), EvmAddress: evmAddr.Hex()})
+		require.Nil(t, err)
+		return *res
+	}
+
+	require.Equal(t, types.QueryAssociationPreflightResponse{Status: keeper.AssociationPreflightOK}, preflight(seiAddr, evmAddr))
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	require.Equal(t, types.QueryAssociationPreflightResponse{Status: keeper.AssociationPreflightAlreadyAssociated}, preflight(seiAddr, evmAddr))
+	require.Equal(t, types.QueryAssociationPreflightResponse{Status: keeper.AssociationPreflightSeiTaken, ConflictingEvmAddress: evmAddr.Hex()}, preflight(seiAddr, otherEVMAddr))
+	require.Equal(t, types.QueryAssociationPreflightResponse{Status: keeper.AssociationPreflightEVMTaken, ConflictingSeiAddress: seiAddr.String()}, preflight(otherSeiAddr, evmAddr))
+	require.Equal(t, types.QueryAssociationPreflightResponse{Status: keeper.AssociationPreflightOK}, preflight(otherSeiAddr, otherEVMAddr))
+
+	_, err := q.AssociationPreflight(goCtx, &types.QueryAssociationPreflightRequest{SeiAddress: "sei1invalid", EvmAddress: evmAddr.Hex()})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = q.AssociationPreflight(goCtx, &types.QueryAssociationPreflightRequest{SeiAddress: seiAddr.String(), EvmAddress: "0x1234"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestQueryNodeQueryConfig(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	k.QueryConfig.GasLimit = 12345
+	k.QueryConfig.DisableTracing = true
+	res, err := q.NodeQueryConfig(sdk.WrapSDKContext(ctx), &types.QueryNodeQueryConfigRequest{})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryNodeQueryConfigResponse{
+		GasLimit:                            12345,
+		MaxPageLimit:                        k.QueryConfig.MaxPageLimit,
+		TracingDisabled:                     true,
+		MaxTraceSize:                        k.QueryConfig.MaxTraceSize,
+		MaxTraceStructLogs:                  k.QueryConfig.MaxTraceStructLogs,
+		MaxLogsBlockRange:                   k.QueryConfig.MaxLogsBlockRange,
+		MaxContractTxParticipantsBlockRange: k.QueryConfig.MaxContractTxParticipantsBlockRange,
+		MaxAddressBatchSize:                 keeper.MaxAddressBatchSize,
+		MaxStaticCallBatchSize:              keeper.MaxStaticCallBatchSize,
+		MaxPointerBatchSize:                 keeper.MaxPointerBatchSize,
+		MaxBalance1155BatchSize:             keeper.MaxBalance1155BatchSize,
+	}, *res)
+}
+
+func TestQueryModuleAddress(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	res, err := q.ModuleAddress(sdk.WrapSDKContext(ctx), &types.QueryModuleAddressRequest{})
+	require.Nil(t, err)
+	moduleAddr := k.AccountKeeper().GetModuleAddress(types.ModuleName)
+	require.Equal(t, moduleAddr.String(), res.SeiAddress)
+	require.Equal(t, k.GetEVMAddressOrDefault(ctx, moduleAddr).Hex(), res.EvmAddress)
+	require.Equal(t, k.AccountKeeper().GetModuleAddress(authtypes.FeeCollectorName).String(), res.FeeCollectorSeiAddress)
+	require.Equal(t, keeper.GetCoinbaseAddress().Hex(), res.FeeCollectorEvmAddress)
+}
+
+func TestQueryPredictPointerAddress(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, err := q.PredictPointerAddress(goCtx, &types.QueryPredictPointerAddressRequest{PointerType: types.PointerType_CW20, Pointee: "0xnotbech32"})
+	require.NotNil(t, err)
+
+	// an ERC pointer is deployed at the predicted address
+	predicted, err := q.PredictPointerAddress(goCtx, &types.QueryPredictPointerAddressRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.False(t, predicted.Registered)
+	require.False(t, predicted.InstanceDependent)
+	deployer := k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+	initCodeHash := keeper.PointerInitCodeHash("native")
+	require.Equal(t, crypto.Keccak256Hash(native.GetBin()), initCodeHash)
+	// the CREATE2 address of the native artifact's creation code
+	create2 := crypto.Keccak256([]byte{0xff}, deployer.Bytes(), keeper.PointerSalt(types.PointerType_NATIVE, "ufoo", 0).Bytes(), initCodeHash.Bytes())[12:]
+	require.Equal(t, common.BytesToAddress(create2).Hex(), predicted.PointerAddress)
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "FOO", Symbol: "FOO", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	pointer, _, _ := k.GetERC20NativePointer(ctx, "ufoo")
+	require.Equal(t, predicted.PointerAddress, pointer.Hex())
+	res, err := q.PredictPointerAddress(goCtx, &types.QueryPredictPointerAddressRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPredictPointerAddressResponse{PointerAddress: pointer.Hex(), Registered: true}, *res)
+
+	// a pointer replacing it skips the taken address
+	_, newPointer, _, _, err := k.OverridePointerInRegistry(ctx, "", types.PointerType_NATIVE, "ufoo", "")
+	require.Nil(t, err)
+	require.Equal(t, keeper.ERCPointerAddress(deployer, keeper.PointerSalt(types.PointerType_NATIVE, "ufoo", 1), initCodeHash).Hex(), newPointer)
+
+	// a pointee whose derived addresses are all taken gets none
+	for attempt := uint64(0); attempt < keeper.MaxPointerAddressAttempts; attempt++ {
+		k.SetNonce(ctx, keeper.ERCPointerAddress(deployer, keeper.PointerSalt(types.PointerType_NATIVE, "ubar", attempt), initCodeHash), 1)
+	}
+	_, err = q.PredictPointerAddress(goCtx, &types.QueryPredictPointerAddressRequest{PointerType: types.PointerType_NATIVE, Pointee: "ubar"})
+	require.ErrorContains(t, err, "no free pointer address")
+	require.NotNil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "ubar", utils.ERCMetadata{Name: "BAR", Symbol: "BAR", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	_, _, exists := k.GetERC20NativePointer(ctx, "ubar")
+	require.False(t, exists)
+
+	// a CW pointer gets the address of the next contract instance
+	_, erc20Addr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+	predicted, err = q.PredictPointerAddress(goCtx, &types.QueryPredictPointerAddressRequest{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.True(t, predicted.InstanceDependent)
+	sender, _ := testkeeper.MockAddressPair()
+	registered, err := keeper.NewMsgServerImpl(k).RegisterPointer(goCtx, &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, predicted.PointerAddress, registered.PointerAddress)
+}
+
+func TestQueryVerifyPointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, err := q.VerifyPointer(goCtx, &types.QueryVerifyPointerRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.NotNil(t, err)
+
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "FOO", Symbol: "FOO", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	pointer, _, _ := k.GetERC20NativePointer(ctx, "ufoo")
+	res, err := q.VerifyPointer(goCtx, &types.QueryVerifyPointerRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.True(t, res.Matches)
+	require.Equal(t, pointer.Hex(), res.Pointer)
+	require.Equal(t, uint32(native.CurrentVersion), res.ClaimedVersion)
+	require.Equal(t, k.GetCodeHash(ctx, pointer).Hex(), res.ActualHash)
+
+	// tampered code is reported as a mismatch, not an error
+	k.SetCode(ctx, pointer, []byte{1, 2, 3})
+	res, err = q.VerifyPointer(goCtx, &types.QueryVerifyPointerRequest{PointerType: types.PointerType_NATIVE, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.False(t, res.Matches)
+	require.NotEqual(t, res.ExpectedHash, res.ActualHash)
+
+	_, erc20Addr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Addr, testkeeper.MockPointeeCode)
+	sender, _ := testkeeper.MockAddressPair()
+	registered, err := keeper.NewMsgServerImpl(k).RegisterPointer(goCtx, &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex()})
+	require.Nil(t, err)
+	res, err = q.VerifyPointer(goCtx, &types.QueryVerifyPointerRequest{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex()})
+	require.Nil(t, err)
+	require.True(t, res.Matches)
+	require.Equal(t, registered.PointerAddress, res.Pointer)
+}
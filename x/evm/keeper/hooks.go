@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// SetHooks sets the hooks run on new address associations.
+func (k *Keeper) SetHooks(h types.AssociationHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set association hooks twice")
+	}
+	k.hooks = h
+	return k
+}
+
+// SetPointerHooks sets the hooks run on pointer registry changes.
+func (k *Keeper) SetPointerHooks(h types.PointerHooks) *Keeper {
+	if k.pointerHooks != nil {
+		panic("cannot set pointer hooks twice")
+	}
+	k.pointerHooks = h
+	return k
+}
+
+func (k *Keeper) afterAddressAssociated(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) {
+	if k.hooks == nil {
+		return
+	}
+	types.RunHook(ctx, "association", func(ctx sdk.Context) error {
+		return k.hooks.AfterAddressAssociated(ctx, seiAddress, evmAddress)
+	})
+}
+
+func (k *Keeper) afterPointerRegistered(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer string, version uint16) {
+	if k.pointerHooks == nil {
+		return
+	}
+	types.RunHook(ctx, "pointer registration", func(ctx sdk.Context) error {
+		return k.pointerHooks.AfterPointerRegistered(ctx, pointerType, pointee, pointer, version)
+	})
+}
+
+func (k *Keeper) afterPointerUpgraded(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer string, version uint16) {
+	if k.pointerHooks == nil {
+		return
+	}
+	types.RunHook(ctx, "pointer upgrade", func(ctx sdk.Context) error {
+		return k.pointerHooks.AfterPointerUpgraded(ctx, pointerType, pointee, pointer, version)
+	})
+}
+
+func (k *Keeper) afterPointerRemoved(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer string, version uint16) {
+	if k.pointerHooks == nil {
+		return
+	}
+	types.RunHook(ctx, "pointer removal", func(ctx sdk.Context) error {
+		return k.pointerHooks.AfterPointerRemoved(ctx, pointerType, pointee, pointer, version)
+	})
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// DefaultIBCDenomPointersToCreate bounds the number of queued IBC denom
+// pointers deployed per block.
+const DefaultIBCDenomPointersToCreate = 10
+
+// maxDerivedSymbolLength bounds the length of symbols derived from a denom
+// trace.
+const maxDerivedSymbolLength = 12
+
+// QueueIBCDenomPointer queues the ibc/ denom of the denom trace fullDenomPath
+// for an ERC20 native pointer to be deployed at the end of the block, if
+// automatic IBC denom pointers are enabled and the denom has no pointer yet.
+func (k *Keeper) QueueIBCDenomPointer(ctx sdk.Context, fullDenomPath string) {
+	if !k.GetAutoCreateIBCDenomPointers(ctx) {
+		return
+	}
+	denom := ibctransfertypes.ParseDenomTrace(fullDenomPath).IBCDenom()
+	if !strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return
+	}
+	if _, _, exists := k.GetERC20NativePointer(ctx, denom); exists {
+		return
+	}
+	if pointerKey, _ := PointerRegistryKey(types.PointerType_NATIVE, denom); k.IsPointeeTombstoned(ctx, pointerKey) {
+		return
+	}
+	ctx.KVStore(k.GetStoreKey()).Set(types.IBCDenomPointerQueueKey(denom), []byte(fullDenomPath))
+}
+
+// CreateQueuedIBCDenomPointers deploys the pointers of up to n queued IBC
+// denoms. A denom whose deployment fails is dropped from the queue rather than
+// retried every block; its pointer can still be registered through
+// governance.
+func (k *Keeper) CreateQueuedIBCDenomPointers(ctx sdk.Context, n int) {
+	store := ctx.KVStore(k.GetStoreKey())
+	iter := sdk.KVStorePrefixIterator(store, types.IBCDenomPointerQueuePrefix)
+	queued := map[string]string{}
+	denoms := []string{}
+	for ; n > 0 && iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.IBCDenomPointerQueuePrefix):])
+		denoms = append(denoms, denom)
+		queued[denom] = string(iter.Value())
+		n--
+	}
+	iter.Close()
+	for _, denom := range denoms {
+		store.Delete(types.IBCDenomPointerQueueKey(denom))
+		if _, _, exists := k.GetERC20NativePointer(ctx, denom); exists {
+			continue
+		}
+		if err := k.createIBCDenomPointer(ctx, denom, queued[denom]); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to create pointer for IBC denom %s: %s", denom, err))
+		}
+	}
+}
+
+func (k *Keeper) createIBCDenomPointer(ctx sdk.Context, denom string, fullDenomPath string) error {
+	cacheCtx, write := ctx.CacheContext()
+	metadata := k.ibcDenomPointerMetadata(cacheCtx, denom, fullDenomPath)
+	if err := k.RunWithOneOffEVMInstance(cacheCtx, func(e *vm.EVM) error {
+		_, err := k.UpsertERCNativePointer(cacheCtx, e, denom, metadata)
+		return err
+	}, func(string, string) {}); err != nil {
+		return err
+	}
+	pointerKey, _ := PointerRegistryKey(types.PointerType_NATIVE, denom)
+	if err := k.markPointerAutoCreated(cacheCtx, pointerKey); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// ibcDenomPointerMetadata returns the pointer metadata of an IBC denom, taken
+// from its bank metadata if set, and otherwise derived from its denom trace
+// with the base denom's raw units.
+func (k *Keeper) ibcDenomPointerMetadata(ctx sdk.Context, denom string, fullDenomPath string) utils.ERCMetadata {
+	if metadata, ok := k.BankKeeper().GetDenomMetaData(ctx, denom); ok && metadata.Name != "" && metadata.Symbol != "" {
+		return denomPointerMetadata(metadata)
+	}
+	return utils.ERCMetadata{
+		Name:   fullDenomPath,
+		Symbol: DeriveIBCDenomSymbol(ibctransfertypes.ParseDenomTrace(fullDenomPath).BaseDenom),
+	}
+}
+
+// DeriveIBCDenomSymbol derives a symbol from an IBC base denom by keeping
+// its alphanumeric characters, uppercased and truncated.
+func DeriveIBCDenomSymbol(baseDenom string) string {
+	symbol := strings.Builder{}
+	for _, r := range baseDenom {
+		if symbol.Len() == maxDerivedSymbolLength {
+			break
+		}
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			symbol.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	if symbol.Len() == 0 {
+		return "IBC"
+	}
+	return symbol.String()
+}
+
+// markPointerAutoCreated flags the creation info of the pointer registered
+// under pointerKey as deployed by the chain itself.
+func (k *Keeper) markPointerAutoCreated(ctx sdk.Context, pointerKey []byte) error {
+	info, ok := k.GetPointerCreationInfo(ctx, pointerKey)
+	if !ok {
+		return fmt.Errorf("no creation info for pointer")
+	}
+	info.AutoCreated = true
+	bz, err := info.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.GetStoreKey()).Set(types.PointerCreationInfoKey(pointerKey), bz)
+	return nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveIBCDenomSymbol(t *testing.T) {
+	require.Equal(t, "UATOM", keeper.DeriveIBCDenomSymbol("uatom"))
+	require.Equal(t, "GAMMPOOL1", keeper.DeriveIBCDenomSymbol("gamm/pool/1"))
+	require.Equal(t, "FACTORYSEI1A", keeper.DeriveIBCDenomSymbol("factory/sei1abcdef/token"))
+	require.Equal(t, "IBC", keeper.DeriveIBCDenomSymbol("-/-"))
+}
+
+func TestCreateQueuedIBCDenomPointers(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).CacheContext()
+	params := k.GetParams(ctx)
+	params.AutoCreateIbcDenomPointers = true
+	k.SetParams(ctx, params)
+	withMetadata := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uusdc").IBCDenom()
+	withoutMetadata := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       withMetadata,
+		Name:       "USD Coin",
+		Symbol:     "USDC",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: withMetadata}, {Denom: "usdc", Exponent: 6}},
+	})
+	k.QueueIBCDenomPointer(ctx, "transfer/channel-0/uusdc")
+	k.QueueIBCDenomPointer(ctx, "transfer/channel-0/uatom")
+
+	// bounded per block
+	k.CreateQueuedIBCDenomPointers(ctx, 1)
+	_, _, usdcExists := k.GetERC20NativePointer(ctx, withMetadata)
+	_, _, atomExists := k.GetERC20NativePointer(ctx, withoutMetadata)
+	require.True(t, usdcExists != atomExists)
+	k.CreateQueuedIBCDenomPointers(ctx, 1)
+
+	for _, tc := range []struct {
+		denom    string
+		name     string
+		symbol   string
+		decimals uint8
+	}{
+		{withMetadata, "USD Coin", "USDC", 6},
+		{withoutMetadata, "transfer/channel-0/uatom", "UATOM", 0},
+	} {
+		pointer, _, exists := k.GetERC20NativePointer(ctx, tc.denom)
+		require.True(t, exists)
+		for query, expected := range map[string]interface{}{"name": tc.name, "symbol": tc.symbol, "decimals": tc.decimals} {
+			out, err := k.QueryERCSingleOutput(ctx, "native", pointer, query)
+			require.Nil(t, err)
+			require.Equal(t, expected, out)
+		}
+		pointerKey, _ := keeper.PointerRegistryKey(types.PointerType_NATIVE, tc.denom)
+		info, ok := k.GetPointerCreationInfo(ctx, pointerKey)
+		require.True(t, ok)
+		require.True(t, info.AutoCreated)
+		require.Equal(t, uint32(native.CurrentVersion), info.InitialVersion)
+	}
+}
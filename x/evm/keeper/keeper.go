@@ -10,12 +10,15 @@ import (
 	"slices"
 	"sort"
 	"sync"
+	"sync/atomic"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
@@ -37,6 +40,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/blocktest"
 	"github.com/sei-protocol/sei-chain/x/evm/querier"
 	"github.com/sei-protocol/sei-chain/x/evm/replay"
+	"github.com/sei-protocol/sei-chain/x/evm/retention"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
@@ -53,6 +57,7 @@ type Keeper struct {
 	bankKeeper     bankkeeper.Keeper
 	accountKeeper  *authkeeper.AccountKeeper
 	stakingKeeper  *stakingkeeper.Keeper
+	distrKeeper    *distrkeeper.Keeper
 	transferKeeper ibctransferkeeper.Keeper
 	wasmKeeper     *wasmkeeper.PermissionedKeeper
 	wasmViewKeeper *wasmkeeper.Keeper
@@ -62,8 +67,17 @@ type Keeper struct {
 	nonceMx                      *sync.RWMutex
 	pendingTxs                   map[string][]*PendingTx
 	keyToNonce                   map[tmtypes.TxKey]*AddressNoncePair
+	// set once CheckTx has reported a pending nonce, i.e. once the node has
+	// visibility into its mempool
+	pendingNoncesTracked *atomic.Bool
 
 	QueryConfig *querier.Config
+	// committed multistore that historical queries branch from; historical
+	// queries are unavailable if unset
+	HistoricalMultiStore sdk.CommitMultiStore
+	// params keeper the chain's minimum gas prices are read from; fee
+	// estimates of Cosmos messages are unavailable if unset
+	ParamsKeeper *paramskeeper.Keeper
 
 	// only used during ETH replay. Not used in chain critical path.
 	EthClient       *ethclient.Client
@@ -81,7 +95,24 @@ type Keeper struct {
 
 	receiptStore seidbtypes.StateStore
 
+	cachedReceiptIndexStartHeight    *int64
+	cachedReceiptIndexStartHeightMtx *sync.RWMutex
+
+	// receipts of blocks outside of the retention window are pruned as
+	// receipts are flushed; all receipts are kept if unset
+	ReceiptRetentionConfig      retention.Config
+	cachedReceiptPruneCursor    []byte
+	cachedReceiptPruneCursorMtx *sync.RWMutex
+
 	customPrecompiles map[common.Address]vm.PrecompiledContract
+
+	hooks        types.AssociationHooks
+	pointerHooks types.PointerHooks
+
+	addressCache *addressCache
+	pointerCache *pointerCache
+
+	metricsDisabled bool
 }
 
 type AddressNoncePair struct {
@@ -115,25 +146,31 @@ func (ctx *ReplayChainContext) GetHeader(hash common.Hash, number uint64) *ethty
 func NewKeeper(
 	storeKey sdk.StoreKey, transientStoreKey sdk.StoreKey, paramstore paramtypes.Subspace, receiptStateStore seidbtypes.StateStore,
 	bankKeeper bankkeeper.Keeper, accountKeeper *authkeeper.AccountKeeper, stakingKeeper *stakingkeeper.Keeper,
-	transferKeeper ibctransferkeeper.Keeper, wasmKeeper *wasmkeeper.PermissionedKeeper, wasmViewKeeper *wasmkeeper.Keeper) *Keeper {
+	distrKeeper *distrkeeper.Keeper, transferKeeper ibctransferkeeper.Keeper, wasmKeeper *wasmkeeper.PermissionedKeeper, wasmViewKeeper *wasmkeeper.Keeper) *Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 	k := &Keeper{
-		storeKey:                     storeKey,
-		transientStoreKey:            transientStoreKey,
-		Paramstore:                   paramstore,
-		bankKeeper:                   bankKeeper,
-		accountKeeper:                accountKeeper,
-		stakingKeeper:                stakingKeeper,
-		transferKeeper:               transferKeeper,
-		wasmKeeper:                   wasmKeeper,
-		wasmViewKeeper:               wasmViewKeeper,
-		pendingTxs:                   make(map[string][]*PendingTx),
-		nonceMx:                      &sync.RWMutex{},
-		cachedFeeCollectorAddressMtx: &sync.RWMutex{},
-		keyToNonce:                   make(map[tmtypes.TxKey]*AddressNoncePair),
-		receiptStore:                 receiptStateStore,
+		storeKey:                         storeKey,
+		transientStoreKey:                transientStoreKey,
+		Paramstore:                       paramstore,
+		bankKeeper:                       bankKeeper,
+		accountKeeper:                    accountKeeper,
+		stakingKeeper:                    stakingKeeper,
+		distrKeeper:                      distrKeeper,
+		transferKeeper:                   transferKeeper,
+		wasmKeeper:                       wasmKeeper,
+		wasmViewKeeper:                   wasmViewKeeper,
+		pendingTxs:                       make(map[string][]*PendingTx),
+		nonceMx:                          &sync.RWMutex{},
+		cachedFeeCollectorAddressMtx:     &sync.RWMutex{},
+		keyToNonce:                       make(map[tmtypes.TxKey]*AddressNoncePair),
+		pendingNoncesTracked:             &atomic.Bool{},
+		receiptStore:                     receiptStateStore,
+		cachedReceiptIndexStartHeightMtx: &sync.RWMutex{},
+		cachedReceiptPruneCursorMtx:      &sync.RWMutex{},
+		addressCache:                     newAddressCache(),
+		pointerCache:                     newPointerCache(),
 	}
 	return k
 }
@@ -247,6 +284,33 @@ func (k *Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	}
 }
 
+// HistoricalContext returns a context over the committed state at the given
+// height, with the block header fields the EVM reads set to that block's.
+func (k *Keeper) HistoricalContext(ctx sdk.Context, height int64) (hctx sdk.Context, err error) {
+	if k.HistoricalMultiStore == nil {
+		return ctx, types.ErrHeightNotAvailable
+	}
+	if latest := k.HistoricalMultiStore.LastCommitID().Version; height > latest {
+		return ctx, fmt.Errorf("%w: height %d is after the latest committed height %d", types.ErrHeightNotAvailable, height, latest)
+	}
+	defer func() {
+		// some store backends panic instead of erroring on missing versions
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: height %d: %v", types.ErrHeightNotAvailable, height, r)
+		}
+	}()
+	cms, err := k.HistoricalMultiStore.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: height %d: %s", types.ErrHeightNotAvailable, height, err)
+	}
+	hctx = ctx.WithMultiStore(cms).WithBlockHeight(height)
+	if histInfo, found := k.stakingKeeper.GetHistoricalInfo(hctx, height); found {
+		header, _ := tmtypes.HeaderFromProto(&histInfo.Header)
+		hctx = hctx.WithBlockTime(header.Time).WithHeaderHash(header.Hash())
+	}
+	return hctx, nil
+}
+
 func (k *Keeper) getHistoricalHash(ctx sdk.Context, h int64) common.Hash {
 	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 	if !found {
@@ -285,10 +349,18 @@ func (k *Keeper) CalculateNextNonce(ctx sdk.Context, addr common.Address, includ
 	}
 }
 
+// PendingNoncesTracked returns whether any pending nonce has been reported by
+// CheckTx since the node started. Nodes that don't check transactions, e.g.
+// because their mempool is disabled, can only report committed nonces.
+func (k *Keeper) PendingNoncesTracked() bool {
+	return k.pendingNoncesTracked.Load()
+}
+
 // AddPendingNonce adds a pending nonce to the keeper
 func (k *Keeper) AddPendingNonce(key tmtypes.TxKey, addr common.Address, nonce uint64, priority int64) {
 	k.nonceMx.Lock()
 	defer k.nonceMx.Unlock()
+	k.pendingNoncesTracked.Store(true)
 
 	addrStr := addr.Hex()
 	if existing, ok := k.keyToNonce[key]; ok {
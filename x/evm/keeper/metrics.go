@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/sei-protocol/sei-chain/utils/metrics"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// SetMetricsEnabled turns the pointer and association metrics on or off.
+// They are on by default.
+func (k *Keeper) SetMetricsEnabled(enabled bool) {
+	k.metricsDisabled = !enabled
+}
+
+func (k *Keeper) MetricsEnabled() bool {
+	return !k.metricsDisabled
+}
+
+// RecordPointerOperation counts an operation on or through a pointer of
+// pointerType, along with the reason it failed if err is set. Labels never
+// carry addresses, so that their cardinality stays bounded.
+func (k *Keeper) RecordPointerOperation(pointerType types.PointerType, operation string, err error) {
+	if k.metricsDisabled {
+		return
+	}
+	metrics.IncrPointerOperation(pointerType.String(), operation, err == nil)
+	if err != nil {
+		metrics.IncrPointerFailure(pointerType.String(), operation, pointerFailureReason(err))
+	}
+}
+
+// recordPointerRegistrationFailure counts a failed registration, or a failed
+// upgrade if the pointer already existed.
+func (k *Keeper) recordPointerRegistrationFailure(pointerType types.PointerType, upgrade bool, err error) {
+	if upgrade {
+		k.RecordPointerOperation(pointerType, types.PointerOpUpgrade, err)
+	} else {
+		k.RecordPointerOperation(pointerType, types.PointerOpRegister, err)
+	}
+}
+
+func (k *Keeper) recordAssociationCreated(mechanism types.AssociationMechanism) {
+	if k.metricsDisabled {
+		return
+	}
+	metrics.IncrAssociationCreated(mechanism.String())
+}
+
+// recordAddressCacheLookup counts a lookup of an address association in
+// direction that went through the per-block cache.
+func (k *Keeper) recordAddressCacheLookup(direction string, hit bool) {
+	if k.metricsDisabled {
+		return
+	}
+	metrics.IncrAddressCacheLookup(direction, hit)
+}
+
+// pointerFailureReason maps err to one of a fixed set of reasons, since error
+// messages may carry addresses.
+func pointerFailureReason(err error) string {
+	var assocErr types.AssociationMissingErr
+	switch {
+	case errors.Is(err, types.ErrPointerPaused):
+		return "paused"
+	case errors.Is(err, sdkerrors.ErrUnauthorized):
+		return "unauthorized"
+	case errors.Is(err, sdkerrors.ErrInsufficientFunds):
+		return "insufficient_funds"
+	case errors.Is(err, sdkerrors.ErrOutOfGas), errors.Is(err, vm.ErrOutOfGas):
+		return "out_of_gas"
+	case errors.Is(err, vm.ErrExecutionReverted):
+		return "reverted"
+	case errors.As(err, &assocErr):
+		return "association_missing"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	gometrics "github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPointerMetrics(t *testing.T) {
+	sink := gometrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := gometrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := gometrics.NewGlobal(cfg, sink)
+	require.Nil(t, err)
+	defer gometrics.NewGlobal(gometrics.DefaultConfig(""), &gometrics.BlackholeSink{})
+	counter := func(name string) int {
+		c, ok := sink.Data()[0].Counters[name]
+		if !ok {
+			return 0
+		}
+		return c.Count
+	}
+
+	k, ctx := testkeeper.MockEVMKeeper()
+	require.True(t, k.MetricsEnabled())
+	_, pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", pointer))
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", pointer))
+	require.Equal(t, 1, counter("sei.evm.pointer.operation;pointer_type=NATIVE;operation=register;success=true"))
+	require.Equal(t, 1, counter("sei.evm.pointer.operation;pointer_type=NATIVE;operation=upgrade;success=true"))
+
+	_, err = k.SetPointerPaused(ctx, "", types.PointerType_NATIVE, "ufoo", true)
+	require.Nil(t, err)
+	_, err = k.SetPointerPaused(ctx, "", types.PointerType_NATIVE, "ufoo", true)
+	require.NotNil(t, err)
+	require.Equal(t, 1, counter("sei.evm.pointer.operation;pointer_type=NATIVE;operation=pause;success=true"))
+	require.Equal(t, 1, counter("sei.evm.pointer.operation;pointer_type=NATIVE;operation=pause;success=false"))
+	require.Equal(t, 1, counter("sei.evm.pointer.failure;pointer_type=NATIVE;operation=pause;reason=other"))
+
+	// failures are labelled by reason, never by address
+	sender, _ := testkeeper.MockAddressPair()
+	_, erc20Addr := testkeeper.MockAddressPair()
+	_, err = keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: erc20Addr.Hex(), AllowMultipleTypes: true})
+	require.NotNil(t, err)
+	require.Equal(t, 1, counter("sei.evm.pointer.failure;pointer_type=ERC20;operation=register;reason=unauthorized"))
+
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMappingWithMechanism(ctx, seiAddr, evmAddr, types.AssociationMechanism_ASSOCIATE_TX)
+	require.Equal(t, 1, counter("sei.evm.association.created;mechanism=ASSOCIATE_TX"))
+
+	// nothing is recorded once metrics are disabled
+	k.SetMetricsEnabled(false)
+	_, err = k.RemovePointerFromRegistry(ctx, "", types.PointerType_NATIVE, "ufoo", true)
+	require.Nil(t, err)
+	seiAddr, evmAddr = testkeeper.MockAddressPair()
+	k.SetAddressMappingWithMechanism(ctx, seiAddr, evmAddr, types.AssociationMechanism_ASSOCIATE_TX)
+	require.Equal(t, 0, counter("sei.evm.pointer.operation;pointer_type=NATIVE;operation=remove;success=true"))
+	require.Equal(t, 1, counter("sei.evm.association.created;mechanism=ASSOCIATE_TX"))
+}
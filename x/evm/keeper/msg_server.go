@@ -11,22 +11,31 @@ import (
 	"strings"
 
 	"github.com/armon/go-metrics"
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	occtypes "github.com/cosmos/cosmos-sdk/types/occ"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	cmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
 	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/utils/helpers"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw1155"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw721"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
@@ -70,6 +79,7 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 	ctx, originalGasMeter := server.PrepareCtxForEVMTransaction(ctx, tx)
 
 	stateDB := state.NewDBImpl(ctx, &server, false)
+	stateDB.SetTxOrigin(msg.Derived.SenderEVMAddr, tx.Hash())
 	emsg := server.GetEVMMessage(ctx, tx, msg.Derived.SenderEVMAddr)
 	gp := server.GetGasPool()
 
@@ -235,13 +245,37 @@ func (k *Keeper) GetEVMMessage(ctx sdk.Context, tx *ethtypes.Transaction, sender
 }
 
 func (k Keeper) applyEVMMessage(ctx sdk.Context, msg *core.Message, stateDB *state.DBImpl, gp core.GasPool) (*core.ExecutionResult, error) {
+	return k.applyEVMMessageWithTracer(ctx, msg, stateDB, gp, nil)
+}
+
+// applyEVMMessageWithTracer is applyEVMMessage with the given tracing hooks
+// attached to the EVM and stateDB. tracer may be nil. Since msg is not applied
+// as part of a transaction, the transaction hooks are called with a legacy
+// transaction built from msg.
+func (k Keeper) applyEVMMessageWithTracer(ctx sdk.Context, msg *core.Message, stateDB *state.DBImpl, gp core.GasPool, tracer *tracing.Hooks) (res *core.ExecutionResult, err error) {
 	blockCtx, err := k.GetVMBlockContext(ctx, gp)
 	if err != nil {
 		return nil, err
 	}
 	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
 	txCtx := core.NewEVMTxContext(msg)
-	evmInstance := vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{}, k.customPrecompiles)
+	evmInstance := vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{Tracer: tracer}, k.customPrecompiles)
+	if tracer != nil {
+		stateDB.SetLogger(tracer)
+		if tracer.OnTxStart != nil {
+			tx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: msg.Nonce, GasPrice: msg.GasPrice, Gas: msg.GasLimit, To: msg.To, Value: msg.Value, Data: msg.Data})
+			tracer.OnTxStart(evmInstance.GetVMContext(), tx, msg.From)
+		}
+		if tracer.OnTxEnd != nil {
+			defer func() {
+				if err != nil {
+					tracer.OnTxEnd(nil, err)
+					return
+				}
+				tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: res.UsedGas}, nil)
+			}()
+		}
+	}
 	st := core.NewStateTransition(evmInstance, msg, &gp, true) // fee already charged in ante handler
 	return st.TransitionDb()
 }
@@ -260,80 +294,377 @@ func (server msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.
 	return &types.MsgSendResponse{}, nil
 }
 
-func (server msgServer) RegisterPointer(goCtx context.Context, msg *types.MsgRegisterPointer) (*types.MsgRegisterPointerResponse, error) {
+func (server msgServer) RegisterPointer(goCtx context.Context, msg *types.MsgRegisterPointer) (res *types.MsgRegisterPointerResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var existingPointer sdk.AccAddress
+	defer func() {
+		if err != nil {
+			server.RecordPointerOperation(msg.PointerType, types.PointerOpRegister, err)
+		}
+	}()
+	// an unparsable sender is never on the allowlist
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	isGov := sender.Equals(authtypes.NewModuleAddress(govtypes.ModuleName))
+	if msg.AllowMultipleTypes && !isGov {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance can register pointers of several types for a pointee")
+	}
+	if !isGov {
+		if err := server.CheckPointerRegistrationAllowed(ctx, sender); err != nil {
+			return nil, err
+		}
+	}
+	existingPointer, currentVersion, exists, err := server.checkCWPointerRegistration(ctx, msg.PointerType, msg.ErcAddress, msg.AllowMultipleTypes)
+	if err != nil {
+		return nil, err
+	}
+	pointerAddr, err := server.registerCWPointer(ctx, msg.Sender, msg.PointerType, msg.ErcAddress, existingPointer, currentVersion, exists)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterPointerResponse{PointerAddress: pointerAddr.String()}, nil
+}
+
+// RegisterPointers registers the CW pointers of several ERC contracts. All
+// entries are checked before any pointer is deployed, so that an atomic
+// registration with a bad entry fails before deploying anything. Each entry is
+// then deployed in its own cache context, which is discarded if the entry
+// fails and continue_on_error is set.
+func (server msgServer) RegisterPointers(goCtx context.Context, msg *types.MsgRegisterPointers) (*types.MsgRegisterPointersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender) // already validated
+	if !sender.Equals(authtypes.NewModuleAddress(govtypes.ModuleName)) {
+		if err := server.CheckPointerRegistrationAllowed(ctx, sender); err != nil {
+			return nil, err
+		}
+	}
+	type checkedEntry struct {
+		existingPointer sdk.AccAddress
+		currentVersion  uint16
+		exists          bool
+	}
+	checked := make([]checkedEntry, len(msg.Entries))
+	results := make([]*types.PointerRegistrationResult, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		results[i] = &types.PointerRegistrationResult{PointerType: entry.PointerType, Pointee: entry.Pointee}
+		gasBefore := ctx.GasMeter().GasConsumed()
+		existingPointer, currentVersion, exists, err := server.checkCWPointerRegistration(ctx, entry.PointerType, entry.Pointee, false)
+		results[i].GasUsed = ctx.GasMeter().GasConsumed() - gasBefore
+		if err != nil {
+			server.RecordPointerOperation(entry.PointerType, types.PointerOpRegister, err)
+			if !msg.ContinueOnError {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+		checked[i] = checkedEntry{existingPointer: existingPointer, currentVersion: currentVersion, exists: exists}
+	}
+	for i, entry := range msg.Entries {
+		result := results[i]
+		if result.Error == "" {
+			gasBefore := ctx.GasMeter().GasConsumed()
+			cacheCtx, write := ctx.CacheContext()
+			pointerAddr, err := server.registerCWPointer(cacheCtx, msg.Sender, entry.PointerType, entry.Pointee, checked[i].existingPointer, checked[i].currentVersion, checked[i].exists)
+			result.GasUsed += ctx.GasMeter().GasConsumed() - gasBefore
+			if err != nil {
+				server.RecordPointerOperation(entry.PointerType, types.PointerOpRegister, err)
+				if !msg.ContinueOnError {
+					return nil, fmt.Errorf("entry %d: %w", i, err)
+				}
+				result.Error = err.Error()
+			} else {
+				write()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+				result.PointerAddress = pointerAddr.String()
+			}
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePointerRegistrationResult,
+			sdk.NewAttribute(types.AttributeKeyIndex, fmt.Sprintf("%d", i)),
+			sdk.NewAttribute(types.AttributeKeyPointerType, result.PointerType.String()),
+			sdk.NewAttribute(types.AttributeKeyPointee, result.Pointee),
+			sdk.NewAttribute(types.AttributeKeyPointerAddress, result.PointerAddress),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", result.GasUsed)),
+			sdk.NewAttribute(types.AttributeKeyError, result.Error)))
+	}
+	return &types.MsgRegisterPointersResponse{Results: results}, nil
+}
+
+// checkCWPointerRegistration looks up the CW pointer of an ERC contract and
+// checks that a pointer can be registered for it, either as a new pointer or
+// as an upgrade of an outdated one. Unless allowMultipleTypes is set, a new
+// pointer is rejected if the contract has a pointer of another type.
+func (server msgServer) checkCWPointerRegistration(ctx sdk.Context, pointerType types.PointerType, ercAddress string, allowMultipleTypes bool) (existingPointer sdk.AccAddress, currentVersion uint16, exists bool, err error) {
 	var existingVersion uint16
-	var currentVersion uint16
-	var exists bool
-	switch msg.PointerType {
+	switch pointerType {
 	case types.PointerType_ERC20:
 		currentVersion = erc20.CurrentVersion
-		existingPointer, existingVersion, exists = server.GetCW20ERC20Pointer(ctx, common.HexToAddress(msg.ErcAddress))
+		existingPointer, existingVersion, exists = server.GetCW20ERC20Pointer(ctx, common.HexToAddress(ercAddress))
 	case types.PointerType_ERC721:
 		currentVersion = erc721.CurrentVersion
-		existingPointer, existingVersion, exists = server.GetCW721ERC721Pointer(ctx, common.HexToAddress(msg.ErcAddress))
+		existingPointer, existingVersion, exists = server.GetCW721ERC721Pointer(ctx, common.HexToAddress(ercAddress))
 	case types.PointerType_ERC1155:
 		currentVersion = erc1155.CurrentVersion
-		existingPointer, existingVersion, exists = server.GetCW1155ERC1155Pointer(ctx, common.HexToAddress(msg.ErcAddress))
+		existingPointer, existingVersion, exists = server.GetCW1155ERC1155Pointer(ctx, common.HexToAddress(ercAddress))
 	default:
 		panic("unknown pointer type")
 	}
 	if exists && existingVersion >= currentVersion {
-		return nil, fmt.Errorf("pointer %s already registered at version %d", existingPointer.String(), existingVersion)
+		return nil, 0, false, fmt.Errorf("pointer %s already registered at version %d", existingPointer.String(), existingVersion)
+	}
+	if !exists {
+		if err := server.checkPointeeIsNotPointer(ctx, ercAddress); err != nil {
+			return nil, 0, false, err
+		}
+		if pointerKey, _ := PointerRegistryKey(pointerType, ercAddress); server.IsPointeeTombstoned(ctx, pointerKey) {
+			return nil, 0, false, ErrorPointeeTombstoned
+		}
+		if !allowMultipleTypes {
+			if err := server.CheckNoPointerOfOtherType(ctx, pointerType, ercAddress); err != nil {
+				return nil, 0, false, err
+			}
+		}
+		if err := server.validateERCPointee(ctx, pointerType, common.HexToAddress(ercAddress)); err != nil {
+			return nil, 0, false, err
+		}
 	}
+	return existingPointer, currentVersion, exists, nil
+}
+
+// instantiateCWPointer instantiates a new CW pointer of the ERC contract at
+// ercAddress from the stored pointer code of the current version.
+func (k *Keeper) instantiateCWPointer(ctx sdk.Context, pointerType types.PointerType, ercAddress string) (sdk.AccAddress, error) {
 	payload := map[string]interface{}{}
-	switch msg.PointerType {
+	switch pointerType {
 	case types.PointerType_ERC20:
-		payload["erc20_address"] = msg.ErcAddress
+		payload["erc20_address"] = ercAddress
 	case types.PointerType_ERC721:
-		payload["erc721_address"] = msg.ErcAddress
+		payload["erc721_address"] = ercAddress
 	case types.PointerType_ERC1155:
-		payload["erc1155_address"] = msg.ErcAddress
+		payload["erc1155_address"] = ercAddress
 	default:
 		panic("unknown pointer type")
 	}
-	codeID := server.GetStoredPointerCodeID(ctx, msg.PointerType)
-	moduleAcct := server.accountKeeper.GetModuleAddress(types.ModuleName)
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	moduleAcct := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	pointerAddr, _, err := k.wasmKeeper.Instantiate(ctx, k.GetStoredPointerCodeID(ctx, pointerType), moduleAcct, moduleAcct, bz, fmt.Sprintf("Pointer of %s", ercAddress), sdk.NewCoins())
+	return pointerAddr, err
+}
+
+// registerCWPointer deploys a checked CW pointer, or migrates the existing one
+// if exists is set.
+func (server msgServer) registerCWPointer(ctx sdk.Context, sender string, pointerType types.PointerType, ercAddress string, existingPointer sdk.AccAddress, currentVersion uint16, exists bool) (sdk.AccAddress, error) {
+	if !exists {
+		// charged only once validation has passed, so that a registration
+		// rejected before deployment costs nothing beyond gas
+		if err := server.chargePointerRegistrationFee(ctx, sender); err != nil {
+			return nil, err
+		}
+	}
+	pointerKey, _ := PointerRegistryKey(pointerType, ercAddress)
+	_, oldVersion, _ := server.GetPointerInfo(ctx, pointerKey)
 	var err error
 	var pointerAddr sdk.AccAddress
 	if exists {
 		bz, _ := json.Marshal(map[string]interface{}{})
 		pointerAddr = existingPointer
-		_, err = server.wasmKeeper.Migrate(ctx, existingPointer, moduleAcct, codeID, bz)
+		moduleAcct := server.accountKeeper.GetModuleAddress(types.ModuleName)
+		_, err = server.wasmKeeper.Migrate(ctx, existingPointer, moduleAcct, server.GetStoredPointerCodeID(ctx, pointerType), bz)
 	} else {
-		bz, jerr := json.Marshal(payload)
-		if jerr != nil {
-			return nil, jerr
-		}
-		pointerAddr, _, err = server.wasmKeeper.Instantiate(ctx, codeID, moduleAcct, moduleAcct, bz, fmt.Sprintf("Pointer of %s", msg.ErcAddress), sdk.NewCoins())
+		pointerAddr, err = server.instantiateCWPointer(ctx, pointerType, ercAddress)
 	}
 	if err != nil {
 		return nil, err
 	}
-	switch msg.PointerType {
+	switch pointerType {
 	case types.PointerType_ERC20:
-		err = server.SetCW20ERC20Pointer(ctx, common.HexToAddress(msg.ErcAddress), pointerAddr.String())
+		err = server.SetCW20ERC20Pointer(ctx, common.HexToAddress(ercAddress), pointerAddr.String())
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, "erc20"),
-			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, msg.ErcAddress),
+			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, ercAddress),
 			sdk.NewAttribute(types.AttributeKeyPointerVersion, fmt.Sprintf("%d", erc20.CurrentVersion))))
 	case types.PointerType_ERC721:
-		err = server.SetCW721ERC721Pointer(ctx, common.HexToAddress(msg.ErcAddress), pointerAddr.String())
+		err = server.SetCW721ERC721Pointer(ctx, common.HexToAddress(ercAddress), pointerAddr.String())
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, "erc721"),
-			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, msg.ErcAddress),
+			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, ercAddress),
 			sdk.NewAttribute(types.AttributeKeyPointerVersion, fmt.Sprintf("%d", erc721.CurrentVersion))))
 	case types.PointerType_ERC1155:
-		err = server.SetCW1155ERC1155Pointer(ctx, common.HexToAddress(msg.ErcAddress), pointerAddr.String())
+		err = server.SetCW1155ERC1155Pointer(ctx, common.HexToAddress(ercAddress), pointerAddr.String())
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, "erc1155"),
-			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, msg.ErcAddress),
+			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, ercAddress),
 			sdk.NewAttribute(types.AttributeKeyPointerVersion, fmt.Sprintf("%d", erc1155.CurrentVersion))))
 	default:
 		panic("unknown pointer type")
 	}
-	return &types.MsgRegisterPointerResponse{PointerAddress: pointerAddr.String()}, err
+	if err != nil {
+		return nil, err
+	}
+	creator, _ := sdk.AccAddressFromBech32(sender) // already validated
+	if err := server.setPointerCreationInfo(ctx, pointerKey, creator, currentVersion); err != nil {
+		return nil, err
+	}
+	emitPointerRegistration(ctx, pointerType, ercAddress, pointerAddr.String(), oldVersion, currentVersion, exists, sender)
+	return pointerAddr, nil
+}
+
+func (server msgServer) UpgradePointer(goCtx context.Context, msg *types.MsgUpgradePointer) (*types.MsgUpgradePointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender) // already validated
+	if !sender.Equals(authtypes.NewModuleAddress(govtypes.ModuleName)) {
+		pointerKey, _ := PointerRegistryKey(msg.PointerType, msg.Pointee)
+		info, ok := server.GetPointerCreationInfo(ctx, pointerKey)
+		if !ok || info.Creator != msg.Sender {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the creator of the pointer or governance can upgrade it")
+		}
+	}
+	pointer, oldVersion, newVersion, err := server.UpgradePointerToCurrentVersion(ctx, msg.Sender, msg.PointerType, msg.Pointee)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerUpgraded, sdk.NewAttribute(types.AttributeKeyPointerType, msg.PointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, pointer), sdk.NewAttribute(types.AttributeKeyPointee, msg.Pointee),
+		sdk.NewAttribute(types.AttributeKeyOldVersion, fmt.Sprintf("%d", oldVersion)),
+		sdk.NewAttribute(types.AttributeKeyNewVersion, fmt.Sprintf("%d", newVersion))))
+	return &types.MsgUpgradePointerResponse{PointerAddress: pointer, OldVersion: uint32(oldVersion), NewVersion: uint32(newVersion)}, nil
+}
+
+func (server msgServer) RemovePointer(goCtx context.Context, msg *types.MsgRemovePointer) (*types.MsgRemovePointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Sender != authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance can remove pointers")
+	}
+	pointer, err := server.RemovePointerFromRegistry(ctx, msg.Sender, msg.PointerType, msg.Pointee, msg.AllowReregistration)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRemovePointerResponse{PointerAddress: pointer}, nil
+}
+
+func (server msgServer) OverridePointer(goCtx context.Context, msg *types.MsgOverridePointer) (*types.MsgOverridePointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Sender != authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance can override pointers")
+	}
+	oldPointer, pointer, oldVersion, newVersion, err := server.OverridePointerInRegistry(ctx, msg.Sender, msg.PointerType, msg.Pointee, msg.PointerAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgOverridePointerResponse{
+		OldPointerAddress: oldPointer,
+		PointerAddress:    pointer,
+		OldVersion:        uint32(oldVersion),
+		NewVersion:        uint32(newVersion),
+	}, nil
+}
+
+func (server msgServer) PausePointer(goCtx context.Context, msg *types.MsgPausePointer) (*types.MsgPausePointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Sender != authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance can pause pointers")
+	}
+	pointer, err := server.SetPointerPaused(ctx, msg.Sender, msg.PointerType, msg.Pointee, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgPausePointerResponse{PointerAddress: pointer}, nil
+}
+
+func (server msgServer) UnpausePointer(goCtx context.Context, msg *types.MsgUnpausePointer) (*types.MsgUnpausePointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Sender != authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance can unpause pointers")
+	}
+	pointer, err := server.SetPointerPaused(ctx, msg.Sender, msg.PointerType, msg.Pointee, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUnpausePointerResponse{PointerAddress: pointer}, nil
+}
+
+// chargePointerRegistrationFee sends the pointer registration fee from payer to
+// the configured recipient, or to the community pool if there is none.
+func (k *Keeper) chargePointerRegistrationFee(ctx sdk.Context, sender string) error {
+	fee := k.GetPointerRegistrationFee(ctx)
+	if fee.IsZero() {
+		return nil
+	}
+	payer, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	fees := sdk.NewCoins(fee)
+	recipient := k.GetPointerRegistrationFeeRecipient(ctx)
+	if recipient == "" {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fees, payer); err != nil {
+			return err
+		}
+	} else if err := k.BankKeeper().SendCoins(ctx, payer, sdk.MustAccAddressFromBech32(recipient), fees); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerRegistrationFee, sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()), sdk.NewAttribute(types.AttributeKeyRecipient, recipient)))
+	return nil
+}
+
+// validateERCPointee makes sure that the pointee of a new pointer is a contract
+// that answers a minimal probe of its token standard, so that no pointer gets
+// deployed for an address whose every call would revert. ERC20s are probed with
+// functions EIP-20 requires, since optional ones like decimals() may be missing.
+func (k *Keeper) validateERCPointee(ctx sdk.Context, pointerType types.PointerType, addr common.Address) error {
+	if k.GetCodeSize(ctx, addr) == 0 {
+		return fmt.Errorf("no contract deployed at pointee %s", addr.Hex())
+	}
+	moduleAddr := k.AccountKeeper().GetModuleAddress(types.ModuleName)
+	type probe struct {
+		desc      string
+		data      []byte
+		wantsTrue bool
+	}
+	var probes []probe
+	switch pointerType {
+	case types.PointerType_ERC20:
+		totalSupply, err := native.GetParsedABI().Pack("totalSupply")
+		if err != nil {
+			return err
+		}
+		balanceOf, err := native.GetParsedABI().Pack("balanceOf", k.GetEVMAddressOrDefault(ctx, moduleAddr))
+		if err != nil {
+			return err
+		}
+		probes = []probe{{desc: "totalSupply()", data: totalSupply}, {desc: "balanceOf(address)", data: balanceOf}}
+	case types.PointerType_ERC721:
+		data, err := cw721.GetParsedABI().Pack("supportsInterface", [4]byte{0x80, 0xac, 0x58, 0xcd})
+		if err != nil {
+			return err
+		}
+		probes = []probe{{desc: "supportsInterface(0x80ac58cd)", data: data, wantsTrue: true}}
+	case types.PointerType_ERC1155:
+		data, err := cw1155.GetParsedABI().Pack("supportsInterface", [4]byte{0xd9, 0xb6, 0x7a, 0x26})
+		if err != nil {
+			return err
+		}
+		probes = []probe{{desc: "supportsInterface(0xd9b67a26)", data: data, wantsTrue: true}}
+	default:
+		return fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	for _, p := range probes {
+		ret, err := k.StaticCallEVM(ctx, moduleAddr, &addr, p.data)
+		if err != nil {
+			return fmt.Errorf("pointee %s did not answer %s: %w", addr.Hex(), p.desc, err)
+		}
+		if len(ret) != 32 {
+			return fmt.Errorf("pointee %s returned a malformed response to %s", addr.Hex(), p.desc)
+		}
+		if p.wantsTrue && new(big.Int).SetBytes(ret).Cmp(big.NewInt(1)) != 0 {
+			return fmt.Errorf("pointee %s answered false to %s", addr.Hex(), p.desc)
+		}
+	}
+	return nil
 }
 
 func (server msgServer) AssociateContractAddress(goCtx context.Context, msg *types.MsgAssociateContractAddress) (*types.MsgAssociateContractAddressResponse, error) {
@@ -351,10 +682,70 @@ func (server msgServer) AssociateContractAddress(goCtx context.Context, msg *typ
 		}
 		return nil, errors.New("contract already has an associated address")
 	}
-	server.SetAddressMapping(ctx, addr, evmAddr)
+	server.SetAddressMappingWithMechanism(ctx, addr, evmAddr, types.AssociationMechanism_CONTRACT)
 	return &types.MsgAssociateContractAddressResponse{}, nil
 }
 
+func (server msgServer) AssociatePubkey(goCtx context.Context, msg *types.MsgAssociatePubkey) (*types.MsgAssociatePubkeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pk, err := btcec.ParsePubKey(msg.Pubkey, btcec.S256())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	evmAddr, seiAddr, pubkey, err := helpers.GetAddressesFromPubkeyBytes(pk.SerializeUncompressed())
+	if err != nil {
+		return nil, err
+	}
+	if seiAddr.String() != msg.Sender {
+		return nil, types.ErrPubkeyAddressMismatch
+	}
+	// the ante handler associates signers whose public key is already known,
+	// in which case there is nothing left to do
+	if existingEvmAddr, ok := server.GetEVMAddress(ctx, seiAddr); ok {
+		if existingEvmAddr.Cmp(evmAddr) != 0 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "account already has association set")
+		}
+		return &types.MsgAssociatePubkeyResponse{EvmAddress: evmAddr.Hex()}, nil
+	}
+	if err := helpers.NewAssociationHelper(server.Keeper, server.BankKeeper(), server.AccountKeeper()).AssociateAddresses(ctx, seiAddr, evmAddr, pubkey, types.AssociationMechanism_SUBMITTED_PUBKEY); err != nil {
+		return nil, err
+	}
+	return &types.MsgAssociatePubkeyResponse{EvmAddress: evmAddr.Hex()}, nil
+}
+
 func (server msgServer) Associate(context.Context, *types.MsgAssociate) (*types.MsgAssociateResponse, error) {
 	return &types.MsgAssociateResponse{}, nil
 }
+
+func (server msgServer) AssociateWithSignature(goCtx context.Context, msg *types.MsgAssociateWithSignature) (*types.MsgAssociateWithSignatureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seiAddr, err := server.AssociateWithTypedDataSignature(ctx, common.HexToAddress(msg.EvmAddress), msg.Nonce, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAssociateWithSignatureResponse{SeiAddress: seiAddr.String()}, nil
+}
+
+func (server msgServer) AssociateWithSignatures(goCtx context.Context, msg *types.MsgAssociateWithSignatures) (*types.MsgAssociateWithSignaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	results := make([]*types.AssociationResult, len(msg.Associations))
+	for i, association := range msg.Associations {
+		result := &types.AssociationResult{EvmAddress: association.EvmAddress}
+		cacheCtx, write := ctx.CacheContext()
+		seiAddr, err := server.AssociateWithTypedDataSignature(cacheCtx, common.HexToAddress(association.EvmAddress), association.Nonce, association.Signature)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			result.SeiAddress = seiAddr.String()
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAssociationResult,
+			sdk.NewAttribute(types.AttributeKeyIndex, fmt.Sprintf("%d", i)),
+			sdk.NewAttribute(types.AttributeKeyEvmAddress, result.EvmAddress),
+			sdk.NewAttribute(types.AttributeKeySeiAddress, result.SeiAddress),
+			sdk.NewAttribute(types.AttributeKeyError, result.Error)))
+		results[i] = result
+	}
+	return &types.MsgAssociateWithSignaturesResponse{Results: results}, nil
+}
@@ -9,14 +9,20 @@ import (
 	"os"
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -24,10 +30,12 @@ import (
 	"github.com/sei-protocol/sei-chain/example/contracts/sendall"
 	"github.com/sei-protocol/sei-chain/example/contracts/simplestorage"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/ante"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
@@ -104,6 +112,9 @@ func TestEVMTransaction(t *testing.T) {
 
 	// send transaction to the contract
 	contractAddr := common.HexToAddress(receipt.ContractAddress)
+	creationInfo, found := k.GetContractCreationInfo(ctx, contractAddr)
+	require.True(t, found)
+	require.Equal(t, types.ContractCreationInfo{Deployer: evmAddr.Hex(), TxHash: res.Hash, Height: ctx.BlockHeight()}, *creationInfo)
 	abi, err := simplestorage.SimplestorageMetaData.GetAbi()
 	require.Nil(t, err)
 	bz, err = abi.Pack("set", big.NewInt(20))
@@ -536,6 +547,7 @@ func TestRegisterPointer(t *testing.T) {
 	k, ctx := testkeeper.MockEVMKeeper()
 	sender, _ := testkeeper.MockAddressPair()
 	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
 
 	// Test register-pointer for ERC20
 	res, err := keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
@@ -558,6 +570,10 @@ func TestRegisterPointer(t *testing.T) {
 		require.Equal(t, "erc20", string(e.Attributes[0].Value))
 	}
 	require.True(t, hasRegisteredEvent)
+	pointerKey, _ := keeper.PointerRegistryKey(types.PointerType_ERC20, pointee.Hex())
+	creationInfo, found := k.GetPointerCreationInfo(ctx, pointerKey)
+	require.True(t, found)
+	require.Equal(t, types.PointerCreationInfo{Creator: sender.String(), Height: ctx.BlockHeight(), InitialVersion: uint32(erc20.CurrentVersion)}, *creationInfo)
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	// ERC20 pointer already exists
@@ -590,8 +606,16 @@ func TestRegisterPointer(t *testing.T) {
 	require.Equal(t, erc20.CurrentVersion, version)
 	require.Equal(t, newPointer.String(), res.PointerAddress)
 	require.Equal(t, newPointer.String(), pointer.String()) // should retain the existing contract address
+	// upgrades keep the original creation info
+	upgradedInfo, found := k.GetPointerCreationInfo(ctx, pointerKey)
+	require.True(t, found)
+	require.Equal(t, creationInfo, upgradedInfo)
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+	// a pointee may only have pointers of a single type
+	_, pointee = testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+
 	// Test register-pointer for ERC721
 	res, err = keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
 		Sender:      sender.String(),
@@ -647,6 +671,10 @@ func TestRegisterPointer(t *testing.T) {
 	require.Equal(t, newPointer.String(), pointer.String()) // should retain the existing contract address
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+	// a pointee may only have pointers of a single type
+	_, pointee = testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+
 	// Test register-pointer for ERC1155
 	res, err = keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
 		Sender:      sender.String(),
@@ -703,6 +731,524 @@ func TestRegisterPointer(t *testing.T) {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 }
 
+func TestUpgradePointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	sender, _ := testkeeper.MockAddressPair()
+	other, _ := testkeeper.MockAddressPair()
+	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+	registered, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
+		Sender:      sender.String(),
+		PointerType: types.PointerType_ERC20,
+		ErcAddress:  pointee.Hex(),
+	})
+	require.Nil(t, err)
+	typedUpgrades := func(ctx sdk.Context) (res []*types.EventPointerUpgraded) {
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			if e.Type != proto.MessageName(&types.EventPointerUpgraded{}) {
+				continue
+			}
+			typed, err := sdk.ParseTypedEvent(e)
+			require.Nil(t, err)
+			res = append(res, typed.(*types.EventPointerUpgraded))
+		}
+		return
+	}
+	upgradeMsg := types.NewMsgUpgradePointer(sender, types.PointerType_ERC20, pointee.Hex())
+	_, err = msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), upgradeMsg)
+	require.ErrorContains(t, err, "already at version")
+
+	// CW pointers are migrated in place
+	k.DeleteCW20ERC20Pointer(ctx, pointee, erc20.CurrentVersion)
+	require.Nil(t, k.SetCW20ERC20PointerWithVersion(ctx, pointee, registered.PointerAddress, erc20.CurrentVersion-1))
+	_, err = msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), types.NewMsgUpgradePointer(other, types.PointerType_ERC20, pointee.Hex()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), upgradeMsg)
+	require.Nil(t, err)
+	require.Equal(t, types.MsgUpgradePointerResponse{PointerAddress: registered.PointerAddress, OldVersion: uint32(erc20.CurrentVersion - 1), NewVersion: uint32(erc20.CurrentVersion)}, *res)
+	pointer, version, exists := k.GetCW20ERC20Pointer(ctx, pointee)
+	require.True(t, exists)
+	require.Equal(t, erc20.CurrentVersion, version)
+	require.Equal(t, registered.PointerAddress, pointer.String())
+	hasUpgradedEvent := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != types.EventTypePointerUpgraded {
+			continue
+		}
+		hasUpgradedEvent = true
+		require.Equal(t, fmt.Sprintf("%d", erc20.CurrentVersion-1), string(e.Attributes[3].Value))
+		require.Equal(t, fmt.Sprintf("%d", erc20.CurrentVersion), string(e.Attributes[4].Value))
+	}
+	require.True(t, hasUpgradedEvent)
+	require.Equal(t, []*types.EventPointerUpgraded{{
+		PointerType: types.PointerType_ERC20, Pointee: pointee.Hex(), Pointer: registered.PointerAddress,
+		OldVersion: uint32(erc20.CurrentVersion - 1), NewVersion: uint32(erc20.CurrentVersion), Actor: sender.String(),
+	}}, typedUpgrades(ctx))
+	// the migration's own events are kept
+	hasMigrateEvent := false
+	for _, e := range ctx.EventManager().Events() {
+		hasMigrateEvent = hasMigrateEvent || e.Type == wasmtypes.EventTypeMigrate
+	}
+	require.True(t, hasMigrateEvent)
+
+	// EVM pointers are redeployed in place with their metadata
+	deployNativePointer := func(denom string) common.Address {
+		var addr common.Address
+		require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) (err error) {
+			addr, err = k.UpsertERCNativePointer(ctx, e, denom, utils.ERCMetadata{Name: "foo", Symbol: "FOO", Decimals: 6})
+			return
+		}, func(string, string) {}))
+		k.DeleteERC20NativePointer(ctx, denom, native.CurrentVersion)
+		require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, denom, addr, native.CurrentVersion-1))
+		return addr
+	}
+	nativePointer := deployNativePointer("ufoo")
+	_, err = msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), types.NewMsgUpgradePointer(sender, types.PointerType_NATIVE, "ufoo"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err = msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), types.NewMsgUpgradePointer(gov, types.PointerType_NATIVE, "ufoo"))
+	require.Nil(t, err)
+	require.Equal(t, nativePointer.Hex(), res.PointerAddress)
+	// so are the redeployment's
+	hasRegisteredEvent := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypePointerRegistered {
+			hasRegisteredEvent = true
+			require.Equal(t, nativePointer.Hex(), string(e.Attributes[1].Value))
+		}
+	}
+	require.True(t, hasRegisteredEvent)
+	// with a single typed upgrade event, attributed to the actual actor
+	require.Equal(t, []*types.EventPointerUpgraded{{
+		PointerType: types.PointerType_NATIVE, Pointee: "ufoo", Pointer: nativePointer.Hex(),
+		OldVersion: uint32(native.CurrentVersion - 1), NewVersion: uint32(native.CurrentVersion), Actor: gov.String(),
+	}}, typedUpgrades(ctx))
+	addr, version, _ := k.GetERC20NativePointer(ctx, "ufoo")
+	require.Equal(t, native.CurrentVersion, version)
+	require.Equal(t, nativePointer, addr)
+	name, err := k.QueryERCSingleOutput(ctx, "native", nativePointer, "name")
+	require.Nil(t, err)
+	require.Equal(t, "foo", name)
+
+	// nothing is written if the metadata can't be read back
+	brokenPointer := deployNativePointer("ubar")
+	k.SetCode(ctx, brokenPointer, nil)
+	_, err = msgServer.UpgradePointer(sdk.WrapSDKContext(ctx), types.NewMsgUpgradePointer(gov, types.PointerType_NATIVE, "ubar"))
+	require.NotNil(t, err)
+	_, version, _ = k.GetERC20NativePointer(ctx, "ubar")
+	require.Equal(t, native.CurrentVersion-1, version)
+	require.Empty(t, k.GetCode(ctx, brokenPointer))
+}
+
+func TestRemovePointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	q := keeper.Querier{k}
+	sender, _ := testkeeper.MockAddressPair()
+	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+	registerMsg := &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: pointee.Hex()}
+	registered, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), registerMsg)
+	require.Nil(t, err)
+
+	_, err = msgServer.RemovePointer(sdk.WrapSDKContext(ctx), types.NewMsgRemovePointer(sender, types.PointerType_ERC20, pointee.Hex(), false))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.RemovePointer(sdk.WrapSDKContext(ctx), types.NewMsgRemovePointer(gov, types.PointerType_ERC20, pointee.Hex(), false))
+	require.Nil(t, err)
+	require.Equal(t, registered.PointerAddress, res.PointerAddress)
+	require.Equal(t, types.EventTypePointerRemoved, ctx.EventManager().Events()[0].Type)
+	pointerRes, err := q.Pointer(sdk.WrapSDKContext(ctx), &types.QueryPointerRequest{PointerType: types.PointerType_ERC20, Pointee: pointee.Hex()})
+	require.Nil(t, err)
+	require.False(t, pointerRes.Exists)
+	_, _, exists := k.LookupPointer(ctx, common.BytesToAddress([]byte(registered.PointerAddress)))
+	require.False(t, exists)
+	// the pointer contract itself is kept
+	_, err = msgServer.AssociateContractAddress(sdk.WrapSDKContext(ctx), &types.MsgAssociateContractAddress{Sender: sender.String(), Address: registered.PointerAddress})
+	require.Nil(t, err)
+
+	// the pointee is tombstoned until governance lifts it
+	_, err = msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), registerMsg)
+	require.ErrorIs(t, err, keeper.ErrorPointeeTombstoned)
+	_, err = msgServer.RemovePointer(sdk.WrapSDKContext(ctx), types.NewMsgRemovePointer(gov, types.PointerType_ERC20, pointee.Hex(), false))
+	require.ErrorContains(t, err, "no ERC20 pointer registered")
+	_, err = msgServer.RemovePointer(sdk.WrapSDKContext(ctx), types.NewMsgRemovePointer(gov, types.PointerType_ERC20, pointee.Hex(), true))
+	require.Nil(t, err)
+	_, err = msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), registerMsg)
+	require.Nil(t, err)
+
+	// removal without a tombstone
+	_, nativePointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+	_, err = msgServer.RemovePointer(sdk.WrapSDKContext(ctx), types.NewMsgRemovePointer(gov, types.PointerType_NATIVE, "ufoo", true))
+	require.Nil(t, err)
+	_, _, exists = k.GetERC20NativePointer(ctx, "ufoo")
+	require.False(t, exists)
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", nativePointer))
+}
+
+func TestOverridePointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	sender, _ := testkeeper.MockAddressPair()
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+	registered, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: pointee.Hex()})
+	require.Nil(t, err)
+
+	_, err = msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(sender, types.PointerType_ERC20, pointee.Hex(), ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(gov, types.PointerType_ERC721, pointee.Hex(), ""))
+	require.ErrorContains(t, err, "no ERC721 pointer registered")
+
+	// a new CW pointer is deployed and the old one no longer resolves
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(gov, types.PointerType_ERC20, pointee.Hex(), ""))
+	require.Nil(t, err)
+	require.Equal(t, registered.PointerAddress, res.OldPointerAddress)
+	require.NotEqual(t, registered.PointerAddress, res.PointerAddress)
+	require.Equal(t, uint32(erc20.CurrentVersion), res.OldVersion)
+	require.Equal(t, uint32(erc20.CurrentVersion+1), res.NewVersion)
+	pointer, version, exists := k.GetCW20ERC20Pointer(ctx, pointee)
+	require.True(t, exists)
+	require.Equal(t, res.PointerAddress, pointer.String())
+	require.Equal(t, erc20.CurrentVersion+1, version)
+	_, _, exists = k.GetERC20Pointee(ctx, registered.PointerAddress)
+	require.False(t, exists)
+	resolved, _, exists := k.GetERC20Pointee(ctx, res.PointerAddress)
+	require.True(t, exists)
+	require.Equal(t, pointee, resolved)
+	events := ctx.EventManager().ABCIEvents()
+	overridden := events[len(events)-2]
+	require.Equal(t, types.EventTypePointerOverridden, overridden.Type)
+	require.Equal(t, registered.PointerAddress, string(overridden.Attributes[2].Value))
+	require.Equal(t, res.PointerAddress, string(overridden.Attributes[3].Value))
+	typed, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.Nil(t, err)
+	require.Equal(t, &types.EventPointerOverridden{
+		PointerType: types.PointerType_ERC20, Pointee: pointee.Hex(), Pointer: res.PointerAddress,
+		OldVersion: uint32(erc20.CurrentVersion), NewVersion: uint32(erc20.CurrentVersion + 1), Actor: gov.String(), OldPointer: registered.PointerAddress,
+	}, typed)
+
+	// an ERC pointer can be replaced by a supplied contract
+	var nativePointer common.Address
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		nativePointer, err = k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "foo", Symbol: "FOO", Decimals: 6})
+		return err
+	}, func(string, string) {}))
+	res, err = msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(gov, types.PointerType_NATIVE, "ufoo", ""))
+	require.Nil(t, err)
+	require.NotEqual(t, nativePointer.Hex(), res.PointerAddress)
+	symbol, err := k.QueryERCSingleOutput(ctx, "native", common.HexToAddress(res.PointerAddress), "symbol")
+	require.Nil(t, err)
+	require.Equal(t, "FOO", symbol)
+	nativePointer = common.HexToAddress(res.PointerAddress)
+	_, compatible := testkeeper.MockAddressPair()
+	_, err = msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(gov, types.PointerType_NATIVE, "ufoo", compatible.Hex()))
+	require.ErrorContains(t, err, "no contract deployed")
+	_, err = msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(gov, types.PointerType_NATIVE, "ufoo", res.PointerAddress))
+	require.ErrorContains(t, err, "is already a pointer")
+	k.SetCode(ctx, compatible, testkeeper.MockPointeeCode)
+	res, err = msgServer.OverridePointer(sdk.WrapSDKContext(ctx), types.NewMsgOverridePointer(gov, types.PointerType_NATIVE, "ufoo", compatible.Hex()))
+	require.Nil(t, err)
+	require.Equal(t, nativePointer.Hex(), res.OldPointerAddress)
+	require.Equal(t, compatible.Hex(), res.PointerAddress)
+	_, _, exists = k.GetNativePointee(ctx, nativePointer.Hex())
+	require.False(t, exists)
+	token, _, exists := k.GetNativePointee(ctx, compatible.Hex())
+	require.True(t, exists)
+	require.Equal(t, "ufoo", token)
+}
+
+func TestPausePointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	q := keeper.Querier{k}
+	sender, _ := testkeeper.MockAddressPair()
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+	registered, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: pointee.Hex()})
+	require.Nil(t, err)
+
+	_, err = msgServer.PausePointer(sdk.WrapSDKContext(ctx), types.NewMsgPausePointer(sender, types.PointerType_ERC20, pointee.Hex()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.PausePointer(sdk.WrapSDKContext(ctx), types.NewMsgPausePointer(gov, types.PointerType_ERC721, pointee.Hex()))
+	require.ErrorContains(t, err, "no ERC721 pointer registered")
+	_, err = msgServer.UnpausePointer(sdk.WrapSDKContext(ctx), types.NewMsgUnpausePointer(gov, types.PointerType_ERC20, pointee.Hex()))
+	require.ErrorContains(t, err, "already unpaused")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.PausePointer(sdk.WrapSDKContext(ctx), types.NewMsgPausePointer(gov, types.PointerType_ERC20, pointee.Hex()))
+	require.Nil(t, err)
+	require.Equal(t, registered.PointerAddress, res.PointerAddress)
+	require.Equal(t, types.EventTypePointerPaused, ctx.EventManager().Events()[0].Type)
+	typed, err := sdk.ParseTypedEvent(ctx.EventManager().ABCIEvents()[1])
+	require.Nil(t, err)
+	require.Equal(t, &types.EventPointerPaused{
+		PointerType: types.PointerType_ERC20, Pointee: pointee.Hex(), Pointer: registered.PointerAddress,
+		OldVersion: uint32(erc20.CurrentVersion), NewVersion: uint32(erc20.CurrentVersion), Actor: gov.String(),
+	}, typed)
+	require.True(t, k.IsPointerPaused(ctx, common.BytesToAddress([]byte(registered.PointerAddress))))
+	pointerRes, err := q.Pointer(sdk.WrapSDKContext(ctx), &types.QueryPointerRequest{PointerType: types.PointerType_ERC20, Pointee: pointee.Hex()})
+	require.Nil(t, err)
+	require.True(t, pointerRes.Paused)
+	pointeeRes, err := q.Pointee(sdk.WrapSDKContext(ctx), &types.QueryPointeeRequest{PointerType: types.PointerType_ERC20, Pointer: registered.PointerAddress})
+	require.Nil(t, err)
+	require.True(t, pointeeRes.Paused)
+	_, err = msgServer.PausePointer(sdk.WrapSDKContext(ctx), types.NewMsgPausePointer(gov, types.PointerType_ERC20, pointee.Hex()))
+	require.ErrorContains(t, err, "already paused")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UnpausePointer(sdk.WrapSDKContext(ctx), types.NewMsgUnpausePointer(gov, types.PointerType_ERC20, pointee.Hex()))
+	require.Nil(t, err)
+	require.Equal(t, types.EventTypePointerUnpaused, ctx.EventManager().Events()[0].Type)
+	pointerRes, err = q.Pointer(sdk.WrapSDKContext(ctx), &types.QueryPointerRequest{PointerType: types.PointerType_ERC20, Pointee: pointee.Hex()})
+	require.Nil(t, err)
+	require.False(t, pointerRes.Paused)
+}
+
+func TestRegisterPointerFee(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	sender, _ := testkeeper.MockAddressPair()
+	recipient, _ := testkeeper.MockAddressPair()
+	params := k.GetParams(ctx)
+	params.PointerRegistrationFee = sdk.NewCoin("usei", sdk.NewInt(100))
+	k.SetParams(ctx, params)
+	register := func() error {
+		_, pointee := testkeeper.MockAddressPair()
+		k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+		_, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: pointee.Hex()})
+		return err
+	}
+
+	require.ErrorIs(t, register(), sdkerrors.ErrInsufficientFunds)
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, testkeeper.UseiCoins(1000)))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, testkeeper.UseiCoins(1000)))
+
+	// sent to the community pool by default
+	communityPool := k.AccountKeeper().GetModuleAddress(distrtypes.ModuleName)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, register())
+	require.Equal(t, sdk.NewInt(900), k.BankKeeper().GetBalance(ctx, sender, "usei").Amount)
+	require.Equal(t, sdk.NewInt(100), k.BankKeeper().GetBalance(ctx, communityPool, "usei").Amount)
+	hasFeeEvent := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypePointerRegistrationFee {
+			hasFeeEvent = true
+			require.Equal(t, "100usei", string(e.Attributes[1].Value))
+		}
+	}
+	require.True(t, hasFeeEvent)
+
+	params.PointerRegistrationFeeRecipient = recipient.String()
+	k.SetParams(ctx, params)
+	require.Nil(t, register())
+	require.Equal(t, sdk.NewInt(100), k.BankKeeper().GetBalance(ctx, recipient, "usei").Amount)
+
+	// nothing is charged if the pointee fails validation
+	_, noCode := testkeeper.MockAddressPair()
+	_, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: noCode.Hex()})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.NewInt(800), k.BankKeeper().GetBalance(ctx, sender, "usei").Amount)
+}
+
+func TestRegisterPointerAllowlist(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	allowed, _ := testkeeper.MockAddressPair()
+	other, _ := testkeeper.MockAddressPair()
+	register := func(sender sdk.AccAddress) error {
+		_, pointee := testkeeper.MockAddressPair()
+		k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+		_, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{Sender: sender.String(), PointerType: types.PointerType_ERC20, ErcAddress: pointee.Hex()})
+		return err
+	}
+
+	// permissionless by default
+	require.Nil(t, register(other))
+
+	params := k.GetParams(ctx)
+	params.PointerRegistrationAllowlist = []string{allowed.String()}
+	k.SetParams(ctx, params)
+	require.ErrorIs(t, register(other), keeper.ErrorPointerRegistrationNotAllowed)
+	require.Nil(t, register(allowed))
+	// governance is exempt, whether it registers one pointer or several
+	registerBatch := func(sender sdk.AccAddress) error {
+		_, pointee := testkeeper.MockAddressPair()
+		k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+		_, err := msgServer.RegisterPointers(sdk.WrapSDKContext(ctx), types.NewMsgRegisterPointers(sender, []*types.PointerRegistrationRequest{
+			{PointerType: types.PointerType_ERC20, Pointee: pointee.Hex()},
+		}, false))
+		return err
+	}
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Nil(t, register(gov))
+	require.ErrorIs(t, registerBatch(other), keeper.ErrorPointerRegistrationNotAllowed)
+	require.Nil(t, registerBatch(gov))
+
+	params.PointerRegistrationAllowlist = nil
+	k.SetParams(ctx, params)
+	require.Nil(t, register(other))
+}
+
+func TestRegisterPointers(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	sender, _ := testkeeper.MockAddressPair()
+	_, erc20Pointee := testkeeper.MockAddressPair()
+	_, erc721Pointee := testkeeper.MockAddressPair()
+	_, noCode := testkeeper.MockAddressPair()
+	k.SetCode(ctx, erc20Pointee, testkeeper.MockPointeeCode)
+	k.SetCode(ctx, erc721Pointee, testkeeper.MockPointeeCode)
+	entries := []*types.PointerRegistrationRequest{
+		{PointerType: types.PointerType_ERC20, Pointee: erc20Pointee.Hex()},
+		{PointerType: types.PointerType_ERC721, Pointee: noCode.Hex()},
+		{PointerType: types.PointerType_ERC721, Pointee: erc721Pointee.Hex()},
+	}
+
+	// atomic by default, so nothing is registered if an entry fails
+	_, err := msgServer.RegisterPointers(sdk.WrapSDKContext(ctx), types.NewMsgRegisterPointers(sender, entries, false))
+	require.ErrorContains(t, err, "entry 1: no contract deployed")
+	_, _, exists := k.GetCW20ERC20Pointer(ctx, erc20Pointee)
+	require.False(t, exists)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.RegisterPointers(sdk.WrapSDKContext(ctx), types.NewMsgRegisterPointers(sender, entries, true))
+	require.Nil(t, err)
+	require.Len(t, res.Results, 3)
+	pointer, _, exists := k.GetCW20ERC20Pointer(ctx, erc20Pointee)
+	require.True(t, exists)
+	require.Equal(t, pointer.String(), res.Results[0].PointerAddress)
+	require.Empty(t, res.Results[0].Error)
+	require.Empty(t, res.Results[1].PointerAddress)
+	require.Contains(t, res.Results[1].Error, "no contract deployed")
+	pointer, _, exists = k.GetCW721ERC721Pointer(ctx, erc721Pointee)
+	require.True(t, exists)
+	require.Equal(t, pointer.String(), res.Results[2].PointerAddress)
+	for _, result := range res.Results {
+		require.NotZero(t, result.GasUsed)
+	}
+	registered, resultEvents := 0, 0
+	for _, e := range ctx.EventManager().Events() {
+		switch e.Type {
+		case types.EventTypePointerRegistered:
+			registered++
+		case types.EventTypePointerRegistrationResult:
+			require.Equal(t, fmt.Sprintf("%d", res.Results[resultEvents].GasUsed), string(e.Attributes[4].Value))
+			resultEvents++
+		}
+	}
+	require.Equal(t, 2, registered)
+	require.Equal(t, 3, resultEvents)
+
+	// registered pointers are up to date and fail the check
+	res, err = msgServer.RegisterPointers(sdk.WrapSDKContext(ctx), types.NewMsgRegisterPointers(sender, entries[:1], true))
+	require.Nil(t, err)
+	require.Contains(t, res.Results[0].Error, "already registered")
+}
+
+func TestRegisterPointerPointeeIsPointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	sender, _ := testkeeper.MockAddressPair()
+	for _, tc := range []struct {
+		registeredType types.PointerType
+		setter         func(sdk.Context, string, common.Address) error
+		pointerType    types.PointerType
+	}{
+		{types.PointerType_NATIVE, k.SetERC20NativePointer, types.PointerType_ERC20},
+		{types.PointerType_CW20, k.SetERC20CW20Pointer, types.PointerType_ERC20},
+		{types.PointerType_CW721, k.SetERC721CW721Pointer, types.PointerType_ERC721},
+		{types.PointerType_CW1155, k.SetERC1155CW1155Pointer, types.PointerType_ERC1155},
+	} {
+		pointee, pointer := testkeeper.MockAddressPair()
+		require.Nil(t, tc.setter(ctx, pointee.String(), pointer))
+		k.SetCode(ctx, pointer, testkeeper.MockPointeeCode)
+		_, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
+			Sender:      sender.String(),
+			PointerType: tc.pointerType,
+			ErcAddress:  pointer.Hex(),
+		})
+		require.ErrorIs(t, err, keeper.ErrPointeeIsPointer)
+		require.ErrorContains(t, err, fmt.Sprintf("%s pointer of %s", tc.registeredType, pointee.String()))
+	}
+}
+
+func TestRegisterPointerOfOtherType(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	q := keeper.Querier{k}
+	sender, _ := testkeeper.MockAddressPair()
+	_, pointee := testkeeper.MockAddressPair()
+	k.SetCode(ctx, pointee, testkeeper.MockPointeeCode)
+	register := func(sender sdk.AccAddress, pointerType types.PointerType, allowMultipleTypes bool) error {
+		_, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
+			Sender:             sender.String(),
+			PointerType:        pointerType,
+			ErcAddress:         pointee.Hex(),
+			AllowMultipleTypes: allowMultipleTypes,
+		})
+		return err
+	}
+	require.Nil(t, register(sender, types.PointerType_ERC721, false))
+
+	require.ErrorIs(t, register(sender, types.PointerType_ERC20, false), keeper.ErrorPointeeHasPointerOfOtherType)
+	require.ErrorIs(t, register(sender, types.PointerType_ERC20, true), sdkerrors.ErrUnauthorized)
+	// governance may override the check, even if it isn't on the allowlist
+	params := k.GetParams(ctx)
+	params.PointerRegistrationAllowlist = []string{sender.String()}
+	k.SetParams(ctx, params)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Nil(t, register(gov, types.PointerType_ERC20, true))
+
+	res, err := q.Pointer(sdk.WrapSDKContext(ctx), &types.QueryPointerRequest{Pointee: pointee.Hex(), AllTypes: true})
+	require.Nil(t, err)
+	require.True(t, res.Exists)
+	require.Len(t, res.Pointers, 2)
+	require.Equal(t, types.PointerType_ERC20, res.Pointers[0].PointerType)
+	require.Equal(t, types.PointerType_ERC721, res.Pointers[1].PointerType)
+	cw20Pointer, _, _ := k.GetCW20ERC20Pointer(ctx, pointee)
+	require.Equal(t, cw20Pointer.String(), res.Pointers[0].Pointer)
+}
+
+func TestRegisterPointerInvalidPointee(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	sender, _ := testkeeper.MockAddressPair()
+	_, pointee := testkeeper.MockAddressPair()
+	register := func(pointerType types.PointerType) error {
+		_, err := keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
+			Sender:      sender.String(),
+			PointerType: pointerType,
+			ErcAddress:  pointee.Hex(),
+		})
+		return err
+	}
+
+	require.ErrorContains(t, register(types.PointerType_ERC20), "no contract deployed")
+	// reverts on every call
+	k.SetCode(ctx, pointee, common.FromHex("0x60006000fd"))
+	require.ErrorContains(t, register(types.PointerType_ERC20), "did not answer totalSupply()")
+	// returns 0 on every call
+	k.SetCode(ctx, pointee, common.FromHex("0x600060005260206000f3"))
+	require.ErrorContains(t, register(types.PointerType_ERC721), "answered false to supportsInterface(0x80ac58cd)")
+	require.ErrorContains(t, register(types.PointerType_ERC1155), "answered false to supportsInterface(0xd9b67a26)")
+	_, _, exists := k.GetCW721ERC721Pointer(ctx, pointee)
+	require.False(t, exists)
+	// decimals() is optional in EIP-20: a token that reverts on it, but
+	// answers everything else, can be pointed to
+	k.SetCode(ctx, pointee, common.FromHex("0x60003560e01c63313ce5671460145760206000f35b60006000fd"))
+	require.Nil(t, register(types.PointerType_ERC20))
+	_, _, exists = k.GetCW20ERC20Pointer(ctx, pointee)
+	require.True(t, exists)
+}
+
 func TestEvmError(t *testing.T) {
 	k := testkeeper.EVMTestApp.EvmKeeper
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{})
@@ -789,6 +1335,7 @@ func TestAssociateContractAddress(t *testing.T) {
 	k, ctx := testkeeper.MockEVMKeeper()
 	msgServer := keeper.NewMsgServerImpl(k)
 	dummySeiAddr, dummyEvmAddr := testkeeper.MockAddressPair()
+	k.SetCode(ctx, dummyEvmAddr, testkeeper.MockPointeeCode)
 	res, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
 		Sender:      dummySeiAddr.String(),
 		PointerType: types.PointerType_ERC20,
@@ -870,3 +1417,111 @@ func TestAssociate(t *testing.T) {
 	res = testkeeper.EVMTestApp.DeliverTx(ctx, abci.RequestDeliverTx{Tx: txbz}, sdktx, sha256.Sum256(txbz))
 	require.Equal(t, uint32(0), res.Code)
 }
+
+func TestAssociateWithSignature(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	submitter, _ := testkeeper.MockAddressPair()
+	privKey := testkeeper.MockPrivateKey()
+	seiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	sign := func(nonce uint64) []byte {
+		sig, err := crypto.Sign(types.AssociationTypedDataHash(k.ChainID(ctx), evmAddr, nonce).Bytes(), key)
+		require.Nil(t, err)
+		return sig
+	}
+
+	// a signature over another nonce recovers a different address
+	_, err := msgServer.AssociateWithSignature(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignature(submitter, evmAddr, 1, sign(2)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, otherEvmAddr := testkeeper.MockAddressPair()
+	_, err = msgServer.AssociateWithSignature(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignature(submitter, otherEvmAddr, 1, sign(1)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.AssociateWithSignature(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignature(submitter, evmAddr, 1, sign(1)))
+	require.Nil(t, err)
+	require.Equal(t, seiAddr.String(), res.SeiAddress)
+	associated, found := k.GetSeiAddress(ctx, evmAddr)
+	require.True(t, found)
+	require.Equal(t, seiAddr, associated)
+	require.Equal(t, types.EventTypeAddressAssociated, ctx.EventManager().Events()[0].Type)
+	require.NotNil(t, k.AccountKeeper().GetAccount(ctx, seiAddr).GetPubKey())
+
+	_, err = msgServer.AssociateWithSignature(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignature(submitter, evmAddr, 1, sign(1)))
+	require.ErrorContains(t, err, "nonce 1 has already been used")
+	_, err = msgServer.AssociateWithSignature(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignature(submitter, evmAddr, 2, sign(2)))
+	require.ErrorContains(t, err, "already has association set")
+}
+
+func TestAssociateWithSignatures(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	relayer, _ := testkeeper.MockAddressPair()
+	signed := func() (*types.SignedAssociation, sdk.AccAddress) {
+		privKey := testkeeper.MockPrivateKey()
+		seiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+		key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+		sig, err := crypto.Sign(types.AssociationTypedDataHash(k.ChainID(ctx), evmAddr, 0).Bytes(), key)
+		require.Nil(t, err)
+		return &types.SignedAssociation{EvmAddress: evmAddr.Hex(), Signature: sig}, seiAddr
+	}
+	first, firstSeiAddr := signed()
+	second, _ := signed()
+	second.Nonce = 1 // signed over nonce 0
+	third, thirdSeiAddr := signed()
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.AssociateWithSignatures(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignatures(relayer, []*types.SignedAssociation{first, second, third}))
+	require.Nil(t, err)
+	require.Len(t, res.Results, 3)
+	require.Equal(t, firstSeiAddr.String(), res.Results[0].SeiAddress)
+	require.Empty(t, res.Results[0].Error)
+	require.Empty(t, res.Results[1].SeiAddress)
+	require.Contains(t, res.Results[1].Error, "association signature is from")
+	require.Equal(t, thirdSeiAddr.String(), res.Results[2].SeiAddress)
+	_, found := k.GetEVMAddress(ctx, thirdSeiAddr)
+	require.True(t, found)
+	_, found = k.GetSeiAddress(ctx, common.HexToAddress(second.EvmAddress))
+	require.False(t, found)
+	resultEvents := 0
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeAssociationResult {
+			resultEvents++
+		}
+	}
+	require.Equal(t, 3, resultEvents)
+
+	// replayed payloads fail individually
+	res, err = msgServer.AssociateWithSignatures(sdk.WrapSDKContext(ctx), types.NewMsgAssociateWithSignatures(relayer, []*types.SignedAssociation{first}))
+	require.Nil(t, err)
+	require.Contains(t, res.Results[0].Error, "nonce 0 has already been used")
+}
+
+func TestAssociatePubkey(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	privKey := testkeeper.MockPrivateKey()
+	seiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	otherSeiAddr, _ := testkeeper.MockAddressPair()
+
+	_, err := msgServer.AssociatePubkey(sdk.WrapSDKContext(ctx), types.NewMsgAssociatePubkey(otherSeiAddr, privKey.PubKey().Bytes()))
+	require.ErrorIs(t, err, types.ErrPubkeyAddressMismatch)
+	invalid := append([]byte{}, privKey.PubKey().Bytes()...)
+	invalid[0] = 0x05
+	_, err = msgServer.AssociatePubkey(sdk.WrapSDKContext(ctx), types.NewMsgAssociatePubkey(seiAddr, invalid))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.AssociatePubkey(sdk.WrapSDKContext(ctx), types.NewMsgAssociatePubkey(seiAddr, privKey.PubKey().Bytes()))
+	require.Nil(t, err)
+	require.Equal(t, evmAddr.Hex(), res.EvmAddress)
+	associated, found := k.GetEVMAddress(ctx, seiAddr)
+	require.True(t, found)
+	require.Equal(t, evmAddr, associated)
+	require.Equal(t, types.EventTypeAddressAssociated, ctx.EventManager().Events()[0].Type)
+
+	// associating again with the same key is a no-op
+	_, err = msgServer.AssociatePubkey(sdk.WrapSDKContext(ctx), types.NewMsgAssociatePubkey(seiAddr, privKey.PubKey().Bytes()))
+	require.Nil(t, err)
+}
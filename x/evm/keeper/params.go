@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/config"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
@@ -61,6 +62,47 @@ func (k *Keeper) GetDeliverTxHookWasmGasLimit(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).DeliverTxHookWasmGasLimit
 }
 
+func (k *Keeper) GetPointerRegistrationLogRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).PointerRegistrationLogRetention
+}
+
+// GetPointerRegistrationFee returns the fee charged for registering a new
+// pointer, which is zero on chains that haven't set the param.
+func (k *Keeper) GetPointerRegistrationFee(ctx sdk.Context) sdk.Coin {
+	fee := k.GetParams(ctx).PointerRegistrationFee
+	if fee.Amount.IsNil() {
+		return sdk.NewCoin(k.GetBaseDenom(ctx), sdk.ZeroInt())
+	}
+	return fee
+}
+
+func (k *Keeper) GetPointerRegistrationFeeRecipient(ctx sdk.Context) string {
+	return k.GetParams(ctx).PointerRegistrationFeeRecipient
+}
+
+func (k *Keeper) GetAutoCreateIBCDenomPointers(ctx sdk.Context) bool {
+	return k.GetParams(ctx).AutoCreateIbcDenomPointers
+}
+
+func (k *Keeper) GetPointerRegistrationAllowlist(ctx sdk.Context) []string {
+	return k.GetParams(ctx).PointerRegistrationAllowlist
+}
+
+// CheckPointerRegistrationAllowed returns ErrorPointerRegistrationNotAllowed
+// if the allowlist is non-empty and doesn't contain sender.
+func (k *Keeper) CheckPointerRegistrationAllowed(ctx sdk.Context, sender sdk.AccAddress) error {
+	allowlist := k.GetPointerRegistrationAllowlist(ctx)
+	if len(allowlist) == 0 {
+		return nil
+	}
+	for _, addr := range allowlist {
+		if allowed, err := sdk.AccAddressFromBech32(addr); err == nil && allowed.Equals(sender) {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrorPointerRegistrationNotAllowed, "sender %s", sender)
+}
+
 func (k *Keeper) ChainID(ctx sdk.Context) *big.Int {
 	if k.EthReplayConfig.Enabled || k.EthBlockTestConfig.Enabled {
 		// replay is for eth mainnet so always return 1
@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw20"
@@ -23,6 +26,10 @@ type PointerGetter func(sdk.Context, string) (common.Address, uint16, bool)
 type PointerSetter func(sdk.Context, string, common.Address) error
 
 var ErrorPointerToPointerNotAllowed = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot create a pointer to a pointer")
+var ErrorPointeeTombstoned = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pointers to this pointee were removed by governance")
+var ErrorPointerRegistrationNotAllowed = sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender is not on the pointer registration allowlist")
+var ErrPointeeIsPointer = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pointee is itself a pointer")
+var ErrorPointeeHasPointerOfOtherType = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pointee already has a pointer of another type")
 
 // ERC20 -> Native Token
 func (k *Keeper) SetERC20NativePointer(ctx sdk.Context, token string, addr common.Address) error {
@@ -34,11 +41,7 @@ func (k *Keeper) SetERC20NativePointerWithVersion(ctx sdk.Context, token string,
 	if k.cwAddressIsPointer(ctx, token) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerERC20NativeKey(token), addr[:], version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(addr), []byte(token), version)
+	return k.setPointer(ctx, types.PointerType_NATIVE, token, addr[:], version)
 }
 
 // ERC20 -> Native Token
@@ -69,11 +72,7 @@ func (k *Keeper) SetERC20CW20PointerWithVersion(ctx sdk.Context, cw20Address str
 	if k.cwAddressIsPointer(ctx, cw20Address) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerERC20CW20Key(cw20Address), addr[:], version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(addr), []byte(cw20Address), version)
+	return k.setPointer(ctx, types.PointerType_CW20, cw20Address, addr[:], version)
 }
 
 // ERC20 -> CW20
@@ -104,11 +103,7 @@ func (k *Keeper) SetERC721CW721PointerWithVersion(ctx sdk.Context, cw721Address
 	if k.cwAddressIsPointer(ctx, cw721Address) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerERC721CW721Key(cw721Address), addr[:], version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(addr), []byte(cw721Address), version)
+	return k.setPointer(ctx, types.PointerType_CW721, cw721Address, addr[:], version)
 }
 
 // ERC721 -> CW721
@@ -139,11 +134,7 @@ func (k *Keeper) SetERC1155CW1155PointerWithVersion(ctx sdk.Context, cw1155Addre
 	if k.cwAddressIsPointer(ctx, cw1155Address) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerERC1155CW1155Key(cw1155Address), addr[:], version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(addr), []byte(cw1155Address), version)
+	return k.setPointer(ctx, types.PointerType_CW1155, cw1155Address, addr[:], version)
 }
 
 // ERC1155 -> CW1155
@@ -174,11 +165,7 @@ func (k *Keeper) SetCW20ERC20PointerWithVersion(ctx sdk.Context, erc20Address co
 	if k.evmAddressIsPointer(ctx, erc20Address) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerCW20ERC20Key(erc20Address), []byte(addr), version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr))), erc20Address[:], version)
+	return k.setPointer(ctx, types.PointerType_ERC20, erc20Address.Hex(), []byte(addr), version)
 }
 
 // CW20 -> ERC20
@@ -199,13 +186,83 @@ func (k *Keeper) DeleteCW20ERC20Pointer(ctx sdk.Context, erc20Address common.Add
 	}
 }
 
+// setPointer registers pointer for pointee in both the forward and the reverse
+// registry, and runs the pointer hooks.
+func (k *Keeper) setPointer(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer []byte, version uint16) error {
+	pointerKey, _ := PointerRegistryKey(pointerType, pointee)
+	_, _, existed := k.GetPointerInfo(ctx, pointerKey)
+	if err := k.writePointer(ctx, pointerType, pointee, pointer, version); err != nil {
+		return err
+	}
+	if existed {
+		k.RecordPointerOperation(pointerType, types.PointerOpUpgrade, nil)
+		k.afterPointerUpgraded(ctx, pointerType, pointee, pointerAddressString(pointerType, pointer), version)
+	} else {
+		k.RecordPointerOperation(pointerType, types.PointerOpRegister, nil)
+		k.afterPointerRegistered(ctx, pointerType, pointee, pointerAddressString(pointerType, pointer), version)
+	}
+	return nil
+}
+
+// writePointer registers pointer for pointee in both the forward and the
+// reverse registry.
+func (k *Keeper) writePointer(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer []byte, version uint16) error {
+	pointerKey, ok := PointerRegistryKey(pointerType, pointee)
+	if !ok {
+		return fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	if err := k.setPointerInfo(ctx, pointerKey, pointer, version); err != nil {
+		return err
+	}
+	// the reverse registry is keyed by the pointer truncated to an address
+	// length, and holds the pointee as it is keyed in the forward registry
+	pointeeBz := []byte(pointee)
+	if isCWPointerType(pointerType) {
+		pointeeBz = common.HexToAddress(pointee).Bytes()
+	}
+	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress(pointer)), pointeeBz, version)
+}
+
+// emitPointerEvent emits a typed pointer lifecycle event. Failing to emit it
+// doesn't fail the change it reports.
+func emitPointerEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit %s: %s", proto.MessageName(event), err))
+	}
+}
+
+// emitPointerRegistration emits the typed event of a pointer deployment, which
+// either registers a new pointer or upgrades the existing one in place.
+func emitPointerRegistration(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer string, oldVersion uint16, newVersion uint16, upgrade bool, actor string) {
+	if upgrade {
+		emitPointerEvent(ctx, &types.EventPointerUpgraded{
+			PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+			OldVersion: uint32(oldVersion), NewVersion: uint32(newVersion), Actor: actor,
+		})
+		return
+	}
+	emitPointerEvent(ctx, &types.EventPointerRegistered{
+		PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+		NewVersion: uint32(newVersion), Actor: actor,
+	})
+}
+
+// pointerAddressString formats a pointer as stored in the forward registry,
+// i.e. bech32 for CW pointers and hex otherwise.
+func pointerAddressString(pointerType types.PointerType, pointer []byte) string {
+	if isCWPointerType(pointerType) {
+		return string(pointer)
+	}
+	return common.BytesToAddress(pointer).Hex()
+}
+
 func (k *Keeper) evmAddressIsPointer(ctx sdk.Context, addr common.Address) bool {
-	_, _, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(addr))
+	_, _, exists := k.getPointerReverseIndex(ctx, addr)
 	return exists
 }
 
 func (k *Keeper) cwAddressIsPointer(ctx sdk.Context, addr string) bool {
-	_, _, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr))))
+	_, _, exists := k.getPointerReverseIndex(ctx, common.BytesToAddress([]byte(addr)))
 	return exists
 }
 
@@ -219,11 +276,7 @@ func (k *Keeper) SetCW721ERC721PointerWithVersion(ctx sdk.Context, erc721Address
 	if k.evmAddressIsPointer(ctx, erc721Address) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerCW721ERC721Key(erc721Address), []byte(addr), version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr))), erc721Address[:], version)
+	return k.setPointer(ctx, types.PointerType_ERC721, erc721Address.Hex(), []byte(addr), version)
 }
 
 // CW721 -> ERC721
@@ -254,11 +307,7 @@ func (k *Keeper) SetCW1155ERC1155PointerWithVersion(ctx sdk.Context, erc1155Addr
 	if k.evmAddressIsPointer(ctx, erc1155Address) {
 		return ErrorPointerToPointerNotAllowed
 	}
-	err := k.setPointerInfo(ctx, types.PointerCW1155ERC1155Key(erc1155Address), []byte(addr), version)
-	if err != nil {
-		return err
-	}
-	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr))), erc1155Address[:], version)
+	return k.setPointer(ctx, types.PointerType_ERC1155, erc1155Address.Hex(), []byte(addr), version)
 }
 
 // CW1155 -> ERC1155
@@ -279,59 +328,472 @@ func (k *Keeper) DeleteCW1155ERC1155Pointer(ctx sdk.Context, erc1155Address comm
 	}
 }
 
+// pointerRegistryTypePrefix returns the prefix, under PointerRegistryPrefix,
+// that holds the forward registry entries of a pointer type.
+func pointerRegistryTypePrefix(pointerType types.PointerType) ([]byte, bool) {
+	switch pointerType {
+	case types.PointerType_NATIVE:
+		return types.PointerERC20NativePrefix, true
+	case types.PointerType_CW20:
+		return types.PointerERC20CW20Prefix, true
+	case types.PointerType_CW721:
+		return types.PointerERC721CW721Prefix, true
+	case types.PointerType_CW1155:
+		return types.PointerERC1155CW1155Prefix, true
+	case types.PointerType_ERC20:
+		return types.PointerCW20ERC20Prefix, true
+	case types.PointerType_ERC721:
+		return types.PointerCW721ERC721Prefix, true
+	case types.PointerType_ERC1155:
+		return types.PointerCW1155ERC1155Prefix, true
+	default:
+		return nil, false
+	}
+}
+
+// currentPointerVersion returns the version that pointers of the given type are
+// registered at when created or upgraded now.
+func (k *Keeper) currentPointerVersion(ctx sdk.Context, pointerType types.PointerType) uint16 {
+	switch pointerType {
+	case types.PointerType_NATIVE:
+		return native.CurrentVersion
+	case types.PointerType_CW20:
+		return cw20.CurrentVersion(ctx)
+	case types.PointerType_CW721:
+		return cw721.CurrentVersion
+	case types.PointerType_CW1155:
+		return cw1155.CurrentVersion
+	case types.PointerType_ERC20:
+		return erc20.CurrentVersion
+	case types.PointerType_ERC721:
+		return erc721.CurrentVersion
+	case types.PointerType_ERC1155:
+		return erc1155.CurrentVersion
+	default:
+		return 0
+	}
+}
+
+// PointerRegistryStore returns the forward registry of a pointer type. Keys are
+// the pointee followed by the 2-byte version and values are the pointer.
+func (k *Keeper) PointerRegistryStore(ctx sdk.Context, pointerType types.PointerType) (prefix.Store, bool) {
+	typePrefix, ok := pointerRegistryTypePrefix(pointerType)
+	if !ok {
+		return prefix.Store{}, false
+	}
+	return prefix.NewStore(k.PrefixStore(ctx, types.PointerRegistryPrefix), typePrefix), true
+}
+
+// DecodePointerRegistryEntry decodes a key/value pair of PointerRegistryStore.
+func DecodePointerRegistryEntry(pointerType types.PointerType, key []byte, value []byte) (*types.PointerEntry, error) {
+	if len(key) < 2 {
+		return nil, fmt.Errorf("malformed pointer registry key %X", key)
+	}
+	pointee, versionBz := key[:len(key)-2], key[len(key)-2:]
+	entry := &types.PointerEntry{Version: uint32(binary.BigEndian.Uint16(versionBz))}
+	switch pointerType {
+	case types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155:
+		entry.Pointee = common.BytesToAddress(pointee).Hex()
+		entry.Pointer = string(value)
+	default:
+		entry.Pointee = string(pointee)
+		entry.Pointer = common.BytesToAddress(value).Hex()
+	}
+	return entry, nil
+}
+
+// ResolveReverseRegistryEntry returns the pointer entry a key/value pair of the
+// reverse registry belongs to if it is a pointer of the given type. The reverse
+// registry is shared by all pointer types, so the entry is checked against the
+// forward registry of the type.
+func (k *Keeper) ResolveReverseRegistryEntry(ctx sdk.Context, pointerType types.PointerType, key []byte, value []byte) (*types.PointerEntry, bool) {
+	if len(key) != common.AddressLength+2 {
+		return nil, false
+	}
+	store, ok := k.PointerRegistryStore(ctx, pointerType)
+	if !ok {
+		return nil, false
+	}
+	addr, versionBz := common.BytesToAddress(key[:common.AddressLength]), key[common.AddressLength:]
+	// the reverse value is the pointee exactly as it is keyed in the forward registry
+	forwardKey := append(append([]byte{}, value...), versionBz...)
+	pointer := store.Get(forwardKey)
+	if pointer == nil {
+		return nil, false
+	}
+	// CW pointer addresses are only stored in the reverse registry truncated
+	// to an address length
+	if common.BytesToAddress(pointer) != addr {
+		return nil, false
+	}
+	entry, err := DecodePointerRegistryEntry(pointerType, forwardKey, pointer)
+	if err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+// LookupPointer returns the latest pointer entry and type of a pointer contract
+// by its address. CW pointers are looked up by their bech32 address truncated
+// to an address length, as they are keyed in the reverse registry. It reads
+// the reverse index once and the forward registry once per pointer type.
+func (k *Keeper) LookupPointer(ctx sdk.Context, addr common.Address) (*types.PointerEntry, types.PointerType, bool) {
+	pointee, version, exists := k.getPointerReverseIndex(ctx, addr)
+	if !exists {
+		return nil, 0, false
+	}
+	versionBz := make([]byte, 2)
+	binary.BigEndian.PutUint16(versionBz, version)
+	key := append(addr.Bytes(), versionBz...)
+	for i := 0; i < len(types.PointerType_name); i++ {
+		if entry, ok := k.ResolveReverseRegistryEntry(ctx, types.PointerType(i), key, pointee); ok {
+			return entry, types.PointerType(i), true
+		}
+	}
+	return nil, 0, false
+}
+
+// IsAnyPointer returns the type of the pointer at addr, which is either the hex
+// address of an ERC pointer or the bech32 address of a CW pointer.
+func (k *Keeper) IsAnyPointer(ctx sdk.Context, addr string) (types.PointerType, bool) {
+	_, pointerType, ok := k.lookupAnyPointer(ctx, addr)
+	return pointerType, ok
+}
+
+func (k *Keeper) lookupAnyPointer(ctx sdk.Context, addr string) (*types.PointerEntry, types.PointerType, bool) {
+	if common.IsHexAddress(addr) {
+		entry, pointerType, ok := k.LookupPointer(ctx, common.HexToAddress(addr))
+		// a truncated CW pointer address may collide with a hex address
+		if !ok || isCWPointerType(pointerType) {
+			return nil, 0, false
+		}
+		return entry, pointerType, true
+	}
+	entry, pointerType, ok := k.LookupPointer(ctx, common.BytesToAddress([]byte(addr)))
+	if !ok || !isCWPointerType(pointerType) || entry.Pointer != addr {
+		return nil, 0, false
+	}
+	return entry, pointerType, true
+}
+
+// isCWPointerType returns whether pointers of the type are CW contracts.
+func isCWPointerType(pointerType types.PointerType) bool {
+	switch pointerType {
+	case types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkPointeeIsNotPointer rejects the registration of a pointer to another
+// pointer, which would wrap the underlying asset twice.
+func (k *Keeper) checkPointeeIsNotPointer(ctx sdk.Context, pointee string) error {
+	entry, pointerType, ok := k.lookupAnyPointer(ctx, pointee)
+	if !ok {
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrPointeeIsPointer, "%s is the %s pointer of %s", pointee, pointerType, entry.Pointee)
+}
+
+// pointeePointerTypes returns the pointer types that can be registered for
+// pointee, i.e. the ERC types for a hex address and the others otherwise.
+func pointeePointerTypes(pointee string) []types.PointerType {
+	if common.IsHexAddress(pointee) {
+		return []types.PointerType{types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155}
+	}
+	return []types.PointerType{types.PointerType_NATIVE, types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155}
+}
+
+// GetPointersOfPointee returns the latest pointer of each type registered for
+// pointee.
+func (k *Keeper) GetPointersOfPointee(ctx sdk.Context, pointee string) []*types.PointerOfType {
+	res := []*types.PointerOfType{}
+	for _, pointerType := range pointeePointerTypes(pointee) {
+		pointerKey, _ := PointerRegistryKey(pointerType, pointee)
+		addr, version, exists := k.GetPointerInfo(ctx, pointerKey)
+		if !exists {
+			continue
+		}
+		pointer := common.BytesToAddress(addr).Hex()
+		if isCWPointerType(pointerType) {
+			pointer = string(addr)
+		}
+		res = append(res, &types.PointerOfType{PointerType: pointerType, Pointer: pointer, Version: uint32(version)})
+	}
+	return res
+}
+
+// CheckNoPointerOfOtherType rejects the registration of a pointer of
+// pointerType for a pointee that already has a pointer of another type, e.g. a
+// CW contract implementing both CW20 and CW721, so that tooling resolving the
+// pointer of a pointee can't pick the wrong one.
+func (k *Keeper) CheckNoPointerOfOtherType(ctx sdk.Context, pointerType types.PointerType, pointee string) error {
+	for _, p := range k.GetPointersOfPointee(ctx, pointee) {
+		if p.PointerType != pointerType {
+			return sdkerrors.Wrapf(ErrorPointeeHasPointerOfOtherType, "%s pointer %s", p.PointerType, p.Pointer)
+		}
+	}
+	return nil
+}
+
+// PointerRegistryKey returns the forward registry key of a pointee of the given
+// pointer type, i.e. the key that pointers to it are registered under.
+func PointerRegistryKey(pointerType types.PointerType, pointee string) ([]byte, bool) {
+	switch pointerType {
+	case types.PointerType_NATIVE:
+		return types.PointerERC20NativeKey(pointee), true
+	case types.PointerType_CW20:
+		return types.PointerERC20CW20Key(pointee), true
+	case types.PointerType_CW721:
+		return types.PointerERC721CW721Key(pointee), true
+	case types.PointerType_CW1155:
+		return types.PointerERC1155CW1155Key(pointee), true
+	case types.PointerType_ERC20:
+		return types.PointerCW20ERC20Key(common.HexToAddress(pointee)), true
+	case types.PointerType_ERC721:
+		return types.PointerCW721ERC721Key(common.HexToAddress(pointee)), true
+	case types.PointerType_ERC1155:
+		return types.PointerCW1155ERC1155Key(common.HexToAddress(pointee)), true
+	default:
+		return nil, false
+	}
+}
+
+// setPointerCreationInfo records who registered the pointer under pointerKey,
+// and when, unless it has already been recorded by an earlier registration.
+func (k *Keeper) setPointerCreationInfo(ctx sdk.Context, pointerKey []byte, creator sdk.AccAddress, version uint16) error {
+	store := ctx.KVStore(k.GetStoreKey())
+	key := types.PointerCreationInfoKey(pointerKey)
+	if store.Has(key) {
+		return nil
+	}
+	info := &types.PointerCreationInfo{Creator: creator.String(), Height: ctx.BlockHeight(), InitialVersion: uint32(version)}
+	if txSum := ctx.TxSum(); txSum != [32]byte{} {
+		info.TxHash = fmt.Sprintf("%X", txSum[:])
+	}
+	bz, err := info.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// GetPointerCreationInfo returns the creation info of the pointer registered
+// under pointerKey. Pointers registered before creation info was recorded
+// have none.
+func (k *Keeper) GetPointerCreationInfo(ctx sdk.Context, pointerKey []byte) (*types.PointerCreationInfo, bool) {
+	bz := ctx.KVStore(k.GetStoreKey()).Get(types.PointerCreationInfoKey(pointerKey))
+	if bz == nil {
+		return nil, false
+	}
+	info := &types.PointerCreationInfo{}
+	if err := info.Unmarshal(bz); err != nil {
+		return nil, false
+	}
+	return info, true
+}
+
 func (k *Keeper) GetPointerInfo(ctx sdk.Context, pref []byte) (addr []byte, version uint16, exists bool) {
+	cached := k.pointerCacheUsable(ctx)
+	if cached {
+		if read, ok := k.pointerCache.get(ctx.BlockHeight(), pref); ok {
+			consumeCachedSeekGas(ctx, read)
+			if !read.found {
+				return nil, 0, false
+			}
+			return append([]byte{}, read.value...), binary.BigEndian.Uint16(read.key[len(read.key)-2:]), true
+		}
+	}
 	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), pref)
 	iter := store.ReverseIterator(nil, nil)
 	defer iter.Close()
 	exists = iter.Valid()
 	if !exists {
+		if cached {
+			k.pointerCache.set(ctx.BlockHeight(), pref, cachedPointerRead{})
+		}
 		return
 	}
 	version = binary.BigEndian.Uint16(iter.Key())
 	addr = iter.Value()
+	if cached {
+		key := append(append([]byte{}, pref...), iter.Key()...)
+		k.pointerCache.set(ctx.BlockHeight(), pref, cachedPointerRead{key: key, value: append([]byte{}, addr...), found: true})
+	}
 	return
 }
 
 func (k *Keeper) setPointerInfo(ctx sdk.Context, pref []byte, addr []byte, version uint16) error {
+	k.markPointerRegistryWritten(ctx)
+	if isPointerRegistryKey(pref) {
+		if _, _, exists := k.GetPointerInfo(ctx, pref); !exists {
+			if k.IsPointeeTombstoned(ctx, pref) {
+				return ErrorPointeeTombstoned
+			}
+			k.incrementChainStat(ctx, types.ChainStatsPointerCountKey, 1)
+			k.incrementChainStat(ctx, pointerTypeCountKey(pref), 1)
+		}
+		if err := k.appendPointerRegistration(ctx, pref, addr, version); err != nil {
+			return err
+		}
+	}
 	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), pref)
 	versionBz := make([]byte, 2)
 	binary.BigEndian.PutUint16(versionBz, version)
 	store.Set(versionBz, addr)
+	if isPointerReverseRegistryKey(pref) {
+		k.updatePointerReverseIndex(ctx, pref)
+	}
 	return nil
 }
 
 func (k *Keeper) deletePointerInfo(ctx sdk.Context, pref []byte, version uint16) {
+	k.markPointerRegistryWritten(ctx)
 	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), pref)
 	versionBz := make([]byte, 2)
 	binary.BigEndian.PutUint16(versionBz, version)
+	if !store.Has(versionBz) {
+		return
+	}
 	store.Delete(versionBz)
+	if isPointerRegistryKey(pref) {
+		if _, _, exists := k.GetPointerInfo(ctx, pref); !exists {
+			k.decrementChainStat(ctx, types.ChainStatsPointerCountKey)
+			k.decrementChainStat(ctx, pointerTypeCountKey(pref))
+		}
+	}
+	if isPointerReverseRegistryKey(pref) {
+		k.updatePointerReverseIndex(ctx, pref)
+	}
+}
+
+// isPointerReverseRegistryKey returns whether pref is the reverse registry key
+// of a pointer.
+func isPointerReverseRegistryKey(pref []byte) bool {
+	return len(pref) == len(types.PointerReverseRegistryPrefix)+common.AddressLength && bytes.HasPrefix(pref, types.PointerReverseRegistryPrefix)
+}
+
+// updatePointerReverseIndex points the reverse index entry of the pointer with
+// the reverse registry key pref at its latest version, or deletes it if no
+// version is left.
+func (k *Keeper) updatePointerReverseIndex(ctx sdk.Context, pref []byte) {
+	k.markPointerRegistryWritten(ctx)
+	key := types.PointerReverseIndexKey(common.BytesToAddress(pref[len(types.PointerReverseRegistryPrefix):]))
+	pointee, version, exists := k.GetPointerInfo(ctx, pref)
+	if !exists {
+		ctx.KVStore(k.GetStoreKey()).Delete(key)
+		return
+	}
+	bz := make([]byte, 2, 2+len(pointee))
+	binary.BigEndian.PutUint16(bz, version)
+	ctx.KVStore(k.GetStoreKey()).Set(key, append(bz, pointee...))
+}
+
+// getPointerReverseIndex returns the pointee and version of the latest
+// registration of the pointer at addr with a single read. CW pointers are
+// keyed by their bech32 address truncated to an address length.
+func (k *Keeper) getPointerReverseIndex(ctx sdk.Context, addr common.Address) (pointee []byte, version uint16, exists bool) {
+	key := types.PointerReverseIndexKey(addr)
+	cached := k.pointerCacheUsable(ctx)
+	if cached {
+		if read, ok := k.pointerCache.get(ctx.BlockHeight(), key); ok {
+			consumeCachedReadGas(ctx, key, read.value)
+			if len(read.value) < 2 {
+				return nil, 0, false
+			}
+			return append([]byte{}, read.value[2:]...), binary.BigEndian.Uint16(read.value[:2]), true
+		}
+	}
+	bz := ctx.KVStore(k.GetStoreKey()).Get(key)
+	if cached {
+		k.pointerCache.set(ctx.BlockHeight(), key, cachedPointerRead{value: append([]byte{}, bz...), found: bz != nil})
+	}
+	if len(bz) < 2 {
+		return nil, 0, false
+	}
+	return bz[2:], binary.BigEndian.Uint16(bz[:2]), true
+}
+
+// RebuildPointerReverseIndex writes the reverse index entry of every pointer
+// in the reverse registry.
+func (k *Keeper) RebuildPointerReverseIndex(ctx sdk.Context) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.GetStoreKey()), types.PointerReverseRegistryPrefix)
+	prefs := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		// keys are the pointer address followed by the 2-byte version
+		if key := iter.Key(); len(key) == len(types.PointerReverseRegistryPrefix)+common.AddressLength+2 {
+			pref := key[:len(key)-2]
+			if len(prefs) == 0 || !bytes.Equal(prefs[len(prefs)-1], pref) {
+				prefs = append(prefs, append([]byte{}, pref...))
+			}
+		}
+	}
+	iter.Close()
+	for _, pref := range prefs {
+		k.updatePointerReverseIndex(ctx, pref)
+	}
 }
 
 func (k *Keeper) GetStoredPointerCodeID(ctx sdk.Context, pointerType types.PointerType) uint64 {
+	store, version, ok := k.pointerCodeIDStore(ctx, pointerType)
+	if !ok {
+		return 0
+	}
+	bz := store.Get(artifactsutils.GetVersionBz(version))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStoredPointerCodeID stores the code ID of the CW pointer code of the given
+// artifact version. Code IDs are kept per version, so those of earlier
+// versions stay available after the pointer code is upgraded.
+func (k *Keeper) SetStoredPointerCodeID(ctx sdk.Context, pointerType types.PointerType, version uint16, codeID uint64) {
+	store, _, ok := k.pointerCodeIDStore(ctx, pointerType)
+	if !ok {
+		panic(fmt.Sprintf("no CW pointer code for pointer type %s", pointerType))
+	}
+	store.Set(artifactsutils.GetVersionBz(version), artifactsutils.GetCodeIDBz(codeID))
+}
+
+// IteratePointerCodeIDs iterates over the code IDs of every stored version of
+// the CW pointer code of the given pointer type, in ascending version order.
+func (k *Keeper) IteratePointerCodeIDs(ctx sdk.Context, pointerType types.PointerType, cb func(version uint16, codeID uint64) bool) {
+	store, _, ok := k.pointerCodeIDStore(ctx, pointerType)
+	if !ok {
+		return
+	}
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint16(iter.Key()), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+// pointerCodeIDStore returns the store of CW pointer code IDs of the given
+// pointer type along with the current artifact version, if the pointer type
+// has CW pointer code.
+func (k *Keeper) pointerCodeIDStore(ctx sdk.Context, pointerType types.PointerType) (prefix.Store, uint16, bool) {
 	store := k.PrefixStore(ctx, types.PointerCWCodePrefix)
-	var versionBz []byte
 	switch pointerType {
 	case types.PointerType_ERC20:
-		store = prefix.NewStore(store, types.PointerCW20ERC20Prefix)
-		versionBz = artifactsutils.GetVersionBz(erc20.CurrentVersion)
+		return prefix.NewStore(store, types.PointerCW20ERC20Prefix), erc20.CurrentVersion, true
 	case types.PointerType_ERC721:
-		store = prefix.NewStore(store, types.PointerCW721ERC721Prefix)
-		versionBz = artifactsutils.GetVersionBz(erc721.CurrentVersion)
+		return prefix.NewStore(store, types.PointerCW721ERC721Prefix), erc721.CurrentVersion, true
 	case types.PointerType_ERC1155:
-		store = prefix.NewStore(store, types.PointerCW1155ERC1155Prefix)
-		versionBz = artifactsutils.GetVersionBz(erc1155.CurrentVersion)
+		return prefix.NewStore(store, types.PointerCW1155ERC1155Prefix), erc1155.CurrentVersion, true
 	default:
-		return 0
-	}
-	bz := store.Get(versionBz)
-	if bz == nil {
-		return 0
+		return prefix.Store{}, 0, false
 	}
-	return binary.BigEndian.Uint64(bz)
 }
 
 func (k *Keeper) GetCW20Pointee(ctx sdk.Context, erc20Address common.Address) (cw20Address string, version uint16, exists bool) {
-	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(erc20Address))
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, erc20Address)
 	if exists {
 		cw20Address = string(addrBz)
 	}
@@ -339,7 +801,7 @@ func (k *Keeper) GetCW20Pointee(ctx sdk.Context, erc20Address common.Address) (c
 }
 
 func (k *Keeper) GetCW721Pointee(ctx sdk.Context, erc721Address common.Address) (cw721Address string, version uint16, exists bool) {
-	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(erc721Address))
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, erc721Address)
 	if exists {
 		cw721Address = string(addrBz)
 	}
@@ -347,7 +809,7 @@ func (k *Keeper) GetCW721Pointee(ctx sdk.Context, erc721Address common.Address)
 }
 
 func (k *Keeper) GetCW1155Pointee(ctx sdk.Context, erc1155Address common.Address) (cw1155Address string, version uint16, exists bool) {
-	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(erc1155Address))
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, erc1155Address)
 	if exists {
 		cw1155Address = string(addrBz)
 	}
@@ -355,7 +817,7 @@ func (k *Keeper) GetCW1155Pointee(ctx sdk.Context, erc1155Address common.Address
 }
 
 func (k *Keeper) GetERC20Pointee(ctx sdk.Context, cw20Address string) (erc20Address common.Address, version uint16, exists bool) {
-	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(cw20Address))))
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, common.BytesToAddress([]byte(cw20Address)))
 	if exists {
 		erc20Address = common.BytesToAddress(addrBz)
 	}
@@ -363,7 +825,7 @@ func (k *Keeper) GetERC20Pointee(ctx sdk.Context, cw20Address string) (erc20Addr
 }
 
 func (k *Keeper) GetERC721Pointee(ctx sdk.Context, cw721Address string) (erc721Address common.Address, version uint16, exists bool) {
-	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(cw721Address))))
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, common.BytesToAddress([]byte(cw721Address)))
 	if exists {
 		erc721Address = common.BytesToAddress(addrBz)
 	}
@@ -371,7 +833,7 @@ func (k *Keeper) GetERC721Pointee(ctx sdk.Context, cw721Address string) (erc721A
 }
 
 func (k *Keeper) GetERC1155Pointee(ctx sdk.Context, cw1155Address string) (erc1155Address common.Address, version uint16, exists bool) {
-	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(cw1155Address))))
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, common.BytesToAddress([]byte(cw1155Address)))
 	if exists {
 		erc1155Address = common.BytesToAddress(addrBz)
 	}
@@ -379,11 +841,76 @@ func (k *Keeper) GetERC1155Pointee(ctx sdk.Context, cw1155Address string) (erc11
 }
 
 func (k *Keeper) GetNativePointee(ctx sdk.Context, erc20Address string) (token string, version uint16, exists bool) {
-	// Ensure the key matches how it was set in SetERC20NativePointer
-	key := types.PointerReverseRegistryKey(common.HexToAddress(erc20Address))
-	addrBz, version, exists := k.GetPointerInfo(ctx, key)
+	addrBz, version, exists := k.getPointerReverseIndex(ctx, common.HexToAddress(erc20Address))
 	if exists {
 		token = string(addrBz)
 	}
 	return
 }
+
+// RemovePointerFromRegistry deletes every version of the pointer registered for pointee
+// from both the forward and the reverse registry, along with its creation
+// info. The deployed pointer contract is left untouched. Unless
+// allowReregistration is set, the pointee is tombstoned so that no pointer of
+// the type can be registered for it again; otherwise its tombstone is lifted,
+// which is also allowed when no pointer is registered anymore.
+func (k *Keeper) RemovePointerFromRegistry(ctx sdk.Context, actor string, pointerType types.PointerType, pointee string, allowReregistration bool) (pointer string, err error) {
+	defer func() { k.RecordPointerOperation(pointerType, types.PointerOpRemove, err) }()
+	pointerKey, ok := PointerRegistryKey(pointerType, pointee)
+	if !ok {
+		return "", fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	pointerBz, _, exists := k.GetPointerInfo(ctx, pointerKey)
+	if !exists {
+		if allowReregistration && k.IsPointeeTombstoned(ctx, pointerKey) {
+			ctx.KVStore(k.GetStoreKey()).Delete(types.PointerTombstoneKey(pointerKey))
+			return "", nil
+		}
+		return "", fmt.Errorf("no %s pointer registered for %s", pointerType, pointee)
+	}
+	if pointerType == types.PointerType_ERC20 || pointerType == types.PointerType_ERC721 || pointerType == types.PointerType_ERC1155 {
+		pointer = string(pointerBz)
+	} else {
+		pointer = common.BytesToAddress(pointerBz).Hex()
+	}
+	// older versions may have been registered at other addresses
+	var versions []uint16
+	var addrs [][]byte
+	iter := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), pointerKey).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		versions = append(versions, binary.BigEndian.Uint16(iter.Key()))
+		addrs = append(addrs, iter.Value())
+	}
+	iter.Close()
+	for i, version := range versions {
+		k.deletePointerInfo(ctx, pointerKey, version)
+		k.deletePointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress(addrs[i])), version)
+	}
+	store := ctx.KVStore(k.GetStoreKey())
+	store.Delete(types.PointerCreationInfoKey(pointerKey))
+	if pointerType == types.PointerType_NATIVE {
+		store.Delete(types.NativePointerDecimalsFromMetadataKey(pointee))
+	}
+	if allowReregistration {
+		store.Delete(types.PointerTombstoneKey(pointerKey))
+	} else {
+		store.Set(types.PointerTombstoneKey(pointerKey), []byte{1})
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerRemoved, sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, pointer), sdk.NewAttribute(types.AttributeKeyPointee, pointee),
+		sdk.NewAttribute(types.AttributeKeyTombstoned, fmt.Sprintf("%t", !allowReregistration))))
+	emitPointerEvent(ctx, &types.EventPointerRemoved{
+		PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+		OldVersion: uint32(versions[len(versions)-1]), Actor: actor,
+	})
+	k.afterPointerRemoved(ctx, pointerType, pointee, pointer, versions[len(versions)-1])
+	return pointer, nil
+}
+
+// IsPointeeTombstoned returns whether the pointee of the forward registry key
+// pointerKey had its pointer removed by governance without allowing it to be
+// registered again.
+func (k *Keeper) IsPointeeTombstoned(ctx sdk.Context, pointerKey []byte) bool {
+	return ctx.KVStore(k.GetStoreKey()).Has(types.PointerTombstoneKey(pointerKey))
+}
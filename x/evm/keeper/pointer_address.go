@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+var pointerSaltPrefix = []byte("sei-pointer")
+
+// PointerSalt returns the salt an ERC pointer of pointee is deployed with:
+// keccak256("sei-pointer" ++ uint8(pointerType) ++ pointee ++
+// uint64(attempt)), with attempt big-endian. attempt counts the addresses
+// derived for the pointee before that were already taken, e.g. by a pointer
+// that has since been overridden.
+func PointerSalt(pointerType types.PointerType, pointee string, attempt uint64) common.Hash {
+	attemptBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(attemptBz, attempt)
+	return crypto.Keccak256Hash(pointerSaltPrefix, []byte{byte(pointerType)}, []byte(pointee), attemptBz)
+}
+
+// MaxPointerAddressAttempts bounds the addresses derived for a pointee before
+// a deployment gives up on finding one that is free.
+const MaxPointerAddressAttempts = 16
+
+// PointerInitCodeHash returns the init code hash ERC pointers of the artifact
+// type typ are addressed with: keccak256 of the artifact's creation code,
+// without constructor arguments. The arguments carry the pointee's metadata,
+// which may only be known at registration, so they're left out for the
+// address to be predictable before it.
+func PointerInitCodeHash(typ string) common.Hash {
+	return crypto.Keccak256Hash(artifacts.GetBin(typ))
+}
+
+// ERCPointerAddress returns the address an ERC pointer deployed by deployer
+// with salt and initCodeHash lives at, i.e. the CREATE2 address
+// keccak256(0xff ++ deployer ++ salt ++ initCodeHash)[12:].
+func ERCPointerAddress(deployer common.Address, salt common.Hash, initCodeHash common.Hash) common.Address {
+	return crypto.CreateAddress2(deployer, salt, initCodeHash.Bytes())
+}
+
+// nextERCPointerAddress returns the first address derived for pointee that a
+// deployment wouldn't collide with, i.e. that has neither a nonce nor code.
+func nextERCPointerAddress(db vm.StateDB, deployer common.Address, pointerType types.PointerType, pointee string) (common.Address, error) {
+	initCodeHash := PointerInitCodeHash(ercPointerArtifactTypes[pointerType])
+	for attempt := uint64(0); attempt < MaxPointerAddressAttempts; attempt++ {
+		addr := ERCPointerAddress(deployer, PointerSalt(pointerType, pointee, attempt), initCodeHash)
+		codeHash := db.GetCodeHash(addr)
+		if db.GetNonce(addr) == 0 && (codeHash == (common.Hash{}) || codeHash == ethtypes.EmptyCodeHash) {
+			return addr, nil
+		}
+	}
+	return common.Address{}, fmt.Errorf("no free pointer address for %s after %d attempts", pointee, MaxPointerAddressAttempts)
+}
+
+func (k *Keeper) evmModuleAddress(ctx sdk.Context) common.Address {
+	return k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+}
+
+// GetPredictedPointerAddress returns the address a pointer of pointee registered
+// in the current state would get, along with whether it depends on the number
+// of CW contracts instantiated before it. It writes nothing. The address of
+// a pointer that is already registered is returned as is.
+func (k *Keeper) GetPredictedPointerAddress(ctx sdk.Context, pointerType types.PointerType, pointee string) (addr string, registered bool, instanceDependent bool, err error) {
+	if err := validatePointee(pointerType, pointee); err != nil {
+		return "", false, false, err
+	}
+	pointerKey, _ := PointerRegistryKey(pointerType, pointee)
+	if pointerBz, _, exists := k.GetPointerInfo(ctx, pointerKey); exists {
+		return pointerAddressString(pointerType, pointerBz), true, false, nil
+	}
+	if isCWPointerType(pointerType) {
+		// sei-wasmd has no instantiate2, so a CW pointer gets the address of
+		// the next contract instance
+		instanceID := k.wasmViewKeeper.PeekAutoIncrementID(ctx, wasmtypes.KeyLastInstanceID)
+		return wasmkeeper.BuildContractAddress(k.GetStoredPointerCodeID(ctx, pointerType), instanceID).String(), false, true, nil
+	}
+	db := state.NewDBImpl(ctx, k, true)
+	pointer, err := nextERCPointerAddress(db, k.evmModuleAddress(ctx), pointerType, pointee)
+	if err != nil {
+		return "", false, false, err
+	}
+	return pointer.Hex(), false, false, nil
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// pointerCache memoizes pointer registry reads for the block being executed,
+// as long as no pointer has been registered, upgraded or removed in that
+// block. Every registry write records the block height in the transient
+// store, which every cached lookup reads, so that under parallel execution a
+// transaction that read from the cache conflicts with one writing the
+// registry. The cache is in memory only and never affects state or gas.
+type pointerCache struct {
+	mtx sync.RWMutex
+	// the height of the block being executed, if any; lookups at any other
+	// height, e.g. queries against committed state, read the store
+	height int64
+	// keyed by the store key read: the registry key of a pointer, or the
+	// reverse index key of a pointer address
+	reads map[string]cachedPointerRead
+}
+
+type cachedPointerRead struct {
+	// for registry lookups, the full key of the latest version found
+	key   []byte
+	value []byte
+	found bool
+}
+
+func newPointerCache() *pointerCache {
+	return &pointerCache{reads: map[string]cachedPointerRead{}}
+}
+
+func (c *pointerCache) open(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.height = height
+	c.reads = map[string]cachedPointerRead{}
+}
+
+func (c *pointerCache) openHeight() int64 {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.height
+}
+
+func (c *pointerCache) get(height int64, key []byte) (cachedPointerRead, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if height != c.height {
+		return cachedPointerRead{}, false
+	}
+	read, ok := c.reads[string(key)]
+	return read, ok
+}
+
+func (c *pointerCache) set(height int64, key []byte, read cachedPointerRead) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if height != c.height {
+		return
+	}
+	c.reads[string(key)] = read
+}
+
+func (c *pointerCache) clear() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.reads = map[string]cachedPointerRead{}
+}
+
+// OpenPointerCache starts caching pointer lookups made at the height of the
+// block that begins in ctx, dropping those of the previous block.
+func (k *Keeper) OpenPointerCache(ctx sdk.Context) {
+	if k.pointerCache != nil {
+		k.pointerCache.open(ctx.BlockHeight())
+	}
+}
+
+// ClosePointerCache stops caching pointer lookups once the block in ctx has
+// been executed, so that queries against the state it commits read the store.
+func (k *Keeper) ClosePointerCache(sdk.Context) {
+	if k.pointerCache != nil {
+		k.pointerCache.open(0)
+	}
+}
+
+// pointerCacheUsable returns whether pointer lookups in ctx may go through the
+// cache. CheckTx, contexts outside the block being executed and blocks that
+// have written the registry, as seen from ctx, bypass it.
+func (k *Keeper) pointerCacheUsable(ctx sdk.Context) bool {
+	if k.pointerCache == nil || ctx.IsCheckTx() || ctx.IsReCheckTx() || ctx.BlockHeight() == 0 || ctx.BlockHeight() != k.pointerCache.openHeight() {
+		return false
+	}
+	bz := ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).TransientStore(k.transientStoreKey).Get(types.PointerRegistryWrittenKey)
+	return len(bz) != 8 || int64(binary.BigEndian.Uint64(bz)) != ctx.BlockHeight()
+}
+
+// markPointerRegistryWritten records that the pointer registry has been
+// written in the block being executed, without charging gas, and drops the
+// cached lookups. Writes outside that block can't affect the cache.
+func (k *Keeper) markPointerRegistryWritten(ctx sdk.Context) {
+	if k.pointerCache == nil || ctx.BlockHeight() == 0 || ctx.BlockHeight() != k.pointerCache.openHeight() {
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).TransientStore(k.transientStoreKey).Set(types.PointerRegistryWrittenKey, bz)
+	k.pointerCache.clear()
+}
+
+// consumeCachedSeekGas charges the gas of opening an iterator that found
+// read, or nothing.
+func consumeCachedSeekGas(ctx sdk.Context, read cachedPointerRead) {
+	gasConfig := storetypes.KVGasConfig()
+	if read.found {
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(read.key)), storetypes.GasValuePerByteDesc)
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(read.value)), storetypes.GasValuePerByteDesc)
+	}
+	ctx.GasMeter().ConsumeGas(gasConfig.IterNextCostFlat, storetypes.GasIterNextCostFlatDesc)
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// countingMultiStore counts the reads of the store under key.
+type countingMultiStore struct {
+	sdk.MultiStore
+	key   sdk.StoreKey
+	reads *int
+}
+
+func (ms countingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	if key != ms.key {
+		return ms.MultiStore.GetKVStore(key)
+	}
+	return countingKVStore{KVStore: ms.MultiStore.GetKVStore(key), reads: ms.reads}
+}
+
+type countingKVStore struct {
+	sdk.KVStore
+	reads *int
+}
+
+func (s countingKVStore) Get(key []byte) []byte {
+	*s.reads++
+	return s.KVStore.Get(key)
+}
+
+func (s countingKVStore) Has(key []byte) bool {
+	*s.reads++
+	return s.KVStore.Has(key)
+}
+
+func (s countingKVStore) Iterator(start, end []byte) sdk.Iterator {
+	*s.reads++
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s countingKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	*s.reads++
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func TestPointerCache(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	_, pointer := testkeeper.MockAddressPair()
+	_, notPointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ufoo", pointer))
+	ctx = ctx.WithBlockHeight(9)
+	k.OpenPointerCache(ctx)
+
+	// lookups cost the same whether they are cached or not
+	gasUsed := func(lookup func(sdk.Context)) uint64 {
+		c := ctx.WithGasMeter(sdk.NewGasMeter(1000000, 1, 1))
+		lookup(c)
+		return c.GasMeter().GasConsumed()
+	}
+	for _, lookup := range []func(sdk.Context){
+		func(c sdk.Context) { k.GetERC20NativePointer(c, "ufoo") },
+		func(c sdk.Context) { k.GetERC20NativePointer(c, "ubar") },
+		func(c sdk.Context) { k.GetNativePointee(c, pointer.Hex()) },
+		func(c sdk.Context) { k.GetCW20Pointee(c, notPointer) },
+	} {
+		require.Equal(t, gasUsed(lookup), gasUsed(lookup))
+	}
+
+	// cached lookups don't read the store
+	reads := 0
+	counted := ctx.WithMultiStore(countingMultiStore{MultiStore: ctx.MultiStore(), key: k.GetStoreKey(), reads: &reads})
+	addr, _, exists := k.GetERC20NativePointer(counted, "ufoo")
+	require.True(t, exists)
+	require.Equal(t, pointer, addr)
+	token, _, exists := k.GetNativePointee(counted, pointer.Hex())
+	require.True(t, exists)
+	require.Equal(t, "ufoo", token)
+	_, _, exists = k.GetCW20Pointee(counted, notPointer)
+	require.False(t, exists)
+	require.Equal(t, 0, reads)
+
+	// CheckTx and other heights, e.g. queries, read the store
+	k.GetERC20NativePointer(counted.WithIsCheckTx(true), "ufoo")
+	k.GetERC20NativePointer(counted.WithBlockHeight(8), "ufoo")
+	require.Equal(t, 2, reads)
+
+	// writing the registry stops caching for the rest of the block
+	_, other := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ubar", other))
+	addr, _, exists = k.GetERC20NativePointer(counted, "ubar")
+	require.True(t, exists)
+	require.Equal(t, other, addr)
+	k.GetERC20NativePointer(counted, "ufoo")
+	require.Equal(t, 4, reads)
+
+	// a new block caches again, until it's closed
+	ctx = ctx.WithBlockHeight(10)
+	counted = counted.WithBlockHeight(10)
+	k.OpenPointerCache(ctx)
+	k.GetERC20NativePointer(counted, "ubar")
+	k.GetERC20NativePointer(counted, "ubar")
+	require.Equal(t, 5, reads)
+	k.ClosePointerCache(ctx)
+	k.GetERC20NativePointer(counted, "ubar")
+	require.Equal(t, 6, reads)
+
+	// removing a pointer is seen by later lookups of the block
+	ctx = ctx.WithBlockHeight(11)
+	k.OpenPointerCache(ctx)
+	_, _, exists = k.GetERC20NativePointer(ctx, "ubar")
+	require.True(t, exists)
+	_, err := k.RemovePointerFromRegistry(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName).String(), types.PointerType_NATIVE, "ubar", true)
+	require.Nil(t, err)
+	_, _, exists = k.GetERC20NativePointer(ctx, "ubar")
+	require.False(t, exists)
+	_, _, exists = k.GetNativePointee(ctx, other.Hex())
+	require.False(t, exists)
+}
+
+// BenchmarkPointerTransfersInBlock resolves the pointer, sender and recipient
+// of 500 transfers through one native pointer in a block, and reports the
+// store reads it takes.
+func BenchmarkPointerTransfersInBlock(b *testing.B) {
+	const transfers = 500
+	for _, cached := range []bool{false, true} {
+		b.Run(fmt.Sprintf("cached=%t", cached), func(b *testing.B) {
+			k, ctx := testkeeper.MockEVMKeeper()
+			_, pointer := testkeeper.MockAddressPair()
+			if err := k.SetERC20NativePointer(ctx, "ufoo", pointer); err != nil {
+				b.Fatal(err)
+			}
+			senders := make([]common.Address, 10)
+			for i := range senders {
+				var seiAddr sdk.AccAddress
+				seiAddr, senders[i] = testkeeper.MockAddressPair()
+				k.SetAddressMapping(ctx, seiAddr, senders[i])
+			}
+			reads := 0
+			ctx = ctx.WithMultiStore(countingMultiStore{MultiStore: ctx.MultiStore(), key: k.GetStoreKey(), reads: &reads})
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+				if cached {
+					k.OpenPointerCache(ctx)
+				}
+				for j := 0; j < transfers; j++ {
+					if _, _, exists := k.GetNativePointee(ctx, pointer.Hex()); !exists {
+						b.Fatal("pointee not found")
+					}
+					k.GetSeiAddressOrDefault(ctx, senders[j%len(senders)])
+					k.GetSeiAddressOrDefault(ctx, senders[(j+1)%len(senders)])
+				}
+				k.ClosePointerCache(ctx)
+			}
+			b.ReportMetric(float64(reads)/float64(b.N), "reads/block")
+		})
+	}
+}
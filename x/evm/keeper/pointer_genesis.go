@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// ExportPointerRegistries returns the forward registry of every pointer type
+// that has registered pointers.
+func (k *Keeper) ExportPointerRegistries(ctx sdk.Context) []*types.PointerRegistry {
+	registries := []*types.PointerRegistry{}
+	for i := 0; i < len(types.PointerType_name); i++ {
+		pointerType := types.PointerType(i)
+		store, ok := k.PointerRegistryStore(ctx, pointerType)
+		if !ok {
+			continue
+		}
+		registry := &types.PointerRegistry{PointerType: pointerType}
+		iter := store.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			entry, err := DecodePointerRegistryEntry(pointerType, iter.Key(), iter.Value())
+			if err != nil {
+				panic(err)
+			}
+			registry.Entries = append(registry.Entries, entry)
+		}
+		iter.Close()
+		if len(registry.Entries) > 0 {
+			registries = append(registries, registry)
+		}
+	}
+	return registries
+}
+
+// ImportPointerRegistry registers the pointers of a registry exported by
+// ExportPointerRegistries, rebuilding both the forward and the reverse
+// registry.
+func (k *Keeper) ImportPointerRegistry(ctx sdk.Context, registry *types.PointerRegistry) {
+	for _, entry := range registry.Entries {
+		if err := k.importPointer(ctx, registry.PointerType, entry); err != nil {
+			panic(fmt.Sprintf("failed to import %s pointer %s of %s: %s", registry.PointerType, entry.Pointer, entry.Pointee, err))
+		}
+	}
+}
+
+func (k *Keeper) importPointer(ctx sdk.Context, pointerType types.PointerType, entry *types.PointerEntry) error {
+	pointer := []byte(entry.Pointer)
+	if !isCWPointerType(pointerType) {
+		pointer = common.HexToAddress(entry.Pointer).Bytes()
+	}
+	return k.writePointer(ctx, pointerType, entry.Pointee, pointer, uint16(entry.Version))
+}
+
+// ExportPointerCodeIDs returns the stored CW pointer code IDs of every pointer
+// type that has CW pointer code.
+func (k *Keeper) ExportPointerCodeIDs(ctx sdk.Context) []*types.PointerCodeIDs {
+	res := []*types.PointerCodeIDs{}
+	for i := 0; i < len(types.PointerType_name); i++ {
+		codeIDs := &types.PointerCodeIDs{PointerType: types.PointerType(i)}
+		k.IteratePointerCodeIDs(ctx, codeIDs.PointerType, func(version uint16, codeID uint64) bool {
+			codeIDs.CodeIds = append(codeIDs.CodeIds, &types.PointerCodeID{Version: uint32(version), CodeId: codeID})
+			return false
+		})
+		if len(codeIDs.CodeIds) > 0 {
+			res = append(res, codeIDs)
+		}
+	}
+	return res
+}
+
+func (k *Keeper) ImportPointerCodeIDs(ctx sdk.Context, codeIDs *types.PointerCodeIDs) {
+	for _, codeID := range codeIDs.CodeIds {
+		k.SetStoredPointerCodeID(ctx, codeIDs.PointerType, uint16(codeID.Version), codeID.CodeId)
+	}
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// DefaultPointerRegistrationsToPrune bounds the number of log entries pruned
+// per block, so that lowering the retention doesn't prune a large backlog at
+// once.
+const DefaultPointerRegistrationsToPrune = 100
+
+// appendPointerRegistration records the registration of a pointer under the
+// forward registry key pointerKey in the pointer registration log.
+func (k *Keeper) appendPointerRegistration(ctx sdk.Context, pointerKey []byte, pointer []byte, version uint16) error {
+	typeIdx := len(types.PointerRegistryPrefix)
+	pointerType, ok := pointerTypeOfRegistryTypePrefix(pointerKey[typeIdx : typeIdx+1])
+	if !ok {
+		return nil
+	}
+	versionBz := make([]byte, 2)
+	binary.BigEndian.PutUint16(versionBz, version)
+	entry, err := DecodePointerRegistryEntry(pointerType, append(append([]byte{}, pointerKey[typeIdx+1:]...), versionBz...), pointer)
+	if err != nil {
+		return err
+	}
+	bz, err := (&types.PointerRegistration{
+		PointerType: pointerType,
+		Pointee:     entry.Pointee,
+		Pointer:     entry.Pointer,
+		Version:     entry.Version,
+		Height:      ctx.BlockHeight(),
+	}).Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.GetStoreKey()).Set(types.PointerRegistrationLogKey(ctx.BlockHeight(), pointerKey, version), bz)
+	return nil
+}
+
+func pointerTypeOfRegistryTypePrefix(typePrefix []byte) (types.PointerType, bool) {
+	for i := 0; i < len(types.PointerType_name); i++ {
+		if p, ok := pointerRegistryTypePrefix(types.PointerType(i)); ok && bytes.Equal(p, typePrefix) {
+			return types.PointerType(i), true
+		}
+	}
+	return 0, false
+}
+
+// GetPointerRegistrationLogPrunedBefore returns the height below which
+// registrations are missing from the pointer registration log, either because
+// they have been pruned or because they predate the log.
+func (k *Keeper) GetPointerRegistrationLogPrunedBefore(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.GetStoreKey()).Get(types.PointerRegistrationLogPrunedBeforeKey)
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k *Keeper) SetPointerRegistrationLogPrunedBefore(ctx sdk.Context, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(k.GetStoreKey()).Set(types.PointerRegistrationLogPrunedBeforeKey, bz)
+}
+
+// PrunePointerRegistrationLog removes up to n log entries that fall outside
+// of the retention window, oldest first.
+func (k *Keeper) PrunePointerRegistrationLog(ctx sdk.Context, n int) {
+	retention := k.GetPointerRegistrationLogRetention(ctx)
+	if n <= 0 || retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+	cutoff := ctx.BlockHeight() - int64(retention) + 1
+	prunedBefore := k.GetPointerRegistrationLogPrunedBefore(ctx)
+	if prunedBefore >= cutoff {
+		return
+	}
+	store := ctx.KVStore(k.GetStoreKey())
+	iter := store.Iterator(types.PointerRegistrationLogPrefix, types.PointerRegistrationLogHeightPrefix(cutoff))
+	defer iter.Close()
+	keysToDelete := make([][]byte, 0, n)
+	for ; n > 0 && iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+		n--
+	}
+	if iter.Valid() {
+		// the height of the last deleted entry may still have entries left
+		lastKey := keysToDelete[len(keysToDelete)-1]
+		cutoff = int64(binary.BigEndian.Uint64(lastKey[len(types.PointerRegistrationLogPrefix):])) + 1
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+	if cutoff > prunedBefore {
+		k.SetPointerRegistrationLogPrunedBefore(ctx, cutoff)
+	}
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc20"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPointersSince(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	_, pointer1 := testkeeper.MockAddressPair()
+	_, pointer2 := testkeeper.MockAddressPair()
+	cwPointer, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx.WithBlockHeight(100), "ufoo", pointer1, native.CurrentVersion))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx.WithBlockHeight(101), erc20Addr, cwPointer.String()))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx.WithBlockHeight(102), "ufoo", pointer1, native.CurrentVersion+1))
+	require.Nil(t, k.SetERC20NativePointer(ctx.WithBlockHeight(102), "ubar", pointer2))
+
+	ctx = ctx.WithBlockHeight(102)
+	res, err := q.PointersSince(sdk.WrapSDKContext(ctx), &types.QueryPointersSinceRequest{StartHeight: 101})
+	require.Nil(t, err)
+	require.Equal(t, int64(0), res.PrunedBefore)
+	require.Equal(t, []*types.PointerRegistration{
+		{PointerType: types.PointerType_ERC20, Pointee: erc20Addr.Hex(), Pointer: cwPointer.String(), Version: uint32(erc20.CurrentVersion), Height: 101},
+		{PointerType: types.PointerType_NATIVE, Pointee: "ubar", Pointer: pointer2.Hex(), Version: uint32(native.CurrentVersion), Height: 102},
+		{PointerType: types.PointerType_NATIVE, Pointee: "ufoo", Pointer: pointer1.Hex(), Version: uint32(native.CurrentVersion + 1), Height: 102},
+	}, res.Registrations)
+
+	// paging resumes after the last returned entry
+	res, err = q.PointersSince(sdk.WrapSDKContext(ctx), &types.QueryPointersSinceRequest{StartHeight: 100, Pagination: &query.PageRequest{Limit: 2}})
+	require.Nil(t, err)
+	require.Len(t, res.Registrations, 2)
+	require.Equal(t, int64(100), res.Registrations[0].Height)
+	res, err = q.PointersSince(sdk.WrapSDKContext(ctx), &types.QueryPointersSinceRequest{StartHeight: 100, Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.Nil(t, err)
+	require.Len(t, res.Registrations, 2)
+	require.Equal(t, int64(102), res.Registrations[0].Height)
+
+	// entries older than the retention are pruned, at most n per block
+	params := k.GetParams(ctx)
+	params.PointerRegistrationLogRetention = 1
+	k.SetParams(ctx, params)
+	k.PrunePointerRegistrationLog(ctx.WithBlockHeight(102), 1)
+	res, err = q.PointersSince(sdk.WrapSDKContext(ctx), &types.QueryPointersSinceRequest{})
+	require.Nil(t, err)
+	require.Equal(t, int64(101), res.PrunedBefore)
+	require.Len(t, res.Registrations, 3)
+	k.PrunePointerRegistrationLog(ctx.WithBlockHeight(102), 100)
+	res, err = q.PointersSince(sdk.WrapSDKContext(ctx), &types.QueryPointersSinceRequest{})
+	require.Nil(t, err)
+	require.Equal(t, int64(102), res.PrunedBefore)
+	require.Len(t, res.Registrations, 2)
+}
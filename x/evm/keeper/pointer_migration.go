@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// DefaultPointersToMigrate bounds the number of pointer registry entries
+// examined per block by scheduled pointer migrations.
+const DefaultPointersToMigrate = 20
+
+// pointerMigrationStart is the cursor of a scheduled migration that hasn't
+// started yet. It sorts before every registry key, which is a pointee followed
+// by a 2-byte version.
+var pointerMigrationStart = []byte{0x00}
+
+// SchedulePointerMigration schedules the pointers of pointerType to be
+// migrated to their latest version over the following blocks, unless a
+// migration of that type is already in progress.
+func (k *Keeper) SchedulePointerMigration(ctx sdk.Context, pointerType types.PointerType) error {
+	if _, ok := pointerRegistryTypePrefix(pointerType); !ok {
+		return fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	store := ctx.KVStore(k.GetStoreKey())
+	if !store.Has(types.PointerMigrationCursorKey(pointerType)) {
+		store.Set(types.PointerMigrationCursorKey(pointerType), pointerMigrationStart)
+	}
+	return nil
+}
+
+// MigratePointersToLatest upgrades the pointers of pointerType that are below
+// the current artifact version, examining up to limit registry entries from
+// where the previous call stopped. A pointer that fails to upgrade is skipped
+// and reported in a pointer_migration_failed event rather than failing the
+// migration. done is set once every entry has been examined, after which the
+// next call starts over.
+func (k *Keeper) MigratePointersToLatest(ctx sdk.Context, pointerType types.PointerType, limit int) (migrated int, failed int, done bool, err error) {
+	registry, ok := k.PointerRegistryStore(ctx, pointerType)
+	if !ok {
+		return 0, 0, false, fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	store := ctx.KVStore(k.GetStoreKey())
+	cursor := store.Get(types.PointerMigrationCursorKey(pointerType))
+	if cursor == nil {
+		cursor = pointerMigrationStart
+	}
+	currentVersion := k.currentPointerVersion(ctx, pointerType)
+	// collected before upgrading, since upgrades write to the registry
+	pointees := []string{}
+	iter := registry.Iterator(cursor, nil)
+	for ; limit > 0 && iter.Valid(); iter.Next() {
+		if string(iter.Key()) == string(cursor) {
+			continue
+		}
+		cursor = append([]byte{}, iter.Key()...)
+		limit--
+		entry, err := DecodePointerRegistryEntry(pointerType, iter.Key(), iter.Value())
+		if err != nil {
+			continue
+		}
+		pointerKey, _ := PointerRegistryKey(pointerType, entry.Pointee)
+		// older versions of a pointer stay in the registry; only its latest
+		// one is upgraded
+		if _, latestVersion, _ := k.GetPointerInfo(ctx, pointerKey); uint16(entry.Version) == latestVersion && latestVersion < currentVersion {
+			pointees = append(pointees, entry.Pointee)
+		}
+	}
+	done = !iter.Valid()
+	iter.Close()
+
+	actor := k.AccountKeeper().GetModuleAddress(types.ModuleName).String()
+	for _, pointee := range pointees {
+		if _, _, _, err := k.UpgradePointerToCurrentVersion(ctx, actor, pointerType, pointee); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to migrate %s pointer of %s: %s", pointerType, pointee, err))
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePointerMigrationFailed,
+				sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+				sdk.NewAttribute(types.AttributeKeyPointee, pointee),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error())))
+			failed++
+			continue
+		}
+		migrated++
+	}
+	if done {
+		store.Delete(types.PointerMigrationCursorKey(pointerType))
+	} else {
+		store.Set(types.PointerMigrationCursorKey(pointerType), cursor)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePointerMigrationSummary,
+		sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyMigrated, fmt.Sprintf("%d", migrated)),
+		sdk.NewAttribute(types.AttributeKeyFailed, fmt.Sprintf("%d", failed)),
+		sdk.NewAttribute(types.AttributeKeyDone, fmt.Sprintf("%t", done))))
+	return migrated, failed, done, nil
+}
+
+// MigrateScheduledPointers continues the earliest scheduled pointer migration,
+// examining up to n registry entries. Scheduled types are migrated one after
+// the other.
+func (k *Keeper) MigrateScheduledPointers(ctx sdk.Context, n int) {
+	store := ctx.KVStore(k.GetStoreKey())
+	iter := sdk.KVStorePrefixIterator(store, types.PointerMigrationCursorPrefix)
+	if !iter.Valid() {
+		iter.Close()
+		return
+	}
+	pointerType := types.PointerType(binary.BigEndian.Uint32(iter.Key()[len(types.PointerMigrationCursorPrefix):]))
+	iter.Close()
+	if _, _, _, err := k.MigratePointersToLatest(ctx, pointerType, n); err != nil {
+		// a type this binary doesn't know can't be migrated
+		store.Delete(types.PointerMigrationCursorKey(pointerType))
+	}
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+func TestMigratePointersToLatest(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	outdated := native.CurrentVersion - 1
+	for _, denom := range []string{"ua", "ub", "uc", "ud"} {
+		require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+			addr, err := k.UpsertERCNativePointer(ctx, e, denom, utils.ERCMetadata{Name: denom, Symbol: denom, Decimals: 6})
+			if err != nil || denom == "ud" {
+				return err
+			}
+			k.DeleteERC20NativePointer(ctx, denom, native.CurrentVersion)
+			return k.SetERC20NativePointerWithVersion(ctx, denom, addr, outdated)
+		}, func(string, string) {}))
+	}
+	// a pointer without code can't be upgraded
+	_, broken := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ue", broken, outdated))
+
+	require.Nil(t, k.SchedulePointerMigration(ctx, types.PointerType_NATIVE))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.MigrateScheduledPointers(ctx, 2)
+	// ua and ub
+	for denom, version := range map[string]uint16{"ua": native.CurrentVersion, "ub": native.CurrentVersion, "uc": outdated} {
+		_, v, _ := k.GetERC20NativePointer(ctx, denom)
+		require.Equal(t, version, v, denom)
+	}
+	require.True(t, ctx.KVStore(k.GetStoreKey()).Has(types.PointerMigrationCursorKey(types.PointerType_NATIVE)))
+
+	// uc, then ud which is already current, then ue which fails. Entries
+	// written by upgrades are examined too.
+	calls := 1
+	for ; calls < 10 && ctx.KVStore(k.GetStoreKey()).Has(types.PointerMigrationCursorKey(types.PointerType_NATIVE)); calls++ {
+		k.MigrateScheduledPointers(ctx, 2)
+	}
+	require.False(t, ctx.KVStore(k.GetStoreKey()).Has(types.PointerMigrationCursorKey(types.PointerType_NATIVE)))
+	for _, denom := range []string{"ua", "ub", "uc", "ud"} {
+		_, v, _ := k.GetERC20NativePointer(ctx, denom)
+		require.Equal(t, native.CurrentVersion, v, denom)
+	}
+	addr, v, _ := k.GetERC20NativePointer(ctx, "ue")
+	require.Equal(t, outdated, v)
+	require.Equal(t, broken, addr)
+
+	failures, summaries := []string{}, 0
+	for _, e := range ctx.EventManager().Events() {
+		switch e.Type {
+		case types.EventTypePointerMigrationFailed:
+			failures = append(failures, string(e.Attributes[1].Value))
+		case types.EventTypePointerMigrationSummary:
+			summaries++
+		}
+	}
+	require.Equal(t, []string{"ue"}, failures)
+	require.Equal(t, calls, summaries)
+
+	// a type without pointers is done right away
+	migrated, failed, done, err := k.MigratePointersToLatest(ctx, types.PointerType_CW20, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, migrated)
+	require.Equal(t, 0, failed)
+	require.True(t, done)
+
+	_, _, _, err = k.MigratePointersToLatest(ctx, types.PointerType(100), 10)
+	require.NotNil(t, err)
+	require.NotNil(t, k.SchedulePointerMigration(ctx, types.PointerType(100)))
+}
+
+func TestMigratePointersToLatestSkipsOlderVersions(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	var addr common.Address
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) (err error) {
+		addr, err = k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "foo", Symbol: "FOO", Decimals: 6})
+		return
+	}, func(string, string) {}))
+	// the registry keeps the entry of an older version next to the current one
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", addr, native.CurrentVersion-1))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", addr, native.CurrentVersion))
+	migrated, failed, done, err := k.MigratePointersToLatest(ctx, types.PointerType_NATIVE, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, migrated)
+	require.Equal(t, 0, failed)
+	require.True(t, done)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// OverridePointerInRegistry replaces the pointer registered for pointee with
+// newPointer, or with a pointer newly deployed from the current artifact if
+// newPointer is empty, and registers it at the version after the current one.
+// A newly deployed ERC pointer gets the metadata of the old one. Every earlier
+// version of the pointer is removed from both the forward and the reverse
+// registry, so that the old addresses no longer resolve to the pointee. The
+// deployed contracts are left untouched, and nothing is written unless the
+// whole override succeeds.
+func (k *Keeper) OverridePointerInRegistry(ctx sdk.Context, actor string, pointerType types.PointerType, pointee string, newPointer string) (oldPointer string, pointer string, oldVersion uint16, newVersion uint16, err error) {
+	defer func() { k.RecordPointerOperation(pointerType, types.PointerOpOverride, err) }()
+	pointerKey, ok := PointerRegistryKey(pointerType, pointee)
+	if !ok {
+		return "", "", 0, 0, fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	oldPointerBz, oldVersion, exists := k.GetPointerInfo(ctx, pointerKey)
+	if !exists {
+		return "", "", 0, 0, fmt.Errorf("no %s pointer registered for %s", pointerType, pointee)
+	}
+	if oldVersion == math.MaxUint16 {
+		return "", "", 0, 0, fmt.Errorf("pointer for %s is at the last version", pointee)
+	}
+	newVersion = oldVersion + 1
+	cwPointer := isCWPointerType(pointerType)
+	pointeeBz := []byte(pointee)
+	if cwPointer {
+		oldPointer = string(oldPointerBz)
+		pointeeBz = common.HexToAddress(pointee).Bytes()
+	} else {
+		oldPointer = common.BytesToAddress(oldPointerBz).Hex()
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	var pointerBz []byte
+	switch {
+	case newPointer != "":
+		pointerBz, err = k.validateOverridingPointer(cacheCtx, pointerType, newPointer)
+	case cwPointer:
+		var addr sdk.AccAddress
+		addr, err = k.instantiateCWPointer(cacheCtx, pointerType, pointee)
+		pointerBz = []byte(addr.String())
+	default:
+		var addr common.Address
+		addr, err = k.deployERCPointer(cacheCtx, pointerType, pointee, common.BytesToAddress(oldPointerBz))
+		pointerBz = addr.Bytes()
+	}
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	if cwPointer {
+		pointer = string(pointerBz)
+	} else {
+		pointer = common.BytesToAddress(pointerBz).Hex()
+	}
+
+	// older versions may have been registered at other addresses
+	var versions []uint16
+	var addrs [][]byte
+	iter := prefix.NewStore(cacheCtx.KVStore(k.GetStoreKey()), pointerKey).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		versions = append(versions, binary.BigEndian.Uint16(iter.Key()))
+		addrs = append(addrs, iter.Value())
+	}
+	iter.Close()
+	// the new version is written first so that the pointee never appears to
+	// have no pointer
+	if err := k.setPointerInfo(cacheCtx, pointerKey, pointerBz, newVersion); err != nil {
+		return "", "", 0, 0, err
+	}
+	for i, version := range versions {
+		k.deletePointerInfo(cacheCtx, pointerKey, version)
+		k.deletePointerInfo(cacheCtx, types.PointerReverseRegistryKey(common.BytesToAddress(addrs[i])), version)
+	}
+	if err := k.setPointerInfo(cacheCtx, types.PointerReverseRegistryKey(common.BytesToAddress(pointerBz)), pointeeBz, newVersion); err != nil {
+		return "", "", 0, 0, err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerOverridden, sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyPointee, pointee),
+		sdk.NewAttribute(types.AttributeKeyOldPointer, oldPointer), sdk.NewAttribute(types.AttributeKeyPointerAddress, pointer),
+		sdk.NewAttribute(types.AttributeKeyOldVersion, fmt.Sprintf("%d", oldVersion)),
+		sdk.NewAttribute(types.AttributeKeyNewVersion, fmt.Sprintf("%d", newVersion))))
+	emitPointerEvent(ctx, &types.EventPointerOverridden{
+		PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+		OldVersion: uint32(oldVersion), NewVersion: uint32(newVersion), Actor: actor, OldPointer: oldPointer,
+	})
+	k.afterPointerUpgraded(ctx, pointerType, pointee, pointer, newVersion)
+	return oldPointer, pointer, oldVersion, newVersion, nil
+}
+
+// validateOverridingPointer checks that pointer is a contract of the kind that
+// pointers of pointerType are, i.e. a CW contract for the pointers of ERC
+// contracts and an EVM contract otherwise, and that it isn't a pointer yet. It
+// returns the address as it is stored in the registry.
+func (k *Keeper) validateOverridingPointer(ctx sdk.Context, pointerType types.PointerType, pointer string) ([]byte, error) {
+	if _, ok := k.IsAnyPointer(ctx, pointer); ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already a pointer", pointer)
+	}
+	if isCWPointerType(pointerType) {
+		addr, err := sdk.AccAddressFromBech32(pointer)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pointer address %s", pointer)
+		}
+		if k.wasmViewKeeper.GetContractInfo(ctx, addr) == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no CW contract at %s", pointer)
+		}
+		return []byte(pointer), nil
+	}
+	if !common.IsHexAddress(pointer) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pointer address %s", pointer)
+	}
+	addr := common.HexToAddress(pointer)
+	if k.GetCodeSize(ctx, addr) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no contract deployed at %s", pointer)
+	}
+	return addr.Bytes(), nil
+}
+
+// deployERCPointer deploys a new ERC pointer of pointee from the current
+// artifact, with the metadata of the pointer it replaces, without registering
+// it.
+func (k *Keeper) deployERCPointer(ctx sdk.Context, pointerType types.PointerType, pointee string, oldPointer common.Address) (common.Address, error) {
+	metadata, err := k.queryERCPointerMetadata(ctx, pointerType, oldPointer)
+	if err != nil {
+		return common.Address{}, err
+	}
+	typ := ercPointerArtifactTypes[pointerType]
+	args := []interface{}{pointee, metadata.Name, metadata.Symbol}
+	if pointerType == types.PointerType_NATIVE {
+		args = append(args, metadata.Decimals)
+	}
+	bin, err := artifacts.GetParsedABI(typ).Pack("", args...)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var contractAddr common.Address
+	err = k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		suppliedGas := k.getEvmGasLimitFromCtx(ctx)
+		addr, err := nextERCPointerAddress(e.StateDB, e.TxContext.Origin, pointerType, pointee)
+		if err != nil {
+			return err
+		}
+		_, _, remainingGas, err := e.CreateWithAddress(vm.AccountRef(e.TxContext.Origin), append(artifacts.GetBin(typ), bin...), suppliedGas, utils.Big0, addr)
+		if err != nil {
+			return err
+		}
+		ctx.GasMeter().ConsumeGas(k.GetCosmosGasLimitFromEVMGas(ctx, suppliedGas-remainingGas), "ERC pointer deployment")
+		contractAddr = addr
+		return nil
+	}, func(string, string) {})
+	return contractAddr, err
+}
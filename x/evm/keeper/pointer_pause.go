@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// SetPointerPaused pauses or unpauses the pointer currently registered for
+// pointee on behalf of actor and returns its address. Pausing is tracked per
+// pointer address, so a pointer that replaces a paused one is not paused.
+func (k *Keeper) SetPointerPaused(ctx sdk.Context, actor string, pointerType types.PointerType, pointee string, paused bool) (pointer string, err error) {
+	defer func() {
+		operation := types.PointerOpUnpause
+		if paused {
+			operation = types.PointerOpPause
+		}
+		k.RecordPointerOperation(pointerType, operation, err)
+	}()
+	pointerKey, ok := PointerRegistryKey(pointerType, pointee)
+	if !ok {
+		return "", fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	pointerBz, version, exists := k.GetPointerInfo(ctx, pointerKey)
+	if !exists {
+		return "", fmt.Errorf("no %s pointer registered for %s", pointerType, pointee)
+	}
+	pointer = common.BytesToAddress(pointerBz).Hex()
+	if isCWPointerType(pointerType) {
+		pointer = string(pointerBz)
+	}
+	if k.IsPointerPaused(ctx, common.BytesToAddress(pointerBz)) == paused {
+		return "", fmt.Errorf("pointer %s is already %s", pointer, pauseStatus(paused))
+	}
+	key := types.PointerPausedKey(common.BytesToAddress(pointerBz))
+	eventType := types.EventTypePointerUnpaused
+	if paused {
+		ctx.KVStore(k.GetStoreKey()).Set(key, []byte{1})
+		eventType = types.EventTypePointerPaused
+	} else {
+		ctx.KVStore(k.GetStoreKey()).Delete(key)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType, sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, pointer), sdk.NewAttribute(types.AttributeKeyPointee, pointee)))
+	if paused {
+		emitPointerEvent(ctx, &types.EventPointerPaused{
+			PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+			OldVersion: uint32(version), NewVersion: uint32(version), Actor: actor,
+		})
+	} else {
+		emitPointerEvent(ctx, &types.EventPointerUnpaused{
+			PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+			OldVersion: uint32(version), NewVersion: uint32(version), Actor: actor,
+		})
+	}
+	return pointer, nil
+}
+
+func pauseStatus(paused bool) string {
+	if paused {
+		return "paused"
+	}
+	return "unpaused"
+}
+
+// IsPointerPaused returns whether the pointer at addr was paused by
+// governance. CW pointers are looked up by their bech32 address truncated to
+// an address length, as they are keyed in the reverse registry.
+func (k *Keeper) IsPointerPaused(ctx sdk.Context, addr common.Address) bool {
+	return ctx.KVStore(k.GetStoreKey()).Has(types.PointerPausedKey(addr))
+}
+
+// pointerRegistryAddress returns the address a pointer is keyed by in the
+// reverse registry, given its hex or bech32 address.
+func pointerRegistryAddress(pointer string) common.Address {
+	if common.IsHexAddress(pointer) {
+		return common.HexToAddress(pointer)
+	}
+	return common.BytesToAddress([]byte(pointer))
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/cw721"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
 	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
 // allows us to permutate different pointer combinations
@@ -392,3 +395,151 @@ func TestCWtoEVMPointers(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAnyPointer(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).CacheContext()
+	cwSetters := map[evmtypes.PointerType]func(types.Context, common.Address, string) error{
+		evmtypes.PointerType_ERC20:   k.SetCW20ERC20Pointer,
+		evmtypes.PointerType_ERC721:  k.SetCW721ERC721Pointer,
+		evmtypes.PointerType_ERC1155: k.SetCW1155ERC1155Pointer,
+	}
+	evmSetters := map[evmtypes.PointerType]func(types.Context, string, common.Address) error{
+		evmtypes.PointerType_NATIVE: k.SetERC20NativePointer,
+		evmtypes.PointerType_CW20:   k.SetERC20CW20Pointer,
+		evmtypes.PointerType_CW721:  k.SetERC721CW721Pointer,
+		evmtypes.PointerType_CW1155: k.SetERC1155CW1155Pointer,
+	}
+	for pointerType, setter := range cwSetters {
+		pointer, pointee := testkeeper.MockAddressPair()
+		require.Nil(t, setter(ctx, pointee, pointer.String()))
+		actual, ok := k.IsAnyPointer(ctx, pointer.String())
+		require.True(t, ok)
+		require.Equal(t, pointerType, actual)
+		_, ok = k.IsAnyPointer(ctx, pointee.Hex())
+		require.False(t, ok)
+		// the truncated address a CW pointer is keyed by isn't a pointer
+		_, ok = k.IsAnyPointer(ctx, common.BytesToAddress([]byte(pointer.String())).Hex())
+		require.False(t, ok)
+	}
+	for pointerType, setter := range evmSetters {
+		pointee, pointer := testkeeper.MockAddressPair()
+		require.Nil(t, setter(ctx, pointee.String(), pointer))
+		actual, ok := k.IsAnyPointer(ctx, pointer.Hex())
+		require.True(t, ok)
+		require.Equal(t, pointerType, actual)
+		_, ok = k.IsAnyPointer(ctx, pointee.String())
+		require.False(t, ok)
+	}
+	require.Len(t, evmtypes.PointerType_name, len(cwSetters)+len(evmSetters))
+}
+
+type pointerHookCall struct {
+	hook        string
+	pointerType evmtypes.PointerType
+	pointee     string
+	pointer     string
+	version     uint16
+}
+
+type mockPointerHooks struct {
+	k     *evmkeeper.Keeper
+	err   error
+	calls []pointerHookCall
+}
+
+func (h *mockPointerHooks) record(ctx types.Context, hook string, pointerType evmtypes.PointerType, pointee string, pointer string, version uint16) error {
+	h.calls = append(h.calls, pointerHookCall{hook, pointerType, pointee, pointer, version})
+	// state changes of failing hooks are discarded
+	h.k.SetNonce(ctx, common.HexToAddress(pointer), h.k.GetNonce(ctx, common.HexToAddress(pointer))+1)
+	return h.err
+}
+
+func (h *mockPointerHooks) AfterPointerRegistered(ctx types.Context, pointerType evmtypes.PointerType, pointee string, pointer string, version uint16) error {
+	// the pointer is registered in both directions by the time hooks run
+	if _, _, ok := h.k.GetNativePointee(ctx, pointer); !ok {
+		return errors.New("reverse registry entry not found")
+	}
+	return h.record(ctx, "registered", pointerType, pointee, pointer, version)
+}
+
+func (h *mockPointerHooks) AfterPointerUpgraded(ctx types.Context, pointerType evmtypes.PointerType, pointee string, pointer string, version uint16) error {
+	return h.record(ctx, "upgraded", pointerType, pointee, pointer, version)
+}
+
+func (h *mockPointerHooks) AfterPointerRemoved(ctx types.Context, pointerType evmtypes.PointerType, pointee string, pointer string, version uint16) error {
+	return h.record(ctx, "removed", pointerType, pointee, pointer, version)
+}
+
+func TestPointerHooks(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	failing := &mockPointerHooks{k: k, err: errors.New("failed")}
+	succeeding := &mockPointerHooks{k: k}
+	k.SetPointerHooks(evmtypes.NewMultiPointerHooks(failing, succeeding))
+	require.Panics(t, func() { k.SetPointerHooks(evmtypes.NewMultiPointerHooks()) })
+
+	_, pointer := testkeeper.MockAddressPair()
+	_, overridingPointer := testkeeper.MockAddressPair()
+	k.SetCode(ctx, overridingPointer, []byte("code"))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", pointer, 1))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", pointer, 2))
+	_, _, _, _, err := k.OverridePointerInRegistry(ctx, "", evmtypes.PointerType_NATIVE, "ufoo", overridingPointer.Hex())
+	require.Nil(t, err)
+	_, err = k.RemovePointerFromRegistry(ctx, "", evmtypes.PointerType_NATIVE, "ufoo", true)
+	require.Nil(t, err)
+
+	expected := []pointerHookCall{
+		{"registered", evmtypes.PointerType_NATIVE, "ufoo", pointer.Hex(), 1},
+		{"upgraded", evmtypes.PointerType_NATIVE, "ufoo", pointer.Hex(), 2},
+		{"upgraded", evmtypes.PointerType_NATIVE, "ufoo", overridingPointer.Hex(), 3},
+		{"removed", evmtypes.PointerType_NATIVE, "ufoo", overridingPointer.Hex(), 3},
+	}
+	require.Equal(t, expected, failing.calls)
+	require.Equal(t, expected, succeeding.calls)
+	require.Equal(t, uint64(2), k.GetNonce(ctx, pointer))
+	require.Equal(t, uint64(2), k.GetNonce(ctx, overridingPointer))
+}
+
+func TestPointerReverseIndex(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	_, pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20CW20PointerWithVersion(ctx, "cw20", pointer, 1))
+	require.Nil(t, k.SetERC20CW20PointerWithVersion(ctx, "cw20", pointer, 2))
+	pointee, version, exists := k.GetCW20Pointee(ctx, pointer)
+	require.True(t, exists)
+	require.Equal(t, "cw20", pointee)
+	require.Equal(t, uint16(2), version)
+
+	// deleting the latest version falls back to the previous one
+	k.DeleteERC20CW20Pointer(ctx, "cw20", 2)
+	_, version, exists = k.GetCW20Pointee(ctx, pointer)
+	require.True(t, exists)
+	require.Equal(t, uint16(1), version)
+	k.DeleteERC20CW20Pointer(ctx, "cw20", 1)
+	_, _, exists = k.GetCW20Pointee(ctx, pointer)
+	require.False(t, exists)
+	require.False(t, ctx.KVStore(k.GetStoreKey()).Has(evmtypes.PointerReverseIndexKey(pointer)))
+}
+
+// BenchmarkGetCW20Pointee shows that reverse lookups cost the same regardless
+// of how many pointers are registered.
+func BenchmarkGetCW20Pointee(b *testing.B) {
+	for _, n := range []int{10, 1000, 10000} {
+		b.Run(fmt.Sprintf("registry=%d", n), func(b *testing.B) {
+			k, ctx := testkeeper.MockEVMKeeper()
+			pointers := make([]common.Address, n)
+			for i := range pointers {
+				_, pointers[i] = testkeeper.MockAddressPair()
+				if err := k.SetERC20CW20Pointer(ctx, fmt.Sprintf("cw20-%d", i), pointers[i]); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, _, exists := k.GetCW20Pointee(ctx, pointers[i%n]); !exists {
+					b.Fatal("pointee not found")
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/gogo/protobuf/proto"
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
@@ -18,6 +22,7 @@ func (k *Keeper) RunWithOneOffEVMInstance(
 ) error {
 	stateDB := state.NewDBImpl(ctx, k, false)
 	evmModuleAddress := k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+	stateDB.SetTxOrigin(evmModuleAddress, ctx.TxSum())
 	gp := core.GasPool(math.MaxUint64)
 	blockCtx, err := k.GetVMBlockContext(ctx, gp)
 	if err != nil {
@@ -43,24 +48,69 @@ func (k *Keeper) RunWithOneOffEVMInstance(
 	return nil
 }
 
+// UpsertERCNativePointer deploys the native pointer of token, or replaces its
+// code. Its decimals are the exponent of the display unit in the bank metadata
+// of token, and metadata.Decimals is only used for tokens without one.
 func (k *Keeper) UpsertERCNativePointer(
 	ctx sdk.Context, evm *vm.EVM, token string, metadata utils.ERCMetadata,
 ) (contractAddr common.Address, err error) {
-	return k.UpsertERCPointer(
+	decimals, fromMetadata := k.bankDisplayDecimals(ctx, token)
+	if fromMetadata {
+		metadata.Decimals = decimals
+	}
+	contractAddr, err = k.UpsertERCPointer(
 		ctx, evm, "native", []interface{}{
 			token, metadata.Name, metadata.Symbol, metadata.Decimals,
 		}, k.GetERC20NativePointer, k.SetERC20NativePointer,
 	)
+	if err != nil {
+		return
+	}
+	k.setNativePointerDecimalsFromMetadata(ctx, token, fromMetadata)
+	return
 }
 
+// MaxCW20PointerDecimals is the most decimals a CW20 can have without its
+// pointer emitting a decimals warning when registered. The pointer relays the
+// CW20's decimals and amounts verbatim either way, but wallets and contracts
+// commonly mishandle tokens with that many decimals.
+const MaxCW20PointerDecimals = 36
+
 func (k *Keeper) UpsertERCCW20Pointer(
 	ctx sdk.Context, evm *vm.EVM, cw20Addr string, metadata utils.ERCMetadata,
 ) (contractAddr common.Address, err error) {
-	return k.UpsertERCPointer(
+	contractAddr, err = k.UpsertERCPointer(
 		ctx, evm, "cw20", []interface{}{
 			cw20Addr, metadata.Name, metadata.Symbol,
 		}, k.GetERC20CW20Pointer, k.SetERC20CW20Pointer,
 	)
+	if err != nil {
+		return
+	}
+	k.warnOnCW20Decimals(ctx, cw20Addr, contractAddr)
+	return
+}
+
+// warnOnCW20Decimals emits a pointer_decimals_warning event if the CW20 at
+// cw20Addr has more than MaxCW20PointerDecimals decimals.
+func (k *Keeper) warnOnCW20Decimals(ctx sdk.Context, cw20Addr string, pointerAddr common.Address) {
+	addr, err := sdk.AccAddressFromBech32(cw20Addr)
+	if err != nil {
+		return
+	}
+	tokenInfo := struct {
+		Decimals uint64 `json:"decimals"`
+	}{}
+	if !k.queryCWTokenMetadata(ctx, addr, `{"token_info":{}}`, &tokenInfo) || tokenInfo.Decimals <= MaxCW20PointerDecimals {
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerDecimalsWarning,
+		sdk.NewAttribute(types.AttributeKeyPointerType, types.PointerType_CW20.String()),
+		sdk.NewAttribute(types.AttributeKeyPointee, cw20Addr),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.Hex()),
+		sdk.NewAttribute(types.AttributeKeyDecimals, fmt.Sprintf("%d", tokenInfo.Decimals)),
+	))
 }
 
 func (k *Keeper) UpsertERCCW721Pointer(
@@ -86,8 +136,12 @@ func (k *Keeper) UpsertERCCW1155Pointer(
 func (k *Keeper) UpsertERCPointer(
 	ctx sdk.Context, evm *vm.EVM, typ string, args []interface{}, getter PointerGetter, setter PointerSetter,
 ) (contractAddr common.Address, err error) {
+	pointerType, ok := ercPointerTypes[typ]
+	if !ok {
+		return common.Address{}, fmt.Errorf("unknown ERC pointer type %s", typ)
+	}
 	pointee := args[0].(string)
-	evmModuleAddress := k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+	evmModuleAddress := k.evmModuleAddress(ctx)
 
 	var bin []byte
 	bin, err = artifacts.GetParsedABI(typ).Pack("", args...)
@@ -95,7 +149,12 @@ func (k *Keeper) UpsertERCPointer(
 		panic(err)
 	}
 	bin = append(artifacts.GetBin(typ), bin...)
-	existingAddr, _, exists := getter(ctx, pointee)
+	existingAddr, oldVersion, exists := getter(ctx, pointee)
+	defer func() {
+		if err != nil {
+			k.recordPointerRegistrationFailure(pointerType, exists, err)
+		}
+	}()
 	suppliedGas := k.getEvmGasLimitFromCtx(ctx)
 	var remainingGas uint64
 	if exists {
@@ -104,7 +163,13 @@ func (k *Keeper) UpsertERCPointer(
 		ret, remainingGas, err = evm.GetDeploymentCode(vm.AccountRef(evmModuleAddress), bin, suppliedGas, utils.Big0, existingAddr)
 		k.SetCode(ctx, contractAddr, ret)
 	} else {
-		_, contractAddr, remainingGas, err = evm.Create(vm.AccountRef(evmModuleAddress), bin, suppliedGas, utils.Big0)
+		// deployed at an address derived from the pointee, so that it can be
+		// predicted before registration
+		contractAddr, err = nextERCPointerAddress(evm.StateDB, evmModuleAddress, pointerType, pointee)
+		if err != nil {
+			return
+		}
+		_, _, remainingGas, err = evm.CreateWithAddress(vm.AccountRef(evmModuleAddress), bin, suppliedGas, utils.Big0, contractAddr)
 	}
 	if err != nil {
 		return
@@ -113,8 +178,171 @@ func (k *Keeper) UpsertERCPointer(
 	if err = setter(ctx, pointee, contractAddr); err != nil {
 		return
 	}
+	pointerKey, _ := PointerRegistryKey(pointerType, pointee)
+	_, version, _ := getter(ctx, pointee)
+	actor := k.GetSeiAddressOrDefault(ctx, evm.TxContext.Origin)
+	if err = k.setPointerCreationInfo(ctx, pointerKey, actor, version); err != nil {
+		return
+	}
+	emitPointerRegistration(ctx, pointerType, pointee, contractAddr.Hex(), oldVersion, version, exists, actor.String())
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, typ),
 		sdk.NewAttribute(types.AttributeKeyPointerAddress, contractAddr.Hex()), sdk.NewAttribute(types.AttributeKeyPointee, pointee)))
 	return
 }
+
+// PointerDeploymentCode returns the runtime code UpsertERCPointer deploys for
+// the artifact type typ. Pointer constructors only write storage, so the
+// runtime code is the same for every pointee and is computed here for a
+// placeholder one, without persisting anything. The constructors are fixed
+// code, so the deployment is not metered.
+func (k *Keeper) PointerDeploymentCode(ctx sdk.Context, typ string) ([]byte, error) {
+	args := []interface{}{"", "", ""}
+	if typ == "native" {
+		args = append(args, uint8(0))
+	}
+	bin, err := artifacts.GetParsedABI(typ).Pack("", args...)
+	if err != nil {
+		return nil, err
+	}
+	moduleAddress := k.AccountKeeper().GetModuleAddress(types.ModuleName)
+	evm, err := k.createReadOnlyEVM(ctx, moduleAddress, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	code, _, err := evm.GetDeploymentCode(vm.AccountRef(k.GetEVMAddressOrDefault(ctx, moduleAddress)), append(artifacts.GetBin(typ), bin...), math.MaxUint64, utils.Big0, common.Address{})
+	return code, err
+}
+
+// UpgradePointerToCurrentVersion upgrades the pointer registered for pointee to the current
+// artifact version without changing its address. EVM pointers get their code
+// replaced by a deployment of the current artifact, initialized with the
+// metadata of the old one, and CW pointers are migrated to the stored code ID
+// of the current version. Nothing is written unless the whole upgrade succeeds.
+func (k *Keeper) UpgradePointerToCurrentVersion(ctx sdk.Context, actor string, pointerType types.PointerType, pointee string) (pointer string, oldVersion uint16, newVersion uint16, err error) {
+	pointerKey, ok := PointerRegistryKey(pointerType, pointee)
+	if !ok {
+		return "", 0, 0, fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+	pointerBz, oldVersion, exists := k.GetPointerInfo(ctx, pointerKey)
+	if !exists {
+		return "", 0, 0, fmt.Errorf("no %s pointer registered for %s", pointerType, pointee)
+	}
+	newVersion = k.currentPointerVersion(ctx, pointerType)
+	if oldVersion >= newVersion {
+		return "", 0, 0, fmt.Errorf("pointer for %s is already at version %d", pointee, oldVersion)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	switch pointerType {
+	case types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155:
+		pointer = string(pointerBz)
+		err = k.migrateCWPointer(cacheCtx, pointerType, common.HexToAddress(pointee), pointer)
+	default:
+		pointer = common.BytesToAddress(pointerBz).Hex()
+		err = k.redeployERCPointer(cacheCtx, pointerType, pointee, common.BytesToAddress(pointerBz))
+	}
+	if err != nil {
+		return "", 0, 0, err
+	}
+	write()
+	// a redeployment reports the upgrade with the one-off EVM's origin as its
+	// actor, so the typed event is only emitted below, with the actual one
+	upgradedType := proto.MessageName(&types.EventPointerUpgraded{})
+	for _, event := range cacheCtx.EventManager().Events() {
+		if event.Type != upgradedType {
+			ctx.EventManager().EmitEvent(event)
+		}
+	}
+	emitPointerEvent(ctx, &types.EventPointerUpgraded{
+		PointerType: pointerType, Pointee: pointee, Pointer: pointer,
+		OldVersion: uint32(oldVersion), NewVersion: uint32(newVersion), Actor: actor,
+	})
+	return pointer, oldVersion, newVersion, nil
+}
+
+func (k *Keeper) migrateCWPointer(ctx sdk.Context, pointerType types.PointerType, pointee common.Address, pointer string) error {
+	addr, err := sdk.AccAddressFromBech32(pointer)
+	if err != nil {
+		return err
+	}
+	bz, _ := json.Marshal(map[string]interface{}{})
+	moduleAcct := k.AccountKeeper().GetModuleAddress(types.ModuleName)
+	if _, err := k.WasmKeeper().Migrate(ctx, addr, moduleAcct, k.GetStoredPointerCodeID(ctx, pointerType), bz); err != nil {
+		return err
+	}
+	switch pointerType {
+	case types.PointerType_ERC20:
+		return k.SetCW20ERC20Pointer(ctx, pointee, pointer)
+	case types.PointerType_ERC721:
+		return k.SetCW721ERC721Pointer(ctx, pointee, pointer)
+	default:
+		return k.SetCW1155ERC1155Pointer(ctx, pointee, pointer)
+	}
+}
+
+// ercPointerTypes maps the artifact types of ERC pointers deployed by
+// UpsertERCPointer to their pointer types.
+var ercPointerTypes = map[string]types.PointerType{
+	"native": types.PointerType_NATIVE,
+	"cw20":   types.PointerType_CW20,
+	"cw721":  types.PointerType_CW721,
+	"cw1155": types.PointerType_CW1155,
+}
+
+// ercPointerArtifactTypes maps the types of ERC pointers to their artifact
+// types.
+var ercPointerArtifactTypes = map[types.PointerType]string{
+	types.PointerType_NATIVE: "native",
+	types.PointerType_CW20:   "cw20",
+	types.PointerType_CW721:  "cw721",
+	types.PointerType_CW1155: "cw1155",
+}
+
+func (k *Keeper) redeployERCPointer(ctx sdk.Context, pointerType types.PointerType, pointee string, pointer common.Address) error {
+	metadata, err := k.queryERCPointerMetadata(ctx, pointerType, pointer)
+	if err != nil {
+		return err
+	}
+	return k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		var err error
+		switch pointerType {
+		case types.PointerType_NATIVE:
+			_, err = k.UpsertERCNativePointer(ctx, e, pointee, metadata)
+		case types.PointerType_CW20:
+			_, err = k.UpsertERCCW20Pointer(ctx, e, pointee, metadata)
+		case types.PointerType_CW721:
+			_, err = k.UpsertERCCW721Pointer(ctx, e, pointee, metadata)
+		default:
+			_, err = k.UpsertERCCW1155Pointer(ctx, e, pointee, metadata)
+		}
+		return err
+	}, func(string, string) {})
+}
+
+// queryERCPointerMetadata returns the metadata an ERC pointer was deployed
+// with.
+func (k *Keeper) queryERCPointerMetadata(ctx sdk.Context, pointerType types.PointerType, pointer common.Address) (utils.ERCMetadata, error) {
+	typ := ercPointerArtifactTypes[pointerType]
+	queries := []string{"name", "symbol"}
+	if pointerType == types.PointerType_NATIVE {
+		queries = append(queries, "decimals")
+	}
+	outputs := make([]interface{}, len(queries))
+	for i, query := range queries {
+		out, err := k.QueryERCSingleOutput(ctx, typ, pointer, query)
+		if err == nil && out == nil {
+			err = errors.New("no output")
+		}
+		if err != nil {
+			return utils.ERCMetadata{}, fmt.Errorf("failed to query %s of pointer %s: %w", query, pointer.Hex(), err)
+		}
+		outputs[i] = out
+	}
+	metadata := utils.ERCMetadata{}
+	metadata.Name, _ = outputs[0].(string)
+	metadata.Symbol, _ = outputs[1].(string)
+	if pointerType == types.PointerType_NATIVE {
+		metadata.Decimals, _ = outputs[2].(uint8)
+	}
+	return metadata, nil
+}
@@ -1,15 +1,24 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"errors"
+	"math/big"
+	"os"
 	"testing"
 	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,6 +77,61 @@ func TestUpsertERCNativePointer(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestUpsertERCNativePointerDecimalsFromMetadata(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	upsert := func(denom string, decimals uint8) common.Address {
+		var addr common.Address
+		require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) (err error) {
+			addr, err = k.UpsertERCNativePointer(ctx, e, denom, utils.ERCMetadata{Name: "foo", Symbol: "FOO", Decimals: decimals})
+			return
+		}, func(string, string) {}))
+		return addr
+	}
+	requireDecimals := func(addr common.Address, expected uint8) {
+		res, err := k.QueryERCSingleOutput(ctx, "native", addr, "decimals")
+		require.Nil(t, err)
+		require.Equal(t, expected, res.(uint8))
+	}
+
+	// without bank metadata the given decimals are used
+	addr := upsert("ufoo", 6)
+	requireDecimals(addr, 6)
+	require.False(t, k.NativePointerDecimalsFromMetadata(ctx, "ufoo"))
+
+	// existing pointers keep their decimals until upgraded
+	k.DeleteERC20NativePointer(ctx, "ufoo", native.CurrentVersion)
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", addr, native.CurrentVersion-1))
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "mfoo", Exponent: 3}, {Denom: "foo", Exponent: 18}},
+		Base:       "ufoo",
+		Display:    "mfoo",
+	})
+	requireDecimals(addr, 6)
+	_, _, _, err := k.UpgradePointerToCurrentVersion(ctx, "", types.PointerType_NATIVE, "ufoo")
+	require.Nil(t, err)
+	requireDecimals(addr, 3)
+	require.True(t, k.NativePointerDecimalsFromMetadata(ctx, "ufoo"))
+	res, err := q.NativePointerMetadata(sdk.WrapSDKContext(ctx), &types.QueryNativePointerMetadataRequest{DenomOrPointer: "ufoo"})
+	require.Nil(t, err)
+	require.True(t, res.DecimalsFromMetadata)
+
+	// metadata without a display unit falls back to the given decimals
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ubar", Exponent: 0}},
+		Base:       "ubar",
+		Display:    "bar",
+	})
+	addr = upsert("ubar", 8)
+	requireDecimals(addr, 8)
+	require.False(t, k.NativePointerDecimalsFromMetadata(ctx, "ubar"))
+
+	// the flag is cleared with the pointer
+	_, err = k.RemovePointerFromRegistry(ctx, "", types.PointerType_NATIVE, "ufoo", true)
+	require.Nil(t, err)
+	require.False(t, k.NativePointerDecimalsFromMetadata(ctx, "ufoo"))
+}
+
 func TestUpsertERC20Pointer(t *testing.T) {
 	k := &testkeeper.EVMTestApp.EvmKeeper
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
@@ -148,3 +212,75 @@ func TestUpsertERC1155Pointer(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, addr, newAddr)
 }
+
+func TestUpsertERCCW20PointerDecimals(t *testing.T) {
+	a := testkeeper.EVMTestApp
+	k := &a.EvmKeeper
+	ctx := a.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	creator, _ := testkeeper.MockAddressPair()
+	code, err := os.ReadFile("../../../contracts/wasm/cw20_base.wasm")
+	require.Nil(t, err)
+	codeID, err := k.WasmKeeper().Create(ctx, creator, code, nil)
+	require.Nil(t, err)
+	supply := new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+	instantiate := func(decimals int) string {
+		// cw20-base caps decimals at 18, so larger ones are written to its
+		// state directly
+		bz, err := json.Marshal(map[string]interface{}{
+			"name": "Foo", "symbol": "FOO", "decimals": min(decimals, 18),
+			"initial_balances": []interface{}{map[string]string{"address": creator.String(), "amount": supply.String()}},
+		})
+		require.Nil(t, err)
+		addr, _, err := k.WasmKeeper().Instantiate(ctx, codeID, creator, creator, bz, "foo", sdk.NewCoins())
+		require.Nil(t, err)
+		if decimals > 18 {
+			store := prefix.NewStore(ctx.KVStore(a.GetKey(wasmtypes.StoreKey)), wasmtypes.GetContractStorePrefix(addr))
+			tokenInfo := map[string]interface{}{}
+			require.Nil(t, json.Unmarshal(store.Get([]byte("token_info")), &tokenInfo))
+			tokenInfo["decimals"] = decimals
+			bz, err := json.Marshal(tokenInfo)
+			require.Nil(t, err)
+			store.Set([]byte("token_info"), bz)
+		}
+		return addr.String()
+	}
+	upsert := func(ctx sdk.Context, cw20Addr string) common.Address {
+		var addr common.Address
+		require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) (err error) {
+			addr, err = k.UpsertERCCW20Pointer(ctx, e, cw20Addr, utils.ERCMetadata{Name: "Foo", Symbol: "FOO"})
+			return
+		}, func(string, string) {}))
+		return addr
+	}
+	hasWarning := func(ctx sdk.Context) bool {
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == types.EventTypePointerDecimalsWarning {
+				return true
+			}
+		}
+		return false
+	}
+
+	// decimals and amounts are relayed verbatim
+	for _, decimals := range []int{0, 6, 18, 24} {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		addr := upsert(ctx, instantiate(decimals))
+		res, err := k.QueryERCSingleOutput(ctx, "cw20", addr, "decimals")
+		require.Nil(t, err)
+		require.Equal(t, uint8(decimals), res.(uint8))
+		res, err = k.QueryERCSingleOutput(ctx, "cw20", addr, "totalSupply")
+		require.Nil(t, err)
+		require.Equal(t, supply, res.(*big.Int))
+		require.False(t, hasWarning(ctx))
+	}
+
+	// registering a pointer for a CW20 with too many decimals emits a warning
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	cw20Addr := instantiate(keeper.MaxCW20PointerDecimals + 1)
+	addr := upsert(ctx, cw20Addr)
+	res, err := k.QueryERCSingleOutput(ctx, "cw20", addr, "decimals")
+	require.Nil(t, err)
+	require.Equal(t, uint8(keeper.MaxCW20PointerDecimals+1), res.(uint8))
+	require.True(t, hasWarning(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"time"
@@ -15,6 +17,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/retention"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
@@ -34,7 +37,7 @@ func (k *Keeper) GetTransientReceipt(ctx sdk.Context, txHash common.Hash) (*type
 	store := ctx.TransientStore(k.transientStoreKey)
 	bz := store.Get(types.ReceiptKey(txHash))
 	if bz == nil {
-		return nil, errors.New("not found")
+		return nil, types.ErrReceiptNotFound
 	}
 	r := &types.Receipt{}
 	if err := r.Unmarshal(bz); err != nil {
@@ -70,7 +73,10 @@ func (k *Keeper) GetReceipt(ctx sdk.Context, txHash common.Hash) (*types.Receipt
 		store := ctx.KVStore(k.storeKey)
 		bz = store.Get(types.ReceiptKey(txHash))
 		if bz == nil {
-			return nil, errors.New("not found")
+			if pruned, err := k.receiptStore.Has(types.ReceiptStoreKey, lv, types.ReceiptPrunedKey(txHash)); err == nil && pruned {
+				return nil, types.ErrReceiptPruned
+			}
+			return nil, types.ErrReceiptNotFound
 		}
 	}
 
@@ -81,6 +87,246 @@ func (k *Keeper) GetReceipt(ctx sdk.Context, txHash common.Hash) (*types.Receipt
 	return &r, nil
 }
 
+// GetReceiptIndexStartHeight returns the first height whose receipts were
+// indexed by block, or 0 if nothing has been indexed yet. Receipts flushed
+// before the index existed are not backfilled.
+func (k *Keeper) GetReceiptIndexStartHeight() (int64, error) {
+	k.cachedReceiptIndexStartHeightMtx.RLock()
+	cache := k.cachedReceiptIndexStartHeight
+	k.cachedReceiptIndexStartHeightMtx.RUnlock()
+	if cache != nil {
+		return *cache, nil
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+	if has, err := k.receiptStore.Has(types.ReceiptStoreKey, lv, types.ReceiptBlockIndexStartHeightKey); err != nil || !has {
+		return 0, err
+	}
+	bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.ReceiptBlockIndexStartHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	height := int64(binary.BigEndian.Uint64(bz))
+	k.setCachedReceiptIndexStartHeight(height)
+	return height, nil
+}
+
+func (k *Keeper) setCachedReceiptIndexStartHeight(height int64) {
+	k.cachedReceiptIndexStartHeightMtx.Lock()
+	defer k.cachedReceiptIndexStartHeightMtx.Unlock()
+	k.cachedReceiptIndexStartHeight = &height
+}
+
+// GetReceiptsPrunedBefore returns the height below which receipts have been
+// pruned, or 0 if none have been. Receipts flushed before
+// GetReceiptIndexStartHeight are never pruned.
+func (k *Keeper) GetReceiptsPrunedBefore() (int64, error) {
+	cursor, err := k.getReceiptPruneCursor()
+	if err != nil || cursor == nil {
+		return 0, err
+	}
+	return types.ReceiptBlockIndexKeyHeight(cursor), nil
+}
+
+// getReceiptPruneCursor returns the block index key the pruner resumes from,
+// or nil if nothing has been pruned yet.
+func (k *Keeper) getReceiptPruneCursor() ([]byte, error) {
+	k.cachedReceiptPruneCursorMtx.RLock()
+	cache := k.cachedReceiptPruneCursor
+	k.cachedReceiptPruneCursorMtx.RUnlock()
+	if cache != nil {
+		if len(cache) == 0 {
+			return nil, nil
+		}
+		return cache, nil
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.ReceiptPruneCursorKey)
+	if err != nil {
+		return nil, err
+	}
+	// cached even if unset, so that lookups of missing receipts don't read it
+	k.setCachedReceiptPruneCursor(append([]byte{}, bz...))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	return bz, nil
+}
+
+func (k *Keeper) setCachedReceiptPruneCursor(cursor []byte) {
+	k.cachedReceiptPruneCursorMtx.Lock()
+	defer k.cachedReceiptPruneCursorMtx.Unlock()
+	k.cachedReceiptPruneCursor = cursor
+}
+
+// pruneReceipts returns the deletes of up to PruneBatchSize receipts flushed
+// before the retention window of the block at height, oldest first, along
+// with a pruned marker for each of them and the updated prune cursor. The
+// cursor is kept in memory as well, since the changeset is applied
+// asynchronously, and read back from the receipt store after a restart.
+// Entries of heights after the receipt store's latest version may not have
+// been written yet, so the cursor never moves past those. Markers are kept
+// for another RetainBlocks blocks and expire as the cursor moves past them.
+func (k *Keeper) pruneReceipts(height int64) ([]*iavl.KVPair, error) {
+	cfg := k.ReceiptRetentionConfig
+	if cfg.RetainBlocks <= 0 || height <= cfg.RetainBlocks {
+		return nil, nil
+	}
+	batchSize := cfg.PruneBatchSize
+	if batchSize == 0 {
+		batchSize = retention.DefaultConfig.PruneBatchSize
+	}
+	cutoff := types.ReceiptBlockIndexHeightPrefix(height - cfg.RetainBlocks + 1)
+	cursor, err := k.getReceiptPruneCursor()
+	if err != nil {
+		return nil, err
+	}
+	if cursor == nil {
+		cursor = types.ReceiptBlockIndexPrefix
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if written := types.ReceiptBlockIndexHeightPrefix(lv + 1); bytes.Compare(written, cutoff) < 0 {
+		cutoff = written
+	}
+	if bytes.Compare(cursor, cutoff) >= 0 {
+		return nil, nil
+	}
+	next := cutoff
+	var pairs []*iavl.KVPair
+	if lv > 0 {
+		iter, err := k.receiptStore.Iterator(types.ReceiptStoreKey, lv, cursor, cutoff)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = iter.Close() }()
+		for n := uint64(0); iter.Valid(); iter.Next() {
+			if n == batchSize {
+				next = append([]byte{}, iter.Key()...)
+				break
+			}
+			n++
+			pairs = append(pairs, &iavl.KVPair{Key: append([]byte{}, iter.Key()...), Delete: true})
+			receiptKey := types.ReceiptKey(common.BytesToHash(iter.Value()))
+			bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, receiptKey)
+			if err != nil {
+				return nil, err
+			}
+			if bz == nil {
+				continue
+			}
+			// a tx that failed its checks in a block can be included again
+			// in a later block, whose receipt replaces the earlier one
+			var r types.Receipt
+			if err := r.Unmarshal(bz); err != nil {
+				return nil, err
+			}
+			if indexHeight := types.ReceiptBlockIndexKeyHeight(iter.Key()); int64(r.BlockNumber) <= indexHeight {
+				txHash := common.BytesToHash(iter.Value())
+				bz := make([]byte, 8)
+				binary.BigEndian.PutUint64(bz, uint64(indexHeight))
+				pairs = append(pairs,
+					&iavl.KVPair{Key: receiptKey, Delete: true},
+					&iavl.KVPair{Key: types.ReceiptPrunedKey(txHash), Value: bz},
+					&iavl.KVPair{Key: types.ReceiptPrunedIndexKey(indexHeight, txHash), Value: txHash[:]},
+				)
+			}
+		}
+	}
+	expiries, err := k.expireReceiptPrunedMarkers(lv, cursor, next)
+	if err != nil {
+		return nil, err
+	}
+	// expiries go first, so that a marker written again in this batch isn't
+	// deleted along with its earlier one
+	pairs = append(expiries, pairs...)
+	pairs = append(pairs, &iavl.KVPair{Key: types.ReceiptPruneCursorKey, Value: next})
+	k.setCachedReceiptPruneCursor(next)
+	return pairs, nil
+}
+
+// expireReceiptPrunedMarkers returns the deletes of the pruned markers written
+// RetainBlocks blocks before the heights the prune cursor moves past from
+// cursor to next, so that markers expire in step with pruning.
+func (k *Keeper) expireReceiptPrunedMarkers(lv int64, cursor []byte, next []byte) ([]*iavl.KVPair, error) {
+	if lv == 0 {
+		return nil, nil
+	}
+	expireFrom := int64(0)
+	if len(cursor) > len(types.ReceiptBlockIndexPrefix) {
+		expireFrom = types.ReceiptBlockIndexKeyHeight(cursor) - k.ReceiptRetentionConfig.RetainBlocks
+	}
+	if expireFrom < 0 {
+		expireFrom = 0
+	}
+	expireTo := types.ReceiptBlockIndexKeyHeight(next) - k.ReceiptRetentionConfig.RetainBlocks
+	if expireTo <= expireFrom {
+		return nil, nil
+	}
+	iter, err := k.receiptStore.Iterator(types.ReceiptStoreKey, lv, types.ReceiptPrunedIndexHeightPrefix(expireFrom), types.ReceiptPrunedIndexHeightPrefix(expireTo))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = iter.Close() }()
+	var pairs []*iavl.KVPair
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, &iavl.KVPair{Key: append([]byte{}, iter.Key()...), Delete: true})
+		markerKey := types.ReceiptPrunedKey(common.BytesToHash(iter.Value()))
+		bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, markerKey)
+		if err != nil {
+			return nil, err
+		}
+		// the marker may have been rewritten when a later receipt of the
+		// same tx was pruned, in which case it expires with that one
+		if len(bz) == 8 && int64(binary.BigEndian.Uint64(bz)) == types.ReceiptPrunedIndexKeyHeight(iter.Key()) {
+			pairs = append(pairs, &iavl.KVPair{Key: markerKey, Delete: true})
+		}
+	}
+	return pairs, nil
+}
+
+// IterateReceiptsInRange calls cb with every receipt flushed at heights in
+// [fromHeight, toHeight], in block order, until cb returns true. Only heights
+// at or after GetReceiptIndexStartHeight are covered.
+func (k *Keeper) IterateReceiptsInRange(fromHeight int64, toHeight int64, cb func(*types.Receipt) bool) error {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if lv == 0 {
+		return nil
+	}
+	iter, err := k.receiptStore.Iterator(types.ReceiptStoreKey, lv, types.ReceiptBlockIndexHeightPrefix(fromHeight), types.ReceiptBlockIndexHeightPrefix(toHeight+1))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.ReceiptKey(common.BytesToHash(iter.Value())))
+		if err != nil {
+			return err
+		}
+		if bz == nil {
+			continue
+		}
+		var r types.Receipt
+		if err := r.Unmarshal(bz); err != nil {
+			return err
+		}
+		if cb(&r) {
+			return nil
+		}
+	}
+	return nil
+}
+
 // GetReceiptWithRetry attempts to get a receipt with retries to handle race conditions
 // where the receipt might not be immediately available after the transaction.
 func (k *Keeper) GetReceiptWithRetry(ctx sdk.Context, txHash common.Hash, maxRetries int) (*types.Receipt, error) {
@@ -92,7 +338,7 @@ func (k *Keeper) GetReceiptWithRetry(ctx sdk.Context, txHash common.Hash, maxRet
 		}
 
 		// If it's not a "not found" error, return immediately
-		if err.Error() != "not found" {
+		if !errors.Is(err, types.ErrReceiptNotFound) {
 			return nil, err
 		}
 
@@ -119,10 +365,31 @@ func (k *Keeper) FlushTransientReceipts(ctx sdk.Context) error {
 	defer iter.Close()
 	var pairs []*iavl.KVPair
 	var changesets []*proto.NamedChangeSet
+	// each receipt is written alongside a small block index entry pointing back
+	// at it, which shares the receipt's retention in the receipt store
 	for ; iter.Valid(); iter.Next() {
-		kvPair := &iavl.KVPair{Key: types.ReceiptKey(common.Hash(iter.Key())), Value: iter.Value()}
+		txHash := common.Hash(iter.Key())
+		kvPair := &iavl.KVPair{Key: types.ReceiptKey(txHash), Value: iter.Value()}
 		pairs = append(pairs, kvPair)
+		pairs = append(pairs, &iavl.KVPair{Key: types.ReceiptBlockIndexKey(ctx.BlockHeight(), txHash), Value: txHash[:]})
+	}
+	startHeight, err := k.GetReceiptIndexStartHeight()
+	if err != nil {
+		return err
+	}
+	if startHeight == 0 {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+		pairs = append(pairs, &iavl.KVPair{Key: types.ReceiptBlockIndexStartHeightKey, Value: bz})
+		k.setCachedReceiptIndexStartHeight(ctx.BlockHeight())
+	}
+	prunes, err := k.pruneReceipts(ctx.BlockHeight())
+	if err != nil {
+		return err
 	}
+	// prunes go first, so that a receipt written again in this block isn't
+	// deleted along with its earlier block's index entry
+	pairs = append(prunes, pairs...)
 	if len(pairs) == 0 {
 		return nil
 	}
@@ -4,10 +4,14 @@ import (
 	"testing"
 	"time"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/sei-protocol/sei-chain/app"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/retention"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -24,6 +28,7 @@ func TestReceipt(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, txHash.Hex(), r.TxHashHex)
 	_, err = k.GetReceipt(ctx, common.Hash{1})
+	require.ErrorIs(t, err, types.ErrReceiptNotFound)
 	require.Equal(t, "not found", err.Error())
 }
 
@@ -36,6 +41,7 @@ func TestGetReceiptWithRetry(t *testing.T) {
 	nonExistentHash := common.Hash{1}
 	_, err := k.GetReceiptWithRetry(ctx, nonExistentHash, 2)
 	require.NotNil(t, err)
+	require.ErrorIs(t, err, types.ErrReceiptNotFound)
 	require.Equal(t, "not found", err.Error())
 
 	// Then test successful retry
@@ -48,3 +54,137 @@ func TestGetReceiptWithRetry(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, txHash.Hex(), r.TxHashHex)
 }
+
+// newKeeperWithReceiptStore builds an EVM keeper over the stores of a and the
+// given receipt store, as a node (re)started on them would.
+func newKeeperWithReceiptStore(a *app.App, receiptStore *app.InMemoryStateStore) *keeper.Keeper {
+	return keeper.NewKeeper(a.GetKey(types.StoreKey), a.GetTKey(types.TransientStoreKey), a.GetSubspace(types.ModuleName), receiptStore,
+		a.BankKeeper, &a.AccountKeeper, &a.StakingKeeper, &a.DistrKeeper, a.TransferKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(a.WasmKeeper), &a.WasmKeeper)
+}
+
+func TestPruneReceipts(t *testing.T) {
+	a := app.Setup(false, false)
+	ctx := a.GetContextForDeliverTx([]byte{})
+	hash := func(height int64, i byte) common.Hash { return common.Hash{byte(height), i} }
+	flush := func(k *keeper.Keeper, height int64, txHashes ...common.Hash) {
+		for _, txHash := range txHashes {
+			require.Nil(t, k.SetTransientReceipt(ctx, txHash, &types.Receipt{TxHashHex: txHash.Hex(), BlockNumber: uint64(height)}))
+		}
+		require.Nil(t, k.FlushTransientReceipts(ctx.WithBlockHeight(height)))
+		for _, txHash := range txHashes {
+			k.DeleteTransientReceipt(ctx, txHash)
+		}
+	}
+	getReceipt := func(k *keeper.Keeper, height int64, txHash common.Hash) error {
+		_, err := k.GetReceipt(ctx.WithBlockHeight(height), txHash)
+		return err
+	}
+
+	// receipts are kept by default
+	k := newKeeperWithReceiptStore(a, app.NewInMemoryStateStore())
+	for h := int64(1); h <= 5; h++ {
+		flush(k, h, hash(h, 0))
+	}
+	require.Nil(t, getReceipt(k, 1, hash(1, 0)))
+	prunedBefore, err := k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(0), prunedBefore)
+
+	receiptStore := app.NewInMemoryStateStore()
+	k = newKeeperWithReceiptStore(a, receiptStore)
+	k.ReceiptRetentionConfig = retention.Config{RetainBlocks: 3, PruneBatchSize: 2}
+	flush(k, 1, hash(1, 0), hash(1, 1))
+	flush(k, 2, hash(2, 0), hash(2, 1))
+	// the second tx of block 1 is included again in block 3
+	flush(k, 3, hash(3, 0), hash(3, 1), hash(1, 1))
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(0), prunedBefore)
+
+	// block 4 keeps the receipts of blocks 2 to 4
+	flush(k, 4, hash(4, 0), hash(4, 1))
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(2), prunedBefore)
+	require.ErrorIs(t, getReceipt(k, 1, hash(1, 0)), types.ErrReceiptPruned)
+	require.Nil(t, getReceipt(k, 2, hash(2, 0)))
+	r, err := k.GetReceipt(ctx.WithBlockHeight(4), hash(1, 1))
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), r.BlockNumber)
+	// lookups by hash alone tell pruned receipts from missing ones
+	require.ErrorIs(t, getReceipt(k, 4, hash(1, 0)), types.ErrReceiptPruned)
+	require.Equal(t, "not found", getReceipt(k, 4, hash(1, 2)).Error())
+
+	flush(k, 5, hash(5, 0))
+	require.ErrorIs(t, getReceipt(k, 2, hash(2, 1)), types.ErrReceiptPruned)
+	require.Nil(t, getReceipt(k, 3, hash(3, 0)))
+
+	// block 3 has three entries, one more than a batch prunes
+	flush(k, 6)
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(3), prunedBefore)
+	require.ErrorIs(t, getReceipt(k, 6, hash(1, 1)), types.ErrReceiptPruned)
+	require.ErrorIs(t, getReceipt(k, 6, hash(3, 0)), types.ErrReceiptPruned)
+	require.Nil(t, getReceipt(k, 3, hash(3, 1)))
+
+	// a restarted node resumes from where pruning stopped
+	k = newKeeperWithReceiptStore(a, receiptStore)
+	k.ReceiptRetentionConfig = retention.Config{RetainBlocks: 3, PruneBatchSize: 2}
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(3), prunedBefore)
+	flush(k, 7)
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(4), prunedBefore)
+	require.ErrorIs(t, getReceipt(k, 3, hash(3, 1)), types.ErrReceiptPruned)
+	require.Nil(t, getReceipt(k, 4, hash(4, 1)))
+	require.Nil(t, getReceipt(k, 5, hash(5, 0)))
+
+	// pruned markers expire once pruning is another retention window past
+	// the heights they were pruned at
+	flush(k, 8)
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(6), prunedBefore)
+	require.ErrorIs(t, getReceipt(k, 8, hash(1, 0)), types.ErrReceiptNotFound)
+	require.ErrorIs(t, getReceipt(k, 8, hash(2, 0)), types.ErrReceiptNotFound)
+	// the tx included again in block 3 was marked when that receipt was pruned
+	require.ErrorIs(t, getReceipt(k, 8, hash(1, 1)), types.ErrReceiptPruned)
+	require.ErrorIs(t, getReceipt(k, 8, hash(3, 0)), types.ErrReceiptPruned)
+	lv, err := receiptStore.GetLatestVersion()
+	require.Nil(t, err)
+	has, err := receiptStore.Has(types.ReceiptStoreKey, lv, types.ReceiptPrunedIndexKey(1, hash(1, 0)))
+	require.Nil(t, err)
+	require.False(t, has)
+	flush(k, 9)
+	require.ErrorIs(t, getReceipt(k, 9, hash(1, 1)), types.ErrReceiptNotFound)
+	require.ErrorIs(t, getReceipt(k, 9, hash(3, 0)), types.ErrReceiptNotFound)
+	require.ErrorIs(t, getReceipt(k, 9, hash(4, 0)), types.ErrReceiptPruned)
+
+	// while the receipt store lags, pruning stops at its latest version
+	receiptStore = app.NewInMemoryStateStore()
+	k = newKeeperWithReceiptStore(a, receiptStore)
+	k.ReceiptRetentionConfig = retention.Config{RetainBlocks: 3, PruneBatchSize: 10}
+	flush(k, 1, hash(1, 0))
+	flush(k, 2, hash(2, 0))
+	flush(k, 3, hash(3, 0))
+	flush(k, 4, hash(4, 0))
+	require.Nil(t, receiptStore.SetLatestVersion(1))
+	flush(k, 5, hash(5, 0))
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(2), prunedBefore)
+	require.ErrorIs(t, getReceipt(k, 5, hash(1, 0)), types.ErrReceiptPruned)
+	require.Nil(t, getReceipt(k, 5, hash(2, 0)))
+	// the entries of block 2 are pruned once the store has caught up
+	flush(k, 6, hash(6, 0))
+	prunedBefore, err = k.GetReceiptsPrunedBefore()
+	require.Nil(t, err)
+	require.Equal(t, int64(4), prunedBefore)
+	require.ErrorIs(t, getReceipt(k, 6, hash(2, 0)), types.ErrReceiptPruned)
+	require.ErrorIs(t, getReceipt(k, 6, hash(3, 0)), types.ErrReceiptPruned)
+	require.Nil(t, getReceipt(k, 6, hash(4, 0)))
+}
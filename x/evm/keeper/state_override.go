@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// StateOverride replaces parts of an account's state for a simulated call.
+// Nil fields are left as they are. State replaces the account's whole storage
+// while StateDiff only replaces the given slots; at most one of them is set.
+type StateOverride struct {
+	Balance   *big.Int
+	Nonce     *uint64
+	Code      []byte
+	State     map[common.Hash]common.Hash
+	StateDiff map[common.Hash]common.Hash
+}
+
+// BlockOverrides replaces fields of the block context a simulated call executes
+// in. Nil fields are left as they are and no consistency between them is
+// enforced.
+type BlockOverrides struct {
+	Number   *big.Int
+	Time     *uint64
+	BaseFee  *big.Int
+	Coinbase *common.Address
+}
+
+func (o *BlockOverrides) apply(blockCtx *vm.BlockContext) {
+	if o == nil {
+		return
+	}
+	if o.Number != nil {
+		blockCtx.BlockNumber = o.Number
+	}
+	if o.Time != nil {
+		blockCtx.Time = *o.Time
+	}
+	if o.BaseFee != nil {
+		blockCtx.BaseFee = o.BaseFee
+	}
+	if o.Coinbase != nil {
+		blockCtx.Coinbase = *o.Coinbase
+	}
+}
+
+// ApplyStateOverrides writes overrides to ctx, which must be a branch that is
+// discarded once the simulation is done.
+func (k *Keeper) ApplyStateOverrides(ctx sdk.Context, overrides map[common.Address]StateOverride) error {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	for addr, override := range overrides {
+		if override.Balance != nil {
+			if err := k.setBalance(ctx, k.GetSeiAddressOrDefault(ctx, addr), override.Balance); err != nil {
+				return err
+			}
+		}
+		if override.Nonce != nil {
+			k.SetNonce(ctx, addr, *override.Nonce)
+		}
+		if override.Code != nil {
+			k.SetCode(ctx, addr, override.Code)
+		}
+		if override.State != nil {
+			k.PurgePrefix(ctx, types.StateKey(addr))
+			for key, val := range override.State {
+				k.SetState(ctx, addr, key, val)
+			}
+		}
+		for key, val := range override.StateDiff {
+			k.SetState(ctx, addr, key, val)
+		}
+	}
+	return nil
+}
+
+// setBalance replaces the spendable balance of addr without going through the
+// module account, so total supply is not kept consistent.
+func (k *Keeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, amt *big.Int) error {
+	denom := k.GetBaseDenom(ctx)
+	usei, wei := state.SplitUseiWeiAmount(k.GetBalance(ctx, addr))
+	if err := k.BankKeeper().SubUnlockedCoins(ctx, addr, sdk.NewCoins(sdk.NewCoin(denom, usei)), true); err != nil {
+		return err
+	}
+	if err := k.BankKeeper().SubWei(ctx, addr, wei); err != nil {
+		return err
+	}
+	usei, wei = state.SplitUseiWeiAmount(amt)
+	if err := k.BankKeeper().AddCoins(ctx, addr, sdk.NewCoins(sdk.NewCoin(denom, usei)), true); err != nil {
+		return err
+	}
+	return k.BankKeeper().AddWei(ctx, addr, wei)
+}
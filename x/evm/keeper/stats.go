@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// GetChainStat returns the aggregate counter stored under key, or 0 if it has
+// never been set.
+func (k *Keeper) GetChainStat(ctx sdk.Context, key []byte) uint64 {
+	bz := k.PrefixStore(ctx, types.ChainStatsPrefix).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k *Keeper) SetChainStat(ctx sdk.Context, key []byte, val uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, val)
+	k.PrefixStore(ctx, types.ChainStatsPrefix).Set(key, bz)
+}
+
+func (k *Keeper) incrementChainStat(ctx sdk.Context, key []byte, delta uint64) {
+	if delta == 0 {
+		return
+	}
+	k.SetChainStat(ctx, key, k.GetChainStat(ctx, key)+delta)
+}
+
+func (k *Keeper) decrementChainStat(ctx sdk.Context, key []byte) {
+	if val := k.GetChainStat(ctx, key); val > 0 {
+		k.SetChainStat(ctx, key, val-1)
+	}
+}
+
+// recordAssociationAdded counts a new association in the ring slot of the
+// current height. A slot holding the count of an older height, one full window
+// ago, is reset first.
+func (k *Keeper) recordAssociationAdded(ctx sdk.Context) {
+	height, count := k.getAssociationSlot(ctx, ctx.BlockHeight())
+	if height != ctx.BlockHeight() {
+		count = 0
+	}
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	binary.BigEndian.PutUint64(bz[8:], count+1)
+	k.PrefixStore(ctx, types.ChainStatsPrefix).Set(types.ChainStatsAssociationSlotKey(ctx.BlockHeight()), bz)
+}
+
+// getAssociationSlot returns the height last recorded in the ring slot of
+// height along with its association count.
+func (k *Keeper) getAssociationSlot(ctx sdk.Context, height int64) (int64, uint64) {
+	bz := k.PrefixStore(ctx, types.ChainStatsPrefix).Get(types.ChainStatsAssociationSlotKey(height))
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return int64(binary.BigEndian.Uint64(bz)), binary.BigEndian.Uint64(bz[8:])
+}
+
+// GetRecentAssociationCount returns the number of associations added in the
+// last blocks blocks up to and including the current one. blocks must not
+// exceed types.AssociationStatsWindow.
+func (k *Keeper) GetRecentAssociationCount(ctx sdk.Context, blocks int64) uint64 {
+	var total uint64
+	for h := ctx.BlockHeight(); h > ctx.BlockHeight()-blocks && h >= 0; h-- {
+		if height, count := k.getAssociationSlot(ctx, h); height == h {
+			total += count
+		}
+	}
+	return total
+}
+
+// RecordBlockChainStats adds the block's executed EVM transactions and
+// successful contract deployments to the chain stats. It runs in EndBlock so
+// that transactions executing in parallel don't contend on the counters.
+func (k *Keeper) RecordBlockChainStats(ctx sdk.Context, deferredInfos []*types.DeferredInfo) {
+	var txs, contracts uint64
+	for _, deferredInfo := range deferredInfos {
+		txHash := common.BytesToHash(deferredInfo.TxHash)
+		if deferredInfo.Error != "" || txHash.Cmp(ethtypes.EmptyTxsHash) == 0 {
+			continue
+		}
+		txs++
+		receipt, err := k.GetTransientReceipt(ctx, txHash)
+		if err != nil {
+			continue
+		}
+		if receipt.To == "" && receipt.ContractAddress != "" && receipt.Status == uint32(ethtypes.ReceiptStatusSuccessful) {
+			contracts++
+		}
+	}
+	k.incrementChainStat(ctx, types.ChainStatsEVMTxCountKey, txs)
+	k.incrementChainStat(ctx, types.ChainStatsContractCountKey, contracts)
+}
+
+// isPointerRegistryKey distinguishes forward pointer registrations, which are
+// counted, from the reverse entries written alongside them.
+func isPointerRegistryKey(pref []byte) bool {
+	return bytes.HasPrefix(pref, types.PointerRegistryPrefix)
+}
+
+// pointerTypeCountKey returns the key counting the pointees of the pointer type
+// a forward registry key belongs to.
+func pointerTypeCountKey(pref []byte) []byte {
+	typeIdx := len(types.PointerRegistryPrefix)
+	return types.ChainStatsPointerTypeCountKey(pref[typeIdx : typeIdx+1])
+}
+
+// GetPointerCount returns the number of pointees with a registered pointer of
+// the given type.
+func (k *Keeper) GetPointerCount(ctx sdk.Context, pointerType types.PointerType) uint64 {
+	typePrefix, ok := pointerRegistryTypePrefix(pointerType)
+	if !ok {
+		return 0
+	}
+	return k.GetChainStat(ctx, types.ChainStatsPointerTypeCountKey(typePrefix))
+}
+
+func (k *Keeper) SetPointerCount(ctx sdk.Context, pointerType types.PointerType, count uint64) {
+	if typePrefix, ok := pointerRegistryTypePrefix(pointerType); ok {
+		k.SetChainStat(ctx, types.ChainStatsPointerTypeCountKey(typePrefix), count)
+	}
+}
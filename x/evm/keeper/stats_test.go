@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainStats(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	base, err := q.ChainStats(sdk.WrapSDKContext(ctx), &types.QueryChainStatsRequest{})
+	require.Nil(t, err)
+
+	// re-associating or re-registering an existing entry isn't double counted
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	_, pointer := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", pointer, native.CurrentVersion))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", pointer, native.CurrentVersion+1))
+
+	created := common.Hash{1}
+	called := common.Hash{2}
+	reverted := common.Hash{3}
+	require.Nil(t, k.SetTransientReceipt(ctx, created, &types.Receipt{ContractAddress: evmAddr.Hex(), Status: uint32(ethtypes.ReceiptStatusSuccessful)}))
+	require.Nil(t, k.SetTransientReceipt(ctx, called, &types.Receipt{To: evmAddr.Hex(), ContractAddress: evmAddr.Hex(), Status: uint32(ethtypes.ReceiptStatusSuccessful)}))
+	require.Nil(t, k.SetTransientReceipt(ctx, reverted, &types.Receipt{ContractAddress: pointer.Hex(), Status: uint32(ethtypes.ReceiptStatusFailed)}))
+	k.RecordBlockChainStats(ctx, []*types.DeferredInfo{
+		{TxHash: created[:]}, {TxHash: called[:]}, {TxHash: reverted[:]},
+		// rejected before execution
+		{TxHash: common.Hash{4}.Bytes(), Error: "insufficient funds"},
+	})
+
+	res, err := q.ChainStats(sdk.WrapSDKContext(ctx), &types.QueryChainStatsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, base.EvmTransactions+3, res.EvmTransactions)
+	require.Equal(t, base.ContractsDeployed+1, res.ContractsDeployed)
+	require.Equal(t, base.Associations+1, res.Associations)
+	require.Equal(t, base.Pointers+1, res.Pointers)
+
+	// pointers stop counting once every version is deleted
+	k.DeleteERC20NativePointer(ctx, "ufoo", native.CurrentVersion)
+	require.Equal(t, base.Pointers+1, k.GetChainStat(ctx, types.ChainStatsPointerCountKey))
+	k.DeleteERC20NativePointer(ctx, "ufoo", native.CurrentVersion+1)
+	require.Equal(t, base.Pointers, k.GetChainStat(ctx, types.ChainStatsPointerCountKey))
+	k.DeleteAddressMapping(ctx, seiAddr, evmAddr)
+	require.Equal(t, base.Associations, k.GetChainStat(ctx, types.ChainStatsAssociationCountKey))
+}
+
+func TestAssociationStats(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	base := k.GetChainStat(ctx, types.ChainStatsAssociationCountKey)
+	associate := func(ctx sdk.Context, n int) {
+		for i := 0; i < n; i++ {
+			seiAddr, evmAddr := testkeeper.MockAddressPair()
+			k.SetAddressMapping(ctx, seiAddr, evmAddr)
+		}
+	}
+	ctx = ctx.WithBlockHeight(10)
+	associate(ctx, 2)
+	associate(ctx.WithBlockHeight(11), 1)
+	// a full window later, height 10's slot is reused and its count dropped
+	ctx = ctx.WithBlockHeight(10 + types.AssociationStatsWindow)
+	associate(ctx, 3)
+
+	res, err := q.AssociationStats(sdk.WrapSDKContext(ctx), &types.QueryAssociationStatsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryAssociationStatsResponse{Total: base + 6, AddedLastNBlocks: 4, Blocks: types.AssociationStatsWindow}, *res)
+	res, err = q.AssociationStats(sdk.WrapSDKContext(ctx), &types.QueryAssociationStatsRequest{Blocks: 1})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), res.AddedLastNBlocks)
+	res, err = q.AssociationStats(sdk.WrapSDKContext(ctx.WithBlockHeight(11+types.AssociationStatsWindow)), &types.QueryAssociationStatsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), res.AddedLastNBlocks)
+	_, err = q.AssociationStats(sdk.WrapSDKContext(ctx), &types.QueryAssociationStatsRequest{Blocks: types.AssociationStatsWindow + 1})
+	require.NotNil(t, err)
+}
+
+func TestPointerStats(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	base, err := q.PointerStats(sdk.WrapSDKContext(ctx), &types.QueryPointerStatsRequest{})
+	require.Nil(t, err)
+	require.Len(t, base.Counts, len(types.PointerType_name))
+
+	_, pointer1 := testkeeper.MockAddressPair()
+	_, pointer2 := testkeeper.MockAddressPair()
+	cwPointer, erc20Addr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", pointer1, native.CurrentVersion))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", pointer1, native.CurrentVersion+1))
+	require.Nil(t, k.SetERC20NativePointer(ctx, "ubar", pointer2))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20Addr, cwPointer.String()))
+	k.SetAddressMapping(ctx, cwPointer, erc20Addr)
+
+	res, err := q.PointerStats(sdk.WrapSDKContext(ctx), &types.QueryPointerStatsRequest{})
+	require.Nil(t, err)
+	for i, count := range res.Counts {
+		require.Equal(t, types.PointerType(i), count.PointerType)
+		switch count.PointerType {
+		case types.PointerType_NATIVE:
+			require.Equal(t, base.Counts[i].Count+2, count.Count)
+		case types.PointerType_ERC20:
+			require.Equal(t, base.Counts[i].Count+1, count.Count)
+		default:
+			require.Equal(t, base.Counts[i].Count, count.Count)
+		}
+	}
+	require.Equal(t, base.Total+3, res.Total)
+	require.Equal(t, base.Associations+1, res.Associations)
+
+	k.DeleteERC20NativePointer(ctx, "ubar", native.CurrentVersion)
+	require.Equal(t, base.Counts[types.PointerType_NATIVE].Count+1, k.GetPointerCount(ctx, types.PointerType_NATIVE))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // run init()s to register native tracers
+)
+
+var ErrTracingDisabled = errors.New("tracing is disabled on this node")
+
+// TraceCallTracers are the named tracers TraceCall accepts besides struct
+// logs. JS tracers are deliberately left out since they can run arbitrary
+// code on the node.
+var TraceCallTracers = map[string]struct{}{
+	"callTracer":     {},
+	"prestateTracer": {},
+}
+
+// TraceMessage executes msg against a discarded branch of ctx with tracer
+// attached and returns the tracer's result. The result also describes calls
+// that revert or run out of gas.
+func (k *Keeper) TraceMessage(ctx sdk.Context, msg core.Message, tracer *tracers.Tracer) (json.RawMessage, error) {
+	if _, err := k.simulateEVMMessage(ctx, msg, tracer.Hooks); err != nil {
+		return nil, err
+	}
+	return tracer.GetResult()
+}
+
+// NewStructLogTracer returns a struct logger that only records opcodes
+// executed at most maxDepth calls deep. A maxDepth of 0 records every depth.
+func NewStructLogTracer(cfg *logger.Config, maxDepth int) *tracers.Tracer {
+	structLogger := logger.NewStructLogger(cfg)
+	hooks := structLogger.Hooks()
+	if maxDepth > 0 {
+		onOpcode := hooks.OnOpcode
+		hooks.OnOpcode = func(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+			if depth <= maxDepth {
+				onOpcode(pc, op, gas, cost, scope, rData, depth, err)
+			}
+		}
+	}
+	return &tracers.Tracer{
+		Hooks:     hooks,
+		GetResult: structLogger.GetResult,
+		Stop:      structLogger.Stop,
+	}
+}
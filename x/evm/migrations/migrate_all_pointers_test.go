@@ -80,6 +80,7 @@ func TestMigrateCWERC20Pointers(t *testing.T) {
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
 	require.Nil(t, migrations.StoreCWPointerCode(ctx, &k, true, false, false))
 	msgServer := keeper.NewMsgServerImpl(&k)
+	k.SetCode(ctx, common.Address{}, testkeeper.MockPointeeCode)
 	res, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
 		PointerType: types.PointerType_ERC20,
 		ErcAddress:  "0x0000000000000000000000000000000000000000",
@@ -96,14 +97,15 @@ func TestMigrateCWERC721Pointers(t *testing.T) {
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
 	require.Nil(t, migrations.StoreCWPointerCode(ctx, &k, false, true, false))
 	msgServer := keeper.NewMsgServerImpl(&k)
+	k.SetCode(ctx, common.HexToAddress("0x0000000000000000000000000000000000000721"), testkeeper.MockPointeeCode)
 	res, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
 		PointerType: types.PointerType_ERC721,
-		ErcAddress:  "0x0000000000000000000000000000000000000000",
+		ErcAddress:  "0x0000000000000000000000000000000000000721",
 	})
 	require.Nil(t, err)
 	require.Nil(t, migrations.MigrateCWERC721Pointers(ctx, &k))
 	// address should stay the same
-	addr, _, _ := k.GetCW721ERC721Pointer(ctx, common.HexToAddress("0x0000000000000000000000000000000000000000"))
+	addr, _, _ := k.GetCW721ERC721Pointer(ctx, common.HexToAddress("0x0000000000000000000000000000000000000721"))
 	require.Equal(t, res.PointerAddress, addr.String())
 }
 
@@ -112,13 +114,14 @@ func TestMigrateCWERC1155Pointers(t *testing.T) {
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
 	require.Nil(t, migrations.StoreCWPointerCode(ctx, &k, false, false, true))
 	msgServer := keeper.NewMsgServerImpl(&k)
+	k.SetCode(ctx, common.HexToAddress("0x0000000000000000000000000000000000001155"), testkeeper.MockPointeeCode)
 	res, err := msgServer.RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
 		PointerType: types.PointerType_ERC1155,
-		ErcAddress:  "0x0000000000000000000000000000000000000000",
+		ErcAddress:  "0x0000000000000000000000000000000000001155",
 	})
 	require.Nil(t, err)
 	require.Nil(t, migrations.MigrateCWERC1155Pointers(ctx, &k))
 	// address should stay the same
-	addr, _, _ := k.GetCW1155ERC1155Pointer(ctx, common.HexToAddress("0x0000000000000000000000000000000000000000"))
+	addr, _, _ := k.GetCW1155ERC1155Pointer(ctx, common.HexToAddress("0x0000000000000000000000000000000000001155"))
 	require.Equal(t, res.PointerAddress, addr.String())
 }
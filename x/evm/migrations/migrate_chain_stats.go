@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// MigrateChainStats seeds the association and pointer counters from existing
+// state. Transaction and contract counters can't be recovered from state, so
+// they start counting from the migration height.
+func MigrateChainStats(ctx sdk.Context, k *keeper.Keeper) error {
+	var associations uint64
+	iter := k.PrefixStore(ctx, types.SeiAddressToEVMAddressKeyPrefix).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		associations++
+	}
+	iter.Close()
+
+	// registry keys are the pointee key followed by a 2-byte version
+	var pointers uint64
+	var last []byte
+	iter = k.PrefixStore(ctx, types.PointerRegistryPrefix).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) < 2 {
+			continue
+		}
+		if pointee := key[:len(key)-2]; string(pointee) != string(last) {
+			pointers++
+			last = append([]byte{}, pointee...)
+		}
+	}
+	iter.Close()
+
+	k.SetChainStat(ctx, types.ChainStatsAssociationCountKey, associations)
+	k.SetChainStat(ctx, types.ChainStatsPointerCountKey, pointers)
+	k.SetChainStat(ctx, types.ChainStatsStartHeightKey, uint64(ctx.BlockHeight()))
+	return nil
+}
@@ -0,0 +1,36 @@
+package migrations_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateChainStats(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	seiAddr1, evmAddr1 := testkeeper.MockAddressPair()
+	seiAddr2, evmAddr2 := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr1, evmAddr1)
+	k.SetAddressMapping(ctx, seiAddr2, evmAddr2)
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", evmAddr1, native.CurrentVersion))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", evmAddr1, native.CurrentVersion+1))
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, seiAddr1.String(), evmAddr2))
+	var associations uint64
+	iter := k.PrefixStore(ctx, types.SeiAddressToEVMAddressKeyPrefix).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		associations++
+	}
+	iter.Close()
+	// wipe the counters as if they never existed
+	k.SetChainStat(ctx, types.ChainStatsAssociationCountKey, 0)
+	k.SetChainStat(ctx, types.ChainStatsPointerCountKey, 0)
+
+	require.Nil(t, migrations.MigrateChainStats(ctx, k))
+	require.Equal(t, associations, k.GetChainStat(ctx, types.ChainStatsAssociationCountKey))
+	require.Equal(t, uint64(2), k.GetChainStat(ctx, types.ChainStatsPointerCountKey))
+	require.Equal(t, uint64(ctx.BlockHeight()), k.GetChainStat(ctx, types.ChainStatsStartHeightKey))
+}
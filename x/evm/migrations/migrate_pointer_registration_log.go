@@ -0,0 +1,14 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+)
+
+// MigratePointerRegistrationLog marks registrations before the migration
+// height as missing from the pointer registration log, since they were made
+// before it was kept.
+func MigratePointerRegistrationLog(ctx sdk.Context, k *keeper.Keeper) error {
+	k.SetPointerRegistrationLogPrunedBefore(ctx, ctx.BlockHeight())
+	return nil
+}
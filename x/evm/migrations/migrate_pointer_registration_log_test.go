@@ -0,0 +1,17 @@
+package migrations_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigratePointerRegistrationLog(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithBlockHeight(123)
+	require.Equal(t, int64(0), k.GetPointerRegistrationLogPrunedBefore(ctx))
+	require.Nil(t, migrations.MigratePointerRegistrationLog(ctx, k))
+	require.Equal(t, int64(123), k.GetPointerRegistrationLogPrunedBefore(ctx))
+}
@@ -0,0 +1,13 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+)
+
+// MigratePointerReverseIndex back-fills the reverse index of pointers
+// registered before it was kept.
+func MigratePointerReverseIndex(ctx sdk.Context, k *keeper.Keeper) error {
+	k.RebuildPointerReverseIndex(ctx)
+	return nil
+}
@@ -0,0 +1,44 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigratePointerReverseIndex(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	_, erc20Pointer := testkeeper.MockAddressPair()
+	cw20Pointer, erc20 := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", erc20Pointer, 1))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", erc20Pointer, 2))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, erc20, cw20Pointer.String()))
+
+	// drop the index, as if the pointers were registered before it was kept
+	store := ctx.KVStore(k.GetStoreKey())
+	iter := sdk.KVStorePrefixIterator(store, types.PointerReverseIndexPrefix)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	_, _, exists := k.GetNativePointee(ctx, erc20Pointer.Hex())
+	require.False(t, exists)
+
+	require.Nil(t, migrations.MigratePointerReverseIndex(ctx, k))
+	denom, version, exists := k.GetNativePointee(ctx, erc20Pointer.Hex())
+	require.True(t, exists)
+	require.Equal(t, "ufoo", denom)
+	require.Equal(t, uint16(2), version)
+	pointee, _, exists := k.GetERC20Pointee(ctx, cw20Pointer.String())
+	require.True(t, exists)
+	require.Equal(t, erc20, pointee)
+}
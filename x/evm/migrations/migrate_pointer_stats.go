@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// MigratePointerStats seeds the per-type pointer counters from the pointer
+// registry.
+func MigratePointerStats(ctx sdk.Context, k *keeper.Keeper) error {
+	for i := 0; i < len(types.PointerType_name); i++ {
+		pointerType := types.PointerType(i)
+		store, ok := k.PointerRegistryStore(ctx, pointerType)
+		if !ok {
+			continue
+		}
+		// registry keys are the pointee followed by a 2-byte version
+		var pointers uint64
+		var last []byte
+		iter := store.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()
+			if len(key) < 2 {
+				continue
+			}
+			if pointee := key[:len(key)-2]; last == nil || string(pointee) != string(last) {
+				pointers++
+				last = append([]byte{}, pointee...)
+			}
+		}
+		iter.Close()
+		k.SetPointerCount(ctx, pointerType, pointers)
+	}
+	return nil
+}
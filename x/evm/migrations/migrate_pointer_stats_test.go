@@ -0,0 +1,36 @@
+package migrations_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigratePointerStats(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	seiAddr1, evmAddr1 := testkeeper.MockAddressPair()
+	_, evmAddr2 := testkeeper.MockAddressPair()
+	seiAddr3, evmAddr3 := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", evmAddr1, native.CurrentVersion))
+	require.Nil(t, k.SetERC20NativePointerWithVersion(ctx, "ufoo", evmAddr1, native.CurrentVersion+1))
+	require.Nil(t, k.SetERC20CW20Pointer(ctx, seiAddr1.String(), evmAddr2))
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, evmAddr3, seiAddr3.String()))
+	expected := map[types.PointerType]uint64{}
+	for i := 0; i < len(types.PointerType_name); i++ {
+		expected[types.PointerType(i)] = k.GetPointerCount(ctx, types.PointerType(i))
+		// wipe the counters as if they never existed
+		k.SetPointerCount(ctx, types.PointerType(i), 0)
+	}
+	require.Equal(t, uint64(1), expected[types.PointerType_NATIVE])
+	require.Equal(t, uint64(1), expected[types.PointerType_CW20])
+	require.Equal(t, uint64(1), expected[types.PointerType_ERC20])
+
+	require.Nil(t, migrations.MigratePointerStats(ctx, k))
+	for pointerType, count := range expected {
+		require.Equal(t, count, k.GetPointerCount(ctx, pointerType))
+	}
+}
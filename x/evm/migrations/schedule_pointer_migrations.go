@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// ScheduledPointerTypes are the pointer types SchedulePointerMigrations
+// migrates. Native pointers are left out: upgrading one replaces its decimals
+// with those of the denom's bank metadata, which only an explicit upgrade
+// may do.
+var ScheduledPointerTypes = []types.PointerType{
+	types.PointerType_CW20, types.PointerType_CW721, types.PointerType_CW1155,
+	types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155,
+}
+
+// SchedulePointerMigrations schedules the pointers of every type in
+// ScheduledPointerTypes to be migrated to their latest version. The migration
+// runs in chunks at the end of the following blocks rather than in the
+// upgrade block.
+func SchedulePointerMigrations(ctx sdk.Context, k *keeper.Keeper) error {
+	for _, pointerType := range ScheduledPointerTypes {
+		if err := k.SchedulePointerMigration(ctx, pointerType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
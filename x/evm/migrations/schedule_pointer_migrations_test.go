@@ -0,0 +1,54 @@
+package migrations_test
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulePointerMigrations(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	// a native pointer deployed with fallback decimals, before its denom got
+	// metadata
+	outdated := native.CurrentVersion - 1
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		addr, err := k.UpsertERCNativePointer(ctx, e, "ufoo", utils.ERCMetadata{Name: "foo", Symbol: "FOO", Decimals: 6})
+		if err != nil {
+			return err
+		}
+		k.DeleteERC20NativePointer(ctx, "ufoo", native.CurrentVersion)
+		return k.SetERC20NativePointerWithVersion(ctx, "ufoo", addr, outdated)
+	}, func(string, string) {}))
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "ufoo",
+		Display:    "foo",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ufoo"}, {Denom: "foo", Exponent: 18}},
+	})
+
+	require.Nil(t, migrations.SchedulePointerMigrations(ctx, k))
+	for _, pointerType := range migrations.ScheduledPointerTypes {
+		require.True(t, ctx.KVStore(k.GetStoreKey()).Has(types.PointerMigrationCursorKey(pointerType)))
+	}
+	require.False(t, ctx.KVStore(k.GetStoreKey()).Has(types.PointerMigrationCursorKey(types.PointerType_NATIVE)))
+	// the migrations run one type at a time at the end of blocks
+	for i := 0; i < len(migrations.ScheduledPointerTypes); i++ {
+		k.MigrateScheduledPointers(ctx, 10)
+	}
+	for pointerType := range types.PointerType_name {
+		require.False(t, ctx.KVStore(k.GetStoreKey()).Has(types.PointerMigrationCursorKey(types.PointerType(pointerType))))
+	}
+
+	// the native pointer keeps its decimals
+	addr, version, _ := k.GetERC20NativePointer(ctx, "ufoo")
+	require.Equal(t, outdated, version)
+	decimals, err := k.QueryERCSingleOutput(ctx, "native", addr, "decimals")
+	require.Nil(t, err)
+	require.Equal(t, uint8(6), decimals)
+}
@@ -1,12 +1,10 @@
 package migrations
 
 import (
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc1155"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc20"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/erc721"
-	artifactsutils "github.com/sei-protocol/sei-chain/x/evm/artifacts/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
@@ -17,10 +15,7 @@ func StoreCWPointerCode(ctx sdk.Context, k *keeper.Keeper, store20 bool, store72
 		if err != nil {
 			panic(err)
 		}
-		prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW20ERC20Prefix).Set(
-			artifactsutils.GetVersionBz(erc20.CurrentVersion),
-			artifactsutils.GetCodeIDBz(erc20CodeID),
-		)
+		k.SetStoredPointerCodeID(ctx, types.PointerType_ERC20, erc20.CurrentVersion, erc20CodeID)
 	}
 
 	if store721 {
@@ -28,10 +23,7 @@ func StoreCWPointerCode(ctx sdk.Context, k *keeper.Keeper, store20 bool, store72
 		if err != nil {
 			panic(err)
 		}
-		prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW721ERC721Prefix).Set(
-			artifactsutils.GetVersionBz(erc721.CurrentVersion),
-			artifactsutils.GetCodeIDBz(erc721CodeID),
-		)
+		k.SetStoredPointerCodeID(ctx, types.PointerType_ERC721, erc721.CurrentVersion, erc721CodeID)
 	}
 
 	if store1155 {
@@ -39,10 +31,7 @@ func StoreCWPointerCode(ctx sdk.Context, k *keeper.Keeper, store20 bool, store72
 		if err != nil {
 			panic(err)
 		}
-		prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW1155ERC1155Prefix).Set(
-			artifactsutils.GetVersionBz(erc1155.CurrentVersion),
-			artifactsutils.GetCodeIDBz(erc1155CodeID),
-		)
+		k.SetStoredPointerCodeID(ctx, types.PointerType_ERC1155, erc1155.CurrentVersion, erc1155CodeID)
 	}
 	return nil
 }
@@ -240,6 +240,33 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 		}
 		return migrations.MigrateERCCW1155Pointers(ctx, am.keeper)
 	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 18, func(ctx sdk.Context) error {
+		return migrations.MigrateChainStats(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 19, func(ctx sdk.Context) error {
+		return migrations.MigratePointerStats(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 20, func(ctx sdk.Context) error {
+		return migrations.MigratePointerRegistrationLog(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 21, func(ctx sdk.Context) error {
+		return migrations.SchedulePointerMigrations(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 22, func(ctx sdk.Context) error {
+		return migrations.MigratePointerReverseIndex(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 23, func(ctx sdk.Context) error {
+		if err := migrations.StoreCWPointerCode(ctx, am.keeper, true, false, false); err != nil {
+			return err
+		}
+		return am.keeper.SchedulePointerMigration(ctx, types.PointerType_ERC20)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -277,10 +304,12 @@ func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec) <-
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 18 }
+func (AppModule) ConsensusVersion() uint64 { return types.ConsensusVersion }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.OpenPointerCache(ctx)
+	am.keeper.OpenAddressCache(ctx)
 	// clear tx/tx responses from last block
 	am.keeper.SetMsgs([]*types.MsgEVMTransaction{})
 	am.keeper.SetTxResults([]*abci.ExecTxResult{})
@@ -305,8 +334,13 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 // EndBlock executes all ABCI EndBlock logic respective to the evm module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ClosePointerCache(ctx)
+	am.keeper.CloseAddressCache(ctx)
 	// TODO: remove after all TxHashes have been removed
 	am.keeper.RemoveFirstNTxHashes(ctx, keeper.DefaultTxHashesToRemove)
+	am.keeper.PrunePointerRegistrationLog(ctx, keeper.DefaultPointerRegistrationsToPrune)
+	am.keeper.CreateQueuedIBCDenomPointers(ctx, keeper.DefaultIBCDenomPointersToCreate)
+	am.keeper.MigrateScheduledPointers(ctx, keeper.DefaultPointersToMigrate)
 
 	newBaseFee := am.keeper.AdjustDynamicBaseFeePerGas(ctx, uint64(req.BlockGasUsed))
 	if newBaseFee != nil {
@@ -366,6 +400,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 			}
 		}
 	}
+	am.keeper.RecordBlockChainStats(ctx, evmTxDeferredInfoList)
 	am.keeper.SetBlockBloom(ctx, utils.Map(evmTxDeferredInfoList, func(i *types.DeferredInfo) ethtypes.Bloom { return ethtypes.BytesToBloom(i.TxBloom) }))
 	return []abci.ValidatorUpdate{}
 }
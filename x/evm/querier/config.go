@@ -6,15 +6,40 @@ import (
 )
 
 type Config struct {
-	GasLimit uint64 `mapstructure:"evm_query_gas_limit"`
+	GasLimit     uint64 `mapstructure:"evm_query_gas_limit"`
+	MaxPageLimit uint64 `mapstructure:"evm_query_max_page_limit"`
+	// DisableTracing rejects TraceCall queries
+	DisableTracing bool `mapstructure:"evm_query_disable_tracing"`
+	// MaxTraceSize is the maximum size in bytes of a TraceCall result
+	MaxTraceSize uint64 `mapstructure:"evm_query_max_trace_size"`
+	// MaxTraceStructLogs is the maximum number of opcodes a struct log trace
+	// records
+	MaxTraceStructLogs uint64 `mapstructure:"evm_query_max_trace_struct_logs"`
+	// MaxLogsBlockRange is the maximum number of blocks a Logs query can span
+	MaxLogsBlockRange uint64 `mapstructure:"evm_query_max_logs_block_range"`
+	// MaxContractTxParticipantsBlockRange is the maximum number of blocks a
+	// ContractTxParticipants query can span
+	MaxContractTxParticipantsBlockRange uint64 `mapstructure:"evm_query_max_contract_tx_participants_block_range"`
 }
 
 var DefaultConfig = Config{
-	GasLimit: 300000,
+	GasLimit:                            300000,
+	MaxPageLimit:                        1000,
+	DisableTracing:                      false,
+	MaxTraceSize:                        4 << 20,
+	MaxTraceStructLogs:                  10000,
+	MaxLogsBlockRange:                   2000,
+	MaxContractTxParticipantsBlockRange: 1000,
 }
 
 const (
-	flagGasLimit = "evm_query.evm_query_gas_limit"
+	flagGasLimit                            = "evm_query.evm_query_gas_limit"
+	flagMaxPageLimit                        = "evm_query.evm_query_max_page_limit"
+	flagDisableTracing                      = "evm_query.evm_query_disable_tracing"
+	flagMaxTraceSize                        = "evm_query.evm_query_max_trace_size"
+	flagMaxTraceStructLogs                  = "evm_query.evm_query_max_trace_struct_logs"
+	flagMaxLogsBlockRange                   = "evm_query.evm_query_max_logs_block_range"
+	flagMaxContractTxParticipantsBlockRange = "evm_query.evm_query_max_contract_tx_participants_block_range"
 )
 
 func ReadConfig(opts servertypes.AppOptions) (Config, error) {
@@ -25,5 +50,35 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagMaxPageLimit); v != nil {
+		if cfg.MaxPageLimit, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagDisableTracing); v != nil {
+		if cfg.DisableTracing, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxTraceSize); v != nil {
+		if cfg.MaxTraceSize, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxTraceStructLogs); v != nil {
+		if cfg.MaxTraceStructLogs, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxLogsBlockRange); v != nil {
+		if cfg.MaxLogsBlockRange, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxContractTxParticipantsBlockRange); v != nil {
+		if cfg.MaxContractTxParticipantsBlockRange, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
 	return cfg, nil
 }
@@ -0,0 +1,48 @@
+package retention
+
+import (
+	"errors"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+type Config struct {
+	// RetainBlocks is the number of most recent blocks whose receipts are
+	// kept; older receipts are pruned. 0 keeps all receipts.
+	RetainBlocks int64 `mapstructure:"receipt_retain_blocks"`
+	// PruneBatchSize is the maximum number of receipts pruned per block
+	PruneBatchSize uint64 `mapstructure:"receipt_prune_batch_size"`
+}
+
+var DefaultConfig = Config{
+	RetainBlocks:   0,
+	PruneBatchSize: 1000,
+}
+
+const (
+	flagRetainBlocks   = "receipt_retention.receipt_retain_blocks"
+	flagPruneBatchSize = "receipt_retention.receipt_prune_batch_size"
+)
+
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig // copy
+	var err error
+	if v := opts.Get(flagRetainBlocks); v != nil {
+		if cfg.RetainBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagPruneBatchSize); v != nil {
+		if cfg.PruneBatchSize, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if cfg.RetainBlocks < 0 {
+		return cfg, errors.New("receipt_retain_blocks must not be negative")
+	}
+	if cfg.RetainBlocks > 0 && cfg.PruneBatchSize == 0 {
+		return cfg, errors.New("receipt_prune_batch_size must be positive when receipts are pruned")
+	}
+	return cfg, nil
+}
@@ -27,6 +27,10 @@ func (s *DBImpl) SetCode(addr common.Address, code []byte) {
 	}
 
 	s.k.SetCode(s.ctx, addr, code)
+	// the EVM only sets code when deploying a contract
+	if s.txOrigin != nil && len(code) > 0 {
+		s.k.SetContractCreationInfo(s.ctx, addr, *s.txOrigin, s.txHash)
+	}
 }
 
 func (s *DBImpl) GetCodeSize(addr common.Address) int {
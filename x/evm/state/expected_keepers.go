@@ -19,6 +19,7 @@ type EVMKeeper interface {
 	DeleteAddressMapping(sdk.Context, sdk.AccAddress, common.Address)
 	GetCode(sdk.Context, common.Address) []byte
 	SetCode(sdk.Context, common.Address, []byte)
+	SetContractCreationInfo(ctx sdk.Context, addr common.Address, deployer common.Address, txHash common.Hash)
 	GetCodeHash(sdk.Context, common.Address) common.Hash
 	GetCodeSize(sdk.Context, common.Address) int
 	GetState(sdk.Context, common.Address, common.Hash) common.Hash
@@ -36,6 +36,12 @@ type DBImpl struct {
 	eventsSuppressed bool
 
 	logger *tracing.Hooks
+
+	// account that initiated the transaction and its hash, recorded as the
+	// creation info of contracts deployed by it; nil if creations aren't
+	// recorded
+	txOrigin *common.Address
+	txHash   common.Hash
 }
 
 func NewDBImpl(ctx sdk.Context, k EVMKeeper, simulation bool) *DBImpl {
@@ -72,6 +78,14 @@ func (s *DBImpl) SetLogger(logger *tracing.Hooks) {
 	s.logger = logger
 }
 
+// SetTxOrigin makes contracts deployed through s record origin and txHash as
+// their creation info. txHash may be empty if the contracts are not deployed
+// within a transaction.
+func (s *DBImpl) SetTxOrigin(origin common.Address, txHash common.Hash) {
+	s.txOrigin = &origin
+	s.txHash = txHash
+}
+
 // for interface compliance
 func (s *DBImpl) SetEVM(evm *vm.EVM) {}
 
@@ -37,6 +37,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "evm/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgRegisterPointer{}, "evm/MsgRegisterPointer", nil)
 	cdc.RegisterConcrete(&MsgAssociateContractAddress{}, "evm/MsgAssociateContractAddress", nil)
+	cdc.RegisterConcrete(&MsgUpgradePointer{}, "evm/MsgUpgradePointer", nil)
+	cdc.RegisterConcrete(&MsgRemovePointer{}, "evm/MsgRemovePointer", nil)
+	cdc.RegisterConcrete(&MsgRegisterPointers{}, "evm/MsgRegisterPointers", nil)
+	cdc.RegisterConcrete(&MsgOverridePointer{}, "evm/MsgOverridePointer", nil)
+	cdc.RegisterConcrete(&MsgPausePointer{}, "evm/MsgPausePointer", nil)
+	cdc.RegisterConcrete(&MsgUnpausePointer{}, "evm/MsgUnpausePointer", nil)
+	cdc.RegisterConcrete(&MsgAssociateWithSignature{}, "evm/MsgAssociateWithSignature", nil)
+	cdc.RegisterConcrete(&MsgAssociateWithSignatures{}, "evm/MsgAssociateWithSignatures", nil)
+	cdc.RegisterConcrete(&MsgAssociatePubkey{}, "evm/MsgAssociatePubkey", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -49,6 +58,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&AddCWERC721PointerProposal{},
 		&AddCWERC1155PointerProposal{},
 		&AddERCNativePointerProposalV2{},
+		&RemovePointerProposal{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -56,6 +66,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgRegisterPointer{},
 		&MsgAssociateContractAddress{},
+		&MsgUpgradePointer{},
+		&MsgRemovePointer{},
+		&MsgRegisterPointers{},
+		&MsgOverridePointer{},
+		&MsgPausePointer{},
+		&MsgUnpausePointer{},
+		&MsgAssociateWithSignature{},
+		&MsgAssociateWithSignatures{},
+		&MsgAssociatePubkey{},
 	)
 	registry.RegisterInterface(
 		"seiprotocol.seichain.evm.TxData",
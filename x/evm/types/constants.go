@@ -1,3 +1,26 @@
 package types
 
 const MaxAssociateCustomMessageLength = 64
+
+// ConsensusVersion is the consensus version of the module, bumped with every
+// store migration.
+const ConsensusVersion = 24
+
+// Directions of address association lookups labelling the address cache
+// metrics.
+const (
+	AddressCacheDirectionSeiToEVM = "sei_to_evm"
+	AddressCacheDirectionEVMToSei = "evm_to_sei"
+)
+
+// Pointer operations labelling the pointer metrics.
+const (
+	PointerOpRegister   = "register"
+	PointerOpUpgrade    = "upgrade"
+	PointerOpOverride   = "override"
+	PointerOpPause      = "pause"
+	PointerOpUnpause    = "unpause"
+	PointerOpRemove     = "remove"
+	PointerOpTransfer   = "transfer"
+	PointerOpStaticCall = "static_call"
+)
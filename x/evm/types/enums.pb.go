@@ -60,26 +60,92 @@ func (PointerType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9ba0923a26222f98, []int{0}
 }
 
+// AssociationMechanism is how an association between a Sei address and an
+// EVM address was established.
+type AssociationMechanism int32
+
+const (
+	AssociationMechanism_UNSPECIFIED_ASSOCIATION AssociationMechanism = 0
+	// recovered from the signature of an EVM transaction
+	AssociationMechanism_EVM_TX_SIGNATURE AssociationMechanism = 1
+	// derived from the public key of a Cosmos transaction signer
+	AssociationMechanism_COSMOS_TX_SIGNER AssociationMechanism = 2
+	// an explicit association transaction, i.e. an AssociateTx or MsgAssociate
+	AssociationMechanism_ASSOCIATE_TX AssociationMechanism = 3
+	// an EIP-712 signed association message
+	AssociationMechanism_EIP712_SIGNATURE AssociationMechanism = 4
+	// a MsgAssociatePubkey
+	AssociationMechanism_SUBMITTED_PUBKEY AssociationMechanism = 5
+	// the direct cast address of a contract
+	AssociationMechanism_CONTRACT AssociationMechanism = 6
+	// the addr precompile
+	AssociationMechanism_PRECOMPILE AssociationMechanism = 7
+	// genesis state
+	AssociationMechanism_GENESIS AssociationMechanism = 8
+)
+
+var AssociationMechanism_name = map[int32]string{
+	0: "UNSPECIFIED_ASSOCIATION",
+	1: "EVM_TX_SIGNATURE",
+	2: "COSMOS_TX_SIGNER",
+	3: "ASSOCIATE_TX",
+	4: "EIP712_SIGNATURE",
+	5: "SUBMITTED_PUBKEY",
+	6: "CONTRACT",
+	7: "PRECOMPILE",
+	8: "GENESIS",
+}
+
+var AssociationMechanism_value = map[string]int32{
+	"UNSPECIFIED_ASSOCIATION": 0,
+	"EVM_TX_SIGNATURE":        1,
+	"COSMOS_TX_SIGNER":        2,
+	"ASSOCIATE_TX":            3,
+	"EIP712_SIGNATURE":        4,
+	"SUBMITTED_PUBKEY":        5,
+	"CONTRACT":                6,
+	"PRECOMPILE":              7,
+	"GENESIS":                 8,
+}
+
+func (x AssociationMechanism) String() string {
+	return proto.EnumName(AssociationMechanism_name, int32(x))
+}
+
+func (AssociationMechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ba0923a26222f98, []int{1}
+}
+
 func init() {
 	proto.RegisterEnum("seiprotocol.seichain.evm.PointerType", PointerType_name, PointerType_value)
+	proto.RegisterEnum("seiprotocol.seichain.evm.AssociationMechanism", AssociationMechanism_name, AssociationMechanism_value)
 }
 
 func init() { proto.RegisterFile("evm/enums.proto", fileDescriptor_9ba0923a26222f98) }
 
 var fileDescriptor_9ba0923a26222f98 = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x2d, 0xcb, 0xd5,
-	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x28, 0x4e, 0xcd,
-	0x04, 0xb3, 0x92, 0xf3, 0x73, 0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x52,
-	0xcb, 0x72, 0xb5, 0xe2, 0xb8, 0xb8, 0x03, 0xf2, 0x33, 0xf3, 0x4a, 0x52, 0x8b, 0x42, 0x2a, 0x0b,
-	0x52, 0x85, 0x38, 0xb9, 0x58, 0x5d, 0x83, 0x9c, 0x8d, 0x0c, 0x04, 0x18, 0x84, 0xb8, 0xb8, 0xd8,
-	0x5c, 0x83, 0x9c, 0xcd, 0x8d, 0x0c, 0x05, 0x18, 0x41, 0x6c, 0x3f, 0xc7, 0x10, 0xcf, 0x30, 0x57,
-	0x01, 0x26, 0x21, 0x0e, 0x2e, 0x16, 0xe7, 0x70, 0x23, 0x03, 0x01, 0x66, 0x90, 0x62, 0xe7, 0x70,
-	0x90, 0x02, 0x16, 0x21, 0x6e, 0x2e, 0x76, 0xd7, 0x20, 0x67, 0x43, 0x43, 0x53, 0x53, 0x01, 0x56,
-	0x90, 0x6a, 0xe7, 0x70, 0x30, 0x9b, 0xcd, 0xc9, 0xfd, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0x74, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b,
-	0x53, 0x33, 0x75, 0x61, 0xee, 0x03, 0x73, 0xc0, 0x0e, 0xd4, 0xaf, 0xd0, 0x07, 0x79, 0xa4, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x2c, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x3a,
-	0x55, 0xe4, 0xdc, 0x00, 0x00, 0x00,
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xdf, 0x6a, 0xdb, 0x30,
+	0x18, 0xc5, 0xed, 0x36, 0x71, 0x33, 0xb5, 0x6c, 0x42, 0x14, 0x36, 0x18, 0xf8, 0x01, 0x0a, 0xb5,
+	0x6b, 0x8f, 0xd2, 0x6b, 0x47, 0xfd, 0x16, 0xc4, 0xe6, 0x3f, 0x58, 0x72, 0x93, 0xed, 0x62, 0x26,
+	0x31, 0x62, 0x11, 0xcc, 0x76, 0x88, 0x9c, 0xb0, 0xbc, 0xc5, 0x1e, 0x2b, 0x97, 0xb9, 0xdc, 0xe5,
+	0x48, 0x5e, 0x64, 0xc8, 0x10, 0xe8, 0xdd, 0xd1, 0x39, 0xe7, 0x77, 0xa1, 0xf3, 0xa1, 0x77, 0x72,
+	0x5b, 0xfb, 0xb2, 0xd9, 0xd4, 0xda, 0x5b, 0xad, 0xdb, 0xae, 0x25, 0x1f, 0xb4, 0x54, 0xbd, 0xaa,
+	0xda, 0x5f, 0x9e, 0x96, 0xaa, 0x5a, 0xce, 0x55, 0xe3, 0xc9, 0x6d, 0x7d, 0xf7, 0x03, 0x5d, 0x67,
+	0xad, 0x6a, 0x3a, 0xb9, 0x16, 0xbb, 0x95, 0x24, 0x6f, 0xd0, 0x10, 0x72, 0x1a, 0x3e, 0x60, 0x8b,
+	0x20, 0xe4, 0x40, 0x4e, 0x9f, 0xc2, 0x00, 0xdb, 0x46, 0x27, 0x91, 0x60, 0x2f, 0x80, 0x2f, 0xc8,
+	0x08, 0x0d, 0xe8, 0x34, 0x7c, 0xc0, 0x97, 0xa6, 0x4c, 0xa7, 0xa6, 0x30, 0x20, 0xd7, 0xe8, 0x0a,
+	0x72, 0x1a, 0x04, 0x8f, 0x8f, 0x78, 0x68, 0xda, 0x74, 0xda, 0x6b, 0xe7, 0x6e, 0x6f, 0xa3, 0xdb,
+	0x48, 0xeb, 0xb6, 0x52, 0xf3, 0x4e, 0xb5, 0x4d, 0x2c, 0xab, 0xe5, 0xbc, 0x51, 0xba, 0x26, 0x1f,
+	0xd1, 0xfb, 0x22, 0xe1, 0x19, 0x50, 0xf6, 0x99, 0xc1, 0x73, 0x19, 0x71, 0x9e, 0x52, 0x16, 0x09,
+	0x96, 0x26, 0xd8, 0x22, 0xb7, 0x08, 0xc3, 0x4b, 0x5c, 0x8a, 0x59, 0xc9, 0xd9, 0x24, 0x89, 0x44,
+	0x91, 0x03, 0xb6, 0x8d, 0x4b, 0x53, 0x1e, 0xa7, 0xfc, 0x1c, 0x40, 0x8e, 0x2f, 0x08, 0x46, 0x37,
+	0x67, 0x18, 0x4a, 0x31, 0xc3, 0x97, 0x3d, 0xcd, 0xb2, 0xa7, 0x20, 0x7c, 0x45, 0x0f, 0x8c, 0xcb,
+	0x8b, 0x71, 0xcc, 0x84, 0x80, 0xe7, 0x32, 0x2b, 0xc6, 0x5f, 0xe0, 0x1b, 0x1e, 0x92, 0x1b, 0x34,
+	0xa2, 0x69, 0x22, 0xf2, 0x88, 0x0a, 0xec, 0x90, 0xb7, 0x08, 0x65, 0x39, 0xd0, 0x34, 0xce, 0xd8,
+	0x57, 0xc0, 0x57, 0xe6, 0x5b, 0x13, 0x48, 0x80, 0x33, 0x8e, 0x47, 0xe3, 0xc9, 0xfe, 0xe8, 0xda,
+	0x87, 0xa3, 0x6b, 0xff, 0x3b, 0xba, 0xf6, 0x9f, 0x93, 0x6b, 0x1d, 0x4e, 0xae, 0xf5, 0xf7, 0xe4,
+	0x5a, 0xdf, 0xef, 0x7f, 0xaa, 0x6e, 0xb9, 0x59, 0x78, 0x55, 0x5b, 0xfb, 0x5a, 0xaa, 0xfb, 0xf3,
+	0xd4, 0xfd, 0xa3, 0xdf, 0xda, 0xff, 0xed, 0x9b, 0x9b, 0x74, 0xbb, 0x95, 0xd4, 0x0b, 0xa7, 0xcf,
+	0x3f, 0xfd, 0x1f, 0x00, 0x04, 0xe1, 0x4c, 0x7d, 0xa7, 0x01, 0x00, 0x00,
 }
@@ -1,10 +1,36 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/ethereum/go-ethereum/core/vm"
 )
 
+// ErrHeightNotAvailable is returned for queries at heights whose state has
+// been pruned or was never committed.
+var ErrHeightNotAvailable = errors.New("height not available")
+
+// ErrReceiptNotFound is returned for transactions the node has no receipt for.
+var ErrReceiptNotFound = errors.New("not found")
+
+// ErrReceiptPruned is returned for receipts of blocks that fall outside of the
+// node's receipt retention window.
+var ErrReceiptPruned = errors.New("receipt pruned")
+
+// ErrPointerPaused is returned when a pointer paused by governance attempts
+// to forward a state-changing call to its pointee.
+var ErrPointerPaused = errors.New("pointer paused")
+
+// ErrEd25519PubkeyAssociation is returned when an ed25519 public key is
+// submitted for association, since EVM addresses derive from secp256k1 keys.
+var ErrEd25519PubkeyAssociation = errors.New("ed25519 public keys cannot be associated with an EVM address")
+
+// ErrPubkeyAddressMismatch is returned when a submitted public key does not
+// hash to the address of the sender.
+var ErrPubkeyAddressMismatch = errors.New("public key does not match the sender address")
+
 type AssociationMissingErr struct {
 	Address string
 }
@@ -23,3 +49,21 @@ func (e AssociationMissingErr) AddressType() string {
 	}
 	return "sei"
 }
+
+// RevertError is returned when an EVM call reverts and carries the revert
+// data. It matches vm.ErrExecutionReverted with errors.Is.
+type RevertError struct {
+	Data []byte
+}
+
+func NewRevertError(data []byte) *RevertError {
+	return &RevertError{Data: data}
+}
+
+func (e *RevertError) Error() string {
+	return vm.ErrExecutionReverted.Error()
+}
+
+func (e *RevertError) Unwrap() error {
+	return vm.ErrExecutionReverted
+}
@@ -3,12 +3,37 @@ package types
 const (
 	EventTypeAddressAssociated = "address_associated"
 	EventTypePointerRegistered = "pointer_registered"
+	EventTypePointerUpgraded   = "pointer_upgraded"
+	EventTypePointerRemoved    = "pointer_removed"
+	EventTypePointerOverridden = "pointer_overridden"
+	EventTypePointerPaused     = "pointer_paused"
+	EventTypePointerUnpaused   = "pointer_unpaused"
 	EventTypeSigner            = "signer"
 
+	EventTypePointerRegistrationFee    = "pointer_registration_fee"
+	EventTypePointerRegistrationResult = "pointer_registration_result"
+	EventTypeAssociationResult         = "association_result"
+	EventTypePointerDecimalsWarning    = "pointer_decimals_warning"
+	EventTypePointerMigrationFailed    = "pointer_migration_failed"
+	EventTypePointerMigrationSummary   = "pointer_migration_summary"
+
 	AttributeKeySeiAddress     = "sei_addr"
 	AttributeKeyEvmAddress     = "evm_addr"
 	AttributeKeyPointerType    = "pointer_type"
 	AttributeKeyPointee        = "pointee"
 	AttributeKeyPointerAddress = "pointer_address"
+	AttributeKeyOldPointer     = "old_pointer_address"
 	AttributeKeyPointerVersion = "pointer_version"
+	AttributeKeyOldVersion     = "old_version"
+	AttributeKeyNewVersion     = "new_version"
+	AttributeKeyTombstoned     = "tombstoned"
+	AttributeKeyPayer          = "payer"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyIndex          = "index"
+	AttributeKeyGasUsed        = "gas_used"
+	AttributeKeyError          = "error"
+	AttributeKeyDecimals       = "decimals"
+	AttributeKeyMigrated       = "migrated"
+	AttributeKeyFailed         = "failed"
+	AttributeKeyDone           = "done"
 )
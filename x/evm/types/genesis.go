@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
@@ -7,7 +15,138 @@ func DefaultGenesis() *GenesisState {
 }
 
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateAddressAssociations(gs.AddressAssociations); err != nil {
+		return err
+	}
+	if err := ValidatePointerRegistries(gs.PointerRegistries); err != nil {
+		return err
+	}
+	return ValidatePointerCodeIDs(gs.PointerCodeIds)
+}
+
+// ValidateAddressAssociations checks that associations are well-formed and
+// that no address is associated more than once.
+func ValidateAddressAssociations(associations []*AddressAssociation) error {
+	seiAddresses := make(map[string]struct{}, len(associations))
+	evmAddresses := make(map[common.Address]struct{}, len(associations))
+	for _, association := range associations {
+		seiAddress, err := sdk.AccAddressFromBech32(association.SeiAddress)
+		if err != nil {
+			return fmt.Errorf("invalid sei address %s in address association: %w", association.SeiAddress, err)
+		}
+		if !common.IsHexAddress(association.EthAddress) {
+			return fmt.Errorf("invalid evm address %s in address association", association.EthAddress)
+		}
+		if association.AssociatedAtHeight < 0 {
+			return fmt.Errorf("negative association height for %s", association.SeiAddress)
+		}
+		if _, ok := seiAddresses[seiAddress.String()]; ok {
+			return fmt.Errorf("sei address %s is associated more than once", association.SeiAddress)
+		}
+		evmAddress := common.HexToAddress(association.EthAddress)
+		if _, ok := evmAddresses[evmAddress]; ok {
+			return fmt.Errorf("evm address %s is associated more than once", association.EthAddress)
+		}
+		seiAddresses[seiAddress.String()] = struct{}{}
+		evmAddresses[evmAddress] = struct{}{}
+	}
+	return nil
+}
+
+// ValidatePointerRegistries checks that pointer registry entries are
+// well-formed and consistent, i.e. that every pointer is registered for a
+// single pointee.
+func ValidatePointerRegistries(registries []*PointerRegistry) error {
+	seenTypes := map[PointerType]struct{}{}
+	// keyed by the pointer address the reverse registry is keyed by
+	pointees := map[common.Address]string{}
+	for _, registry := range registries {
+		if _, ok := PointerType_name[int32(registry.PointerType)]; !ok {
+			return fmt.Errorf("invalid pointer type %d", registry.PointerType)
+		}
+		if _, ok := seenTypes[registry.PointerType]; ok {
+			return fmt.Errorf("duplicate %s pointer registry", registry.PointerType)
+		}
+		seenTypes[registry.PointerType] = struct{}{}
+		seenEntries := map[string]struct{}{}
+		for _, entry := range registry.Entries {
+			reverseAddr, err := validatePointerEntry(registry.PointerType, entry)
+			if err != nil {
+				return err
+			}
+			entryKey := fmt.Sprintf("%s/%d", entry.Pointee, entry.Version)
+			if _, ok := seenEntries[entryKey]; ok {
+				return fmt.Errorf("duplicate %s pointer of %s at version %d", registry.PointerType, entry.Pointee, entry.Version)
+			}
+			seenEntries[entryKey] = struct{}{}
+			pointee := fmt.Sprintf("%s pointee %s", registry.PointerType, entry.Pointee)
+			if existing, ok := pointees[reverseAddr]; ok && existing != pointee {
+				return fmt.Errorf("pointer %s is registered for both %s and %s", entry.Pointer, existing, pointee)
+			}
+			pointees[reverseAddr] = pointee
+		}
+	}
+	return nil
+}
+
+// validatePointerEntry checks the address formats of a pointer registry entry
+// and returns the address of the pointer as keyed in the reverse registry.
+func validatePointerEntry(pointerType PointerType, entry *PointerEntry) (common.Address, error) {
+	if entry.Version > math.MaxUint16 {
+		return common.Address{}, fmt.Errorf("invalid version %d of %s pointer %s", entry.Version, pointerType, entry.Pointer)
+	}
+	switch pointerType {
+	case PointerType_ERC20, PointerType_ERC721, PointerType_ERC1155:
+		if !common.IsHexAddress(entry.Pointee) {
+			return common.Address{}, fmt.Errorf("invalid %s pointee %s", pointerType, entry.Pointee)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Pointer); err != nil {
+			return common.Address{}, fmt.Errorf("invalid %s pointer %s: %w", pointerType, entry.Pointer, err)
+		}
+		return common.BytesToAddress([]byte(entry.Pointer)), nil
+	case PointerType_NATIVE:
+		if err := sdk.ValidateDenom(entry.Pointee); err != nil {
+			return common.Address{}, fmt.Errorf("invalid %s pointee %s: %w", pointerType, entry.Pointee, err)
+		}
+	default:
+		if _, err := sdk.AccAddressFromBech32(entry.Pointee); err != nil {
+			return common.Address{}, fmt.Errorf("invalid %s pointee %s: %w", pointerType, entry.Pointee, err)
+		}
+	}
+	if !common.IsHexAddress(entry.Pointer) {
+		return common.Address{}, fmt.Errorf("invalid %s pointer %s", pointerType, entry.Pointer)
+	}
+	return common.HexToAddress(entry.Pointer), nil
+}
+
+// ValidatePointerCodeIDs checks that code IDs are only set for the pointer
+// types that have CW pointer code, once per version.
+func ValidatePointerCodeIDs(pointerCodeIDs []*PointerCodeIDs) error {
+	seen := map[string]struct{}{}
+	for _, codeIDs := range pointerCodeIDs {
+		switch codeIDs.PointerType {
+		case PointerType_ERC20, PointerType_ERC721, PointerType_ERC1155:
+		default:
+			return fmt.Errorf("pointer type %s has no CW pointer code", codeIDs.PointerType)
+		}
+		for _, codeID := range codeIDs.CodeIds {
+			if codeID.Version > math.MaxUint16 {
+				return fmt.Errorf("invalid version %d of %s pointer code", codeID.Version, codeIDs.PointerType)
+			}
+			if codeID.CodeId == 0 {
+				return fmt.Errorf("missing code ID of %s pointer code version %d", codeIDs.PointerType, codeID.Version)
+			}
+			key := fmt.Sprintf("%s/%d", codeIDs.PointerType, codeID.Version)
+			if _, ok := seen[key]; ok {
+				return fmt.Errorf("duplicate code ID of %s pointer code version %d", codeIDs.PointerType, codeID.Version)
+			}
+			seen[key] = struct{}{}
+		}
+	}
+	return nil
 }
 
 func ValidateStream(gensisStateCh <-chan GenesisState) error {
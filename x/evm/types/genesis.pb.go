@@ -25,8 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // AddressAssociation represents an association between a Cosmos and an Ethereum address.
 type AddressAssociation struct {
-	SeiAddress string `protobuf:"bytes,1,opt,name=sei_address,json=seiAddress,proto3" json:"sei_address,omitempty"`
-	EthAddress string `protobuf:"bytes,2,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	SeiAddress         string `protobuf:"bytes,1,opt,name=sei_address,json=seiAddress,proto3" json:"sei_address,omitempty"`
+	EthAddress         string `protobuf:"bytes,2,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	AssociatedAtHeight int64  `protobuf:"varint,3,opt,name=associated_at_height,json=associatedAtHeight,proto3" json:"associated_at_height,omitempty"`
+	AssociatedInTx     string `protobuf:"bytes,4,opt,name=associated_in_tx,json=associatedInTx,proto3" json:"associated_in_tx,omitempty"`
 }
 
 func (m *AddressAssociation) Reset()         { *m = AddressAssociation{} }
@@ -76,6 +78,20 @@ func (m *AddressAssociation) GetEthAddress() string {
 	return ""
 }
 
+func (m *AddressAssociation) GetAssociatedAtHeight() int64 {
+	if m != nil {
+		return m.AssociatedAtHeight
+	}
+	return 0
+}
+
+func (m *AddressAssociation) GetAssociatedInTx() string {
+	if m != nil {
+		return m.AssociatedInTx
+	}
+	return ""
+}
+
 type Code struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Code    []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
@@ -300,6 +316,114 @@ func (m *Serialized) GetValue() []byte {
 	return nil
 }
 
+// PointerRegistry lists the pointers registered for pointees of one pointer
+// type, with one entry per registered version.
+type PointerRegistry struct {
+	PointerType PointerType     `protobuf:"varint,1,opt,name=pointer_type,json=pointerType,proto3,enum=seiprotocol.seichain.evm.PointerType" json:"pointer_type,omitempty"`
+	Entries     []*PointerEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *PointerRegistry) Reset()         { *m = PointerRegistry{} }
+func (m *PointerRegistry) String() string { return proto.CompactTextString(m) }
+func (*PointerRegistry) ProtoMessage()    {}
+func (*PointerRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f044f30507c97ed, []int{5}
+}
+func (m *PointerRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointerRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointerRegistry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointerRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointerRegistry.Merge(m, src)
+}
+func (m *PointerRegistry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointerRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointerRegistry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointerRegistry proto.InternalMessageInfo
+
+func (m *PointerRegistry) GetPointerType() PointerType {
+	if m != nil {
+		return m.PointerType
+	}
+	return PointerType_ERC20
+}
+
+func (m *PointerRegistry) GetEntries() []*PointerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// PointerCodeIDs lists the code IDs of every stored version of the CW pointer
+// code of one pointer type.
+type PointerCodeIDs struct {
+	PointerType PointerType      `protobuf:"varint,1,opt,name=pointer_type,json=pointerType,proto3,enum=seiprotocol.seichain.evm.PointerType" json:"pointer_type,omitempty"`
+	CodeIds     []*PointerCodeID `protobuf:"bytes,2,rep,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+}
+
+func (m *PointerCodeIDs) Reset()         { *m = PointerCodeIDs{} }
+func (m *PointerCodeIDs) String() string { return proto.CompactTextString(m) }
+func (*PointerCodeIDs) ProtoMessage()    {}
+func (*PointerCodeIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f044f30507c97ed, []int{6}
+}
+func (m *PointerCodeIDs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointerCodeIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointerCodeIDs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointerCodeIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointerCodeIDs.Merge(m, src)
+}
+func (m *PointerCodeIDs) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointerCodeIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointerCodeIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointerCodeIDs proto.InternalMessageInfo
+
+func (m *PointerCodeIDs) GetPointerType() PointerType {
+	if m != nil {
+		return m.PointerType
+	}
+	return PointerType_ERC20
+}
+
+func (m *PointerCodeIDs) GetCodeIds() []*PointerCodeID {
+	if m != nil {
+		return m.CodeIds
+	}
+	return nil
+}
+
 // GenesisState defines the evm module's genesis state.
 type GenesisState struct {
 	Params              Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -308,13 +432,15 @@ type GenesisState struct {
 	States              []*ContractState      `protobuf:"bytes,4,rep,name=states,proto3" json:"states,omitempty"`
 	Nonces              []*Nonce              `protobuf:"bytes,5,rep,name=nonces,proto3" json:"nonces,omitempty"`
 	Serialized          []*Serialized         `protobuf:"bytes,6,rep,name=serialized,proto3" json:"serialized,omitempty"`
+	PointerRegistries   []*PointerRegistry    `protobuf:"bytes,7,rep,name=pointer_registries,json=pointerRegistries,proto3" json:"pointer_registries,omitempty"`
+	PointerCodeIds      []*PointerCodeIDs     `protobuf:"bytes,8,rep,name=pointer_code_ids,json=pointerCodeIds,proto3" json:"pointer_code_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f044f30507c97ed, []int{5}
+	return fileDescriptor_9f044f30507c97ed, []int{7}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,48 +511,77 @@ func (m *GenesisState) GetSerialized() []*Serialized {
 	return nil
 }
 
+func (m *GenesisState) GetPointerRegistries() []*PointerRegistry {
+	if m != nil {
+		return m.PointerRegistries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointerCodeIds() []*PointerCodeIDs {
+	if m != nil {
+		return m.PointerCodeIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AddressAssociation)(nil), "seiprotocol.seichain.evm.AddressAssociation")
 	proto.RegisterType((*Code)(nil), "seiprotocol.seichain.evm.Code")
 	proto.RegisterType((*ContractState)(nil), "seiprotocol.seichain.evm.ContractState")
 	proto.RegisterType((*Nonce)(nil), "seiprotocol.seichain.evm.Nonce")
 	proto.RegisterType((*Serialized)(nil), "seiprotocol.seichain.evm.Serialized")
+	proto.RegisterType((*PointerRegistry)(nil), "seiprotocol.seichain.evm.PointerRegistry")
+	proto.RegisterType((*PointerCodeIDs)(nil), "seiprotocol.seichain.evm.PointerCodeIDs")
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.evm.GenesisState")
 }
 
 func init() { proto.RegisterFile("evm/genesis.proto", fileDescriptor_9f044f30507c97ed) }
 
 var fileDescriptor_9f044f30507c97ed = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x13, 0xf7, 0x87, 0xf8, 0x12, 0xa1, 0x8e, 0x41, 0x96, 0x1e, 0x36, 0x65, 0x11, 0xec,
-	0xc1, 0xee, 0x42, 0x2d, 0xf4, 0xa6, 0xb4, 0x15, 0x7a, 0x11, 0xd1, 0x29, 0x78, 0xf0, 0x12, 0xa6,
-	0xbb, 0xcf, 0x64, 0x30, 0xd9, 0x09, 0xfb, 0xa6, 0xa1, 0xf5, 0xaf, 0xf0, 0x9f, 0xf0, 0x7f, 0xe9,
-	0xb1, 0x47, 0x4f, 0x22, 0xc9, 0x3f, 0x22, 0xf3, 0x76, 0xfb, 0x43, 0xca, 0x86, 0xde, 0xde, 0xdb,
-	0xf9, 0x7e, 0x3f, 0xcc, 0xe4, 0x43, 0xe0, 0x19, 0x2e, 0x66, 0xd9, 0x18, 0x4b, 0x24, 0x4d, 0xe9,
-	0xbc, 0x32, 0xd6, 0x88, 0x88, 0x50, 0xf3, 0x94, 0x9b, 0x69, 0x4a, 0xa8, 0xf3, 0x89, 0xd2, 0x65,
-	0x8a, 0x8b, 0xd9, 0xe6, 0x60, 0x6c, 0xc6, 0x86, 0x8f, 0x32, 0x37, 0xd5, 0xf9, 0xcd, 0x0d, 0x87,
-	0x98, 0xab, 0x4a, 0xcd, 0x1a, 0x42, 0xf2, 0x05, 0xc4, 0x41, 0x51, 0x54, 0x48, 0x74, 0x40, 0x64,
-	0x72, 0xad, 0xac, 0x36, 0xa5, 0x18, 0x42, 0x8f, 0x50, 0x8f, 0x54, 0x7d, 0x12, 0x75, 0xb7, 0xba,
-	0xdb, 0x4f, 0x24, 0x10, 0xea, 0x26, 0xeb, 0x02, 0x68, 0x27, 0x37, 0x81, 0x47, 0x75, 0x00, 0xed,
-	0xa4, 0x09, 0x24, 0x7b, 0xe0, 0x1f, 0x99, 0x02, 0x45, 0x04, 0x8f, 0xff, 0xa7, 0x5c, 0xaf, 0x42,
-	0x80, 0x9f, 0x9b, 0x02, 0xb9, 0xdb, 0x97, 0x3c, 0x27, 0x9f, 0xe1, 0xe9, 0x91, 0x29, 0x6d, 0xa5,
-	0x72, 0x7b, 0x62, 0x95, 0x5d, 0x57, 0xdf, 0x00, 0xef, 0x3b, 0x5e, 0x34, 0x6d, 0x37, 0x8a, 0x01,
-	0x04, 0x0b, 0x35, 0x3d, 0xc3, 0xc8, 0xe3, 0x6f, 0xf5, 0x92, 0xec, 0x43, 0xf0, 0xd1, 0x94, 0xf9,
-	0x3a, 0xd4, 0x00, 0x82, 0xd2, 0x45, 0x18, 0xe6, 0xcb, 0x7a, 0x49, 0x3e, 0x00, 0x9c, 0x60, 0xa5,
-	0xd5, 0x54, 0xff, 0xc0, 0x42, 0xbc, 0x80, 0x70, 0x5e, 0xe1, 0x37, 0x7d, 0xce, 0xe5, 0xbe, 0x6c,
-	0xb6, 0x07, 0x5f, 0xe3, 0x97, 0x07, 0xfd, 0xe3, 0xda, 0x5d, 0xfd, 0xb2, 0xb7, 0x10, 0xd6, 0x22,
-	0x18, 0xd8, 0xdb, 0xdd, 0x4a, 0xdb, 0x5c, 0xa6, 0x9f, 0x38, 0x77, 0xe8, 0x5f, 0xfe, 0x19, 0x76,
-	0x64, 0xd3, 0x12, 0x23, 0x18, 0x34, 0xf7, 0x1f, 0xa9, 0x5b, 0x73, 0x4e, 0x85, 0xb7, 0xdd, 0xdb,
-	0x7d, 0xdd, 0x4e, 0xbb, 0xaf, 0x5b, 0x3e, 0x57, 0xf7, 0xbe, 0x91, 0xd8, 0x83, 0xc0, 0x39, 0xa1,
-	0xc8, 0x63, 0x62, 0xdc, 0x4e, 0x74, 0xa2, 0x65, 0x1d, 0x16, 0xef, 0x20, 0x24, 0xf7, 0x3e, 0x8a,
-	0x7c, 0xae, 0xbd, 0x5a, 0x57, 0xbb, 0x63, 0x5a, 0x36, 0x35, 0xb1, 0x0f, 0x21, 0xff, 0xfe, 0x14,
-	0x05, 0x0c, 0x18, 0xb6, 0x03, 0xd8, 0xab, 0x6c, 0xe2, 0xe2, 0x3d, 0x00, 0xdd, 0xf8, 0x8a, 0x42,
-	0x2e, 0xbf, 0x6c, 0x2f, 0xdf, 0xba, 0x95, 0x77, 0x7a, 0x87, 0xc7, 0x97, 0xcb, 0xb8, 0x7b, 0xb5,
-	0x8c, 0xbb, 0x7f, 0x97, 0x71, 0xf7, 0xe7, 0x2a, 0xee, 0x5c, 0xad, 0xe2, 0xce, 0xef, 0x55, 0xdc,
-	0xf9, 0xba, 0x33, 0xd6, 0x76, 0x72, 0x76, 0x9a, 0xe6, 0x66, 0x96, 0x11, 0xea, 0x9d, 0x6b, 0x2c,
-	0x2f, 0xcc, 0xcd, 0xce, 0x33, 0xf7, 0xff, 0xb2, 0x17, 0x73, 0xa4, 0xd3, 0x90, 0xcf, 0xdf, 0xfc,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x58, 0x59, 0xc2, 0xfb, 0xb6, 0x03, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7f, 0x9c, 0xa4, 0xff, 0x9b, 0x90, 0xa6, 0x43, 0x84, 0xac, 0x2e, 0xdc, 0xca,
+	0xe2, 0x23, 0x48, 0x34, 0x41, 0xa5, 0x52, 0x77, 0x40, 0x3f, 0x50, 0x5b, 0x09, 0x21, 0x98, 0x76,
+	0x81, 0xd8, 0x58, 0xae, 0x7d, 0x49, 0x46, 0x34, 0x1e, 0xe3, 0x99, 0x54, 0x09, 0x4f, 0xc1, 0x0b,
+	0xc0, 0x43, 0xf0, 0x14, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0xa1, 0xf6, 0x45, 0xd0, 0xcc, 0xd8, 0x4d,
+	0xaa, 0x2a, 0x49, 0x17, 0xec, 0xe6, 0xce, 0x3d, 0xe7, 0xa7, 0xeb, 0xcc, 0xb9, 0x81, 0x25, 0x3c,
+	0xed, 0x77, 0xba, 0x18, 0xa3, 0x60, 0xa2, 0x9d, 0xa4, 0x5c, 0x72, 0xe2, 0x08, 0x64, 0xfa, 0x14,
+	0xf2, 0x93, 0xb6, 0x40, 0x16, 0xf6, 0x02, 0x16, 0xb7, 0xf1, 0xb4, 0xbf, 0xdc, 0xec, 0xf2, 0x2e,
+	0xd7, 0xad, 0x8e, 0x3a, 0x19, 0xfd, 0xf2, 0xa2, 0x42, 0x60, 0x3c, 0xe8, 0x67, 0x80, 0xe5, 0x86,
+	0xba, 0x48, 0x82, 0x34, 0xe8, 0x8b, 0x49, 0xc9, 0xe7, 0x01, 0xa6, 0x23, 0x73, 0xe1, 0xfd, 0xb0,
+	0x80, 0x6c, 0x45, 0x51, 0x8a, 0x42, 0x6c, 0x09, 0xc1, 0x43, 0x16, 0x48, 0xc6, 0x63, 0xb2, 0x02,
+	0x55, 0x81, 0xcc, 0x0f, 0x4c, 0xc7, 0xb1, 0x56, 0xad, 0xd6, 0xff, 0x14, 0x04, 0xb2, 0x4c, 0xab,
+	0x04, 0x28, 0x7b, 0x57, 0x82, 0xff, 0x8c, 0x00, 0x65, 0x2f, 0x17, 0x3c, 0x85, 0x66, 0x90, 0x01,
+	0x31, 0xf2, 0x03, 0xe9, 0xf7, 0x90, 0x75, 0x7b, 0xd2, 0x29, 0xae, 0x5a, 0xad, 0x22, 0x25, 0xe3,
+	0xde, 0x96, 0xdc, 0xd7, 0x1d, 0xd2, 0x82, 0xc6, 0x84, 0x83, 0xc5, 0xbe, 0x1c, 0x3a, 0xb6, 0xe6,
+	0xd6, 0xc7, 0xf7, 0x07, 0xf1, 0xd1, 0xd0, 0xdb, 0x00, 0x7b, 0x87, 0x47, 0x48, 0x1c, 0xa8, 0x5c,
+	0x9f, 0x30, 0x2f, 0x09, 0x01, 0x3b, 0xe4, 0x11, 0xea, 0xb9, 0x6a, 0x54, 0x9f, 0xbd, 0x77, 0x70,
+	0x67, 0x87, 0xc7, 0x32, 0x0d, 0x42, 0x79, 0x28, 0x03, 0x39, 0xcb, 0xde, 0x80, 0xe2, 0x27, 0x1c,
+	0x65, 0x6e, 0x75, 0x24, 0x4d, 0x28, 0x9d, 0x06, 0x27, 0x03, 0xd4, 0xf3, 0xd7, 0xa8, 0x29, 0xbc,
+	0x4d, 0x28, 0xbd, 0xe1, 0x71, 0x38, 0x0b, 0xd5, 0x84, 0x52, 0xac, 0x24, 0x1a, 0x66, 0x53, 0x53,
+	0x78, 0xaf, 0x01, 0x0e, 0x31, 0x65, 0xc1, 0x09, 0xfb, 0x82, 0x11, 0xb9, 0x07, 0xe5, 0x24, 0xc5,
+	0x8f, 0x6c, 0xa8, 0xcd, 0x35, 0x9a, 0x55, 0xb7, 0x1e, 0xe3, 0x9b, 0x05, 0x8b, 0x6f, 0x39, 0x8b,
+	0x25, 0xa6, 0x14, 0xbb, 0x4c, 0xc8, 0x74, 0x44, 0xf6, 0xa1, 0x96, 0x98, 0x2b, 0x5f, 0x8e, 0x12,
+	0xd4, 0xe4, 0xfa, 0xfa, 0x83, 0xf6, 0xb4, 0x4c, 0xb5, 0x33, 0xc0, 0xd1, 0x28, 0x41, 0x5a, 0x4d,
+	0xc6, 0x05, 0x79, 0x09, 0x15, 0x8c, 0x65, 0xca, 0x50, 0x3d, 0x73, 0xb1, 0x55, 0x5d, 0x7f, 0x38,
+	0x17, 0xf2, 0x2a, 0x96, 0xe9, 0x88, 0xe6, 0x36, 0xef, 0xbb, 0x05, 0xf5, 0xac, 0xa3, 0xde, 0xed,
+	0x60, 0x57, 0xfc, 0xc3, 0xf1, 0xb6, 0x61, 0x41, 0x3d, 0xaf, 0xcf, 0xa2, 0x7c, 0xbe, 0x47, 0x73,
+	0x29, 0x66, 0x0a, 0x5a, 0x51, 0xc6, 0x83, 0x48, 0x78, 0x3f, 0x6d, 0xa8, 0xed, 0x99, 0xdd, 0x33,
+	0xd1, 0x78, 0x0e, 0x65, 0xb3, 0x37, 0x7a, 0xb0, 0xea, 0xfa, 0xea, 0x0c, 0xa4, 0xd6, 0x6d, 0xdb,
+	0x67, 0xbf, 0x57, 0x0a, 0x34, 0x73, 0x11, 0x1f, 0x9a, 0x59, 0x00, 0xfc, 0x60, 0xbc, 0x56, 0xf9,
+	0x80, 0x4f, 0xa6, 0xd3, 0x6e, 0xee, 0x22, 0xbd, 0x1b, 0xdc, 0xb8, 0x13, 0x64, 0x03, 0x4a, 0x6a,
+	0x78, 0xe1, 0x14, 0x35, 0xd1, 0x9d, 0x4e, 0x54, 0xdf, 0x4a, 0x8d, 0x98, 0xbc, 0x80, 0xb2, 0x50,
+	0xdf, 0x27, 0x1c, 0x7b, 0xde, 0x2f, 0x75, 0x6d, 0x55, 0x68, 0x66, 0x23, 0x9b, 0x50, 0xd6, 0x01,
+	0x16, 0x4e, 0x49, 0x03, 0x56, 0xa6, 0x03, 0xf4, 0x62, 0xd0, 0x4c, 0x4e, 0x76, 0x01, 0xc4, 0x55,
+	0xe0, 0x9d, 0xb2, 0x36, 0xdf, 0x9f, 0x6e, 0x1e, 0x2f, 0x07, 0x9d, 0xf0, 0x91, 0xf7, 0x40, 0xf2,
+	0xd4, 0xa4, 0x26, 0xe8, 0x2a, 0x95, 0x15, 0x4d, 0x7b, 0x3c, 0xf7, 0xd5, 0xf3, 0xdd, 0xa0, 0x4b,
+	0xc9, 0xb5, 0x0b, 0x86, 0x82, 0x50, 0x68, 0xe4, 0xe4, 0xab, 0x34, 0x2d, 0x68, 0x6e, 0xeb, 0x96,
+	0x69, 0x12, 0xb4, 0x9e, 0x4c, 0xd4, 0x91, 0xd8, 0xde, 0x3b, 0xbb, 0x70, 0xad, 0xf3, 0x0b, 0xd7,
+	0xfa, 0x73, 0xe1, 0x5a, 0x5f, 0x2f, 0xdd, 0xc2, 0xf9, 0xa5, 0x5b, 0xf8, 0x75, 0xe9, 0x16, 0x3e,
+	0xac, 0x75, 0x99, 0xec, 0x0d, 0x8e, 0xdb, 0x21, 0xef, 0x77, 0x04, 0xb2, 0xb5, 0x1c, 0xaf, 0x0b,
+	0xcd, 0xef, 0x0c, 0x3b, 0xea, 0xaf, 0x5a, 0xed, 0x86, 0x38, 0x2e, 0xeb, 0xfe, 0xb3, 0xbf, 0x03,
+	0x00, 0x3f, 0xff, 0x15, 0x99, 0x24, 0x06, 0x00, 0x00,
 }
 
 func (m *AddressAssociation) Marshal() (dAtA []byte, err error) {
@@ -449,6 +604,18 @@ func (m *AddressAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssociatedInTx) > 0 {
+		i -= len(m.AssociatedInTx)
+		copy(dAtA[i:], m.AssociatedInTx)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssociatedInTx)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AssociatedAtHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AssociatedAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -626,6 +793,90 @@ func (m *Serialized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PointerRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointerRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointerRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PointerType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PointerType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PointerCodeIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointerCodeIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointerCodeIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeIds) > 0 {
+		for iNdEx := len(m.CodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PointerType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PointerType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -646,6 +897,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PointerCodeIds) > 0 {
+		for iNdEx := len(m.PointerCodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointerCodeIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PointerRegistries) > 0 {
+		for iNdEx := len(m.PointerRegistries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointerRegistries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Serialized) > 0 {
 		for iNdEx := len(m.Serialized) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -754,6 +1033,13 @@ func (m *AddressAssociation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.AssociatedAtHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.AssociatedAtHeight))
+	}
+	l = len(m.AssociatedInTx)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -832,6 +1118,42 @@ func (m *Serialized) Size() (n int) {
 	return n
 }
 
+func (m *PointerRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PointerType != 0 {
+		n += 1 + sovGenesis(uint64(m.PointerType))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PointerCodeIDs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PointerType != 0 {
+		n += 1 + sovGenesis(uint64(m.PointerType))
+	}
+	if len(m.CodeIds) > 0 {
+		for _, e := range m.CodeIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -870,6 +1192,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PointerRegistries) > 0 {
+		for _, e := range m.PointerRegistries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PointerCodeIds) > 0 {
+		for _, e := range m.PointerCodeIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -972,6 +1306,57 @@ func (m *AddressAssociation) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedAtHeight", wireType)
+			}
+			m.AssociatedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssociatedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedInTx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssociatedInTx = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1512,6 +1897,212 @@ func (m *Serialized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PointerRegistry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointerRegistry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointerRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerType |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &PointerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointerCodeIDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointerCodeIDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointerCodeIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerType |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeIds = append(m.CodeIds, &PointerCodeID{})
+			if err := m.CodeIds[len(m.CodeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1744,6 +2335,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerRegistries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointerRegistries = append(m.PointerRegistries, &PointerRegistry{})
+			if err := m.PointerRegistries[len(m.PointerRegistries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerCodeIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointerCodeIds = append(m.PointerCodeIds, &PointerCodeIDs{})
+			if err := m.PointerCodeIds[len(m.PointerCodeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,6 +20,7 @@ const (
 	ProposalTypeAddCWERC721Pointer    = "AddCWERC721Pointer"
 	ProposalTypeAddCWERC1155Pointer   = "AddCWERC1155Pointer"
 	ProposalTypeAddERCNativePointerV2 = "AddERCNativePointerV2"
+	ProposalTypeRemovePointer         = "RemovePointer"
 )
 
 func init() {
@@ -32,6 +33,7 @@ func init() {
 	govtypes.RegisterProposalType(ProposalTypeAddCWERC721Pointer)
 	govtypes.RegisterProposalType(ProposalTypeAddCWERC1155Pointer)
 	govtypes.RegisterProposalType(ProposalTypeAddERCNativePointerV2)
+	govtypes.RegisterProposalType(ProposalTypeRemovePointer)
 
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddERCNativePointerProposal{}, "evm/AddERCNativePointerProposal")
@@ -42,6 +44,7 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&AddCWERC721PointerProposal{}, "evm/AddCWERC721PointerProposal")
 	govtypes.RegisterProposalTypeCodec(&AddCWERC1155PointerProposal{}, "evm/AddCWERC1155PointerProposal")
 	govtypes.RegisterProposalTypeCodec(&AddERCNativePointerProposalV2{}, "evm/AddERCNativePointerProposalV2")
+	govtypes.RegisterProposalTypeCodec(&RemovePointerProposal{}, "evm/RemovePointerProposal")
 }
 
 func (p *AddERCNativePointerProposal) GetTitle() string { return p.Title }
@@ -339,3 +342,37 @@ func (p AddERCNativePointerProposalV2) String() string {
 `, p.Title, p.Description, p.Token, p.Name, p.Symbol, p.Decimals))
 	return b.String()
 }
+
+func (p *RemovePointerProposal) GetTitle() string { return p.Title }
+
+func (p *RemovePointerProposal) GetDescription() string { return p.Description }
+
+func (p *RemovePointerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemovePointerProposal) ProposalType() string {
+	return ProposalTypeRemovePointer
+}
+
+func (p *RemovePointerProposal) ValidateBasic() error {
+	if _, ok := PointerType_name[int32(p.PointerType)]; !ok {
+		return fmt.Errorf("unknown pointer type %d", p.PointerType)
+	}
+
+	if p.Pointee == "" {
+		return errors.New("pointee must be set")
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p RemovePointerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Pointer Proposal:
+  Title:                %s
+  Description:          %s
+  Pointer Type:         %s
+  Pointee:              %s
+  Allow Reregistration: %t
+`, p.Title, p.Description, p.PointerType, p.Pointee, p.AllowReregistration))
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AssociationHooks lets other modules react to new address associations.
+// AfterAddressAssociated is called once the association has been written to
+// the store. Returning an error doesn't undo the association: the error is
+// logged and any state changes made by the failing hook are discarded.
+type AssociationHooks interface {
+	AfterAddressAssociated(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) error
+}
+
+var _ AssociationHooks = MultiAssociationHooks{}
+
+// combine multiple association hooks, all hook functions are run in array
+// sequence. A failing hook doesn't prevent the following ones from running.
+type MultiAssociationHooks []AssociationHooks
+
+func NewMultiAssociationHooks(hooks ...AssociationHooks) MultiAssociationHooks {
+	return hooks
+}
+
+func (h MultiAssociationHooks) AfterAddressAssociated(ctx sdk.Context, seiAddress sdk.AccAddress, evmAddress common.Address) error {
+	for i := range h {
+		RunHook(ctx, "association", func(ctx sdk.Context) error {
+			return h[i].AfterAddressAssociated(ctx, seiAddress, evmAddress)
+		})
+	}
+	return nil
+}
+
+// PointerHooks lets other modules keep track of the pointer registry. Hooks
+// are called once the registry has been updated, with the pointer address as
+// it is returned by the Pointer query, i.e. bech32 for CW pointers and hex
+// otherwise. AfterPointerUpgraded is called whenever a new version of a
+// pointer is registered, including when governance overrides the pointer of a
+// pointee with a different contract. AfterPointerRemoved is called with the
+// latest removed version. Errors are handled as for AssociationHooks.
+type PointerHooks interface {
+	AfterPointerRegistered(ctx sdk.Context, pointerType PointerType, pointee string, pointer string, version uint16) error
+	AfterPointerUpgraded(ctx sdk.Context, pointerType PointerType, pointee string, pointer string, version uint16) error
+	AfterPointerRemoved(ctx sdk.Context, pointerType PointerType, pointee string, pointer string, version uint16) error
+}
+
+var _ PointerHooks = MultiPointerHooks{}
+
+// combine multiple pointer hooks, all hook functions are run in array
+// sequence. A failing hook doesn't prevent the following ones from running.
+type MultiPointerHooks []PointerHooks
+
+func NewMultiPointerHooks(hooks ...PointerHooks) MultiPointerHooks {
+	return hooks
+}
+
+func (h MultiPointerHooks) AfterPointerRegistered(ctx sdk.Context, pointerType PointerType, pointee string, pointer string, version uint16) error {
+	for i := range h {
+		RunHook(ctx, "pointer registration", func(ctx sdk.Context) error {
+			return h[i].AfterPointerRegistered(ctx, pointerType, pointee, pointer, version)
+		})
+	}
+	return nil
+}
+
+func (h MultiPointerHooks) AfterPointerUpgraded(ctx sdk.Context, pointerType PointerType, pointee string, pointer string, version uint16) error {
+	for i := range h {
+		RunHook(ctx, "pointer upgrade", func(ctx sdk.Context) error {
+			return h[i].AfterPointerUpgraded(ctx, pointerType, pointee, pointer, version)
+		})
+	}
+	return nil
+}
+
+func (h MultiPointerHooks) AfterPointerRemoved(ctx sdk.Context, pointerType PointerType, pointee string, pointer string, version uint16) error {
+	for i := range h {
+		RunHook(ctx, "pointer removal", func(ctx sdk.Context) error {
+			return h[i].AfterPointerRemoved(ctx, pointerType, pointee, pointer, version)
+		})
+	}
+	return nil
+}
+
+// RunHook runs hook on a branch of ctx that is only written back if the hook
+// succeeds, so that a failing hook can't affect the operation it reacts to.
+// Panics, e.g. running out of gas, are not recovered.
+func RunHook(ctx sdk.Context, name string, hook func(sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		ctx.Logger().Error(name+" hook failed", "error", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -58,6 +58,42 @@ var (
 	LegacyBlockBloomCutoffHeightKey = []byte{0x1a}
 	BaseFeePerGasPrefix             = []byte{0x1b}
 	NextBaseFeePerGasPrefix         = []byte{0x1c}
+
+	ReceiptBlockIndexPrefix         = []byte{0x1d} // in receipt store
+	ReceiptBlockIndexStartHeightKey = []byte{0x1e} // in receipt store
+
+	ChainStatsPrefix = []byte{0x1f}
+
+	PointerCreationInfoPrefix  = []byte{0x20}
+	ContractCreationInfoPrefix = []byte{0x21}
+
+	PointerRegistrationLogPrefix          = []byte{0x22}
+	PointerRegistrationLogPrunedBeforeKey = []byte{0x23}
+
+	PointerTombstonePrefix = []byte{0x24}
+
+	IBCDenomPointerQueuePrefix = []byte{0x25}
+
+	PointerPausedPrefix = []byte{0x26}
+
+	AssociationNoncePrefix = []byte{0x27}
+
+	AssociationInfoPrefix = []byte{0x28}
+
+	NativePointerDecimalsFromMetadataPrefix = []byte{0x29}
+
+	PointerMigrationCursorPrefix = []byte{0x2a}
+
+	PointerReverseIndexPrefix = []byte{0x2b}
+
+	PointerRegistryWrittenKey = []byte{0x2c} // transient
+
+	ReceiptPruneCursorKey = []byte{0x2d} // in receipt store
+
+	AddressAssociationRewrittenKey = []byte{0x2e} // transient
+
+	ReceiptPrunedPrefix      = []byte{0x2f} // in receipt store
+	ReceiptPrunedIndexPrefix = []byte{0x30} // in receipt store
 )
 
 var (
@@ -70,6 +106,37 @@ var (
 	PointerCW1155ERC1155Prefix = []byte{0x6}
 )
 
+var (
+	ChainStatsEVMTxCountKey       = []byte{0x0}
+	ChainStatsContractCountKey    = []byte{0x1}
+	ChainStatsAssociationCountKey = []byte{0x2}
+	ChainStatsPointerCountKey     = []byte{0x3}
+	ChainStatsStartHeightKey      = []byte{0x4}
+	// followed by the pointer registry type prefix
+	ChainStatsPointerTypeCountPrefix = []byte{0x5}
+	// followed by a ring slot, see ChainStatsAssociationSlotKey
+	ChainStatsAssociationSlotPrefix = []byte{0x6}
+)
+
+// AssociationStatsWindow is the number of most recent blocks whose new
+// associations are tracked individually.
+const AssociationStatsWindow = 1000
+
+// ChainStatsPointerTypeCountKey returns the chain stats key counting the
+// pointees registered under a pointer registry type prefix.
+func ChainStatsPointerTypeCountKey(registryTypePrefix []byte) []byte {
+	return append(append([]byte{}, ChainStatsPointerTypeCountPrefix...), registryTypePrefix...)
+}
+
+// ChainStatsAssociationSlotKey returns the chain stats key of the ring slot
+// counting the associations added at height. Slots are reused every
+// AssociationStatsWindow blocks.
+func ChainStatsAssociationSlotKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height)%AssociationStatsWindow)
+	return append(append([]byte{}, ChainStatsAssociationSlotPrefix...), bz...)
+}
+
 func EVMAddressToSeiAddressKey(evmAddress common.Address) []byte {
 	return append(EVMAddressToSeiAddressKeyPrefix, evmAddress[:]...)
 }
@@ -86,6 +153,50 @@ func ReceiptKey(txHash common.Hash) []byte {
 	return append(ReceiptKeyPrefix, txHash[:]...)
 }
 
+// ReceiptPrunedKey marks that the receipt of txHash was pruned, so that
+// lookups by hash can tell it apart from a receipt that never existed.
+func ReceiptPrunedKey(txHash common.Hash) []byte {
+	return append(append([]byte{}, ReceiptPrunedPrefix...), txHash[:]...)
+}
+
+// ReceiptPrunedIndexKey indexes the pruned marker of txHash under the height
+// of the block index entry it was pruned with, so that markers can be expired
+// by height.
+func ReceiptPrunedIndexKey(height int64, txHash common.Hash) []byte {
+	return append(ReceiptPrunedIndexHeightPrefix(height), txHash[:]...)
+}
+
+func ReceiptPrunedIndexHeightPrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, ReceiptPrunedIndexPrefix...), bz...)
+}
+
+// ReceiptPrunedIndexKeyHeight returns the height of a key built by
+// ReceiptPrunedIndexKey or ReceiptPrunedIndexHeightPrefix.
+func ReceiptPrunedIndexKeyHeight(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[len(ReceiptPrunedIndexPrefix) : len(ReceiptPrunedIndexPrefix)+8]))
+}
+
+// ReceiptBlockIndexKey indexes a receipt's tx hash under the height it was
+// included at, so that receipts can be ranged over by block. The index is only
+// written for receipts flushed after ReceiptBlockIndexStartHeightKey was set.
+func ReceiptBlockIndexKey(height int64, txHash common.Hash) []byte {
+	return append(ReceiptBlockIndexHeightPrefix(height), txHash[:]...)
+}
+
+func ReceiptBlockIndexHeightPrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, ReceiptBlockIndexPrefix...), bz...)
+}
+
+// ReceiptBlockIndexKeyHeight returns the height of a key built by
+// ReceiptBlockIndexKey or ReceiptBlockIndexHeightPrefix.
+func ReceiptBlockIndexKeyHeight(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[len(ReceiptBlockIndexPrefix) : len(ReceiptBlockIndexPrefix)+8]))
+}
+
 func BlockBloomKey(height int64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, uint64(height))
@@ -147,6 +258,85 @@ func PointerCW1155ERC1155Key(erc1155Addr common.Address) []byte {
 	)
 }
 
+// PointerCreationInfoKey returns the key of the creation info of the pointer
+// registered under pointerKey, one of the Pointer*Key registry keys.
+func PointerCreationInfoKey(pointerKey []byte) []byte {
+	return append(append([]byte{}, PointerCreationInfoPrefix...), pointerKey[len(PointerRegistryPrefix):]...)
+}
+
+// PointerTombstoneKey returns the key marking the pointee of the forward
+// registry key pointerKey as removed by governance.
+func PointerTombstoneKey(pointerKey []byte) []byte {
+	return append(append([]byte{}, PointerTombstonePrefix...), pointerKey[len(PointerRegistryPrefix):]...)
+}
+
+// IBCDenomPointerQueueKey returns the key of an ibc/ denom waiting for its
+// pointer to be created automatically.
+func IBCDenomPointerQueueKey(denom string) []byte {
+	return append(append([]byte{}, IBCDenomPointerQueuePrefix...), []byte(denom)...)
+}
+
+// NativePointerDecimalsFromMetadataKey returns the key marking the native
+// pointer of denom as deployed with the decimals of the denom's bank metadata.
+func NativePointerDecimalsFromMetadataKey(denom string) []byte {
+	return append(append([]byte{}, NativePointerDecimalsFromMetadataPrefix...), []byte(denom)...)
+}
+
+// PointerMigrationCursorKey returns the key holding the progress of the
+// migration of pointers of pointerType to their latest version.
+func PointerMigrationCursorKey(pointerType PointerType) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(pointerType))
+	return append(append([]byte{}, PointerMigrationCursorPrefix...), bz...)
+}
+
+// PointerPausedKey returns the key marking the pointer at addr as paused by
+// governance. CW pointers are keyed by their bech32 address truncated to an
+// address length, as in the reverse registry.
+func PointerPausedKey(addr common.Address) []byte {
+	return append(append([]byte{}, PointerPausedPrefix...), addr[:]...)
+}
+
+func AssociationInfoKey(seiAddress sdk.AccAddress) []byte {
+	return append(append([]byte{}, AssociationInfoPrefix...), seiAddress...)
+}
+
+// AssociationNonceKey returns the key marking nonce as used by a signed
+// association message of addr.
+func AssociationNonceKey(addr common.Address, nonce uint64) []byte {
+	nonceBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBz, nonce)
+	return append(append(append([]byte{}, AssociationNoncePrefix...), addr[:]...), nonceBz...)
+}
+
+func ContractCreationInfoKey(addr common.Address) []byte {
+	return append(append([]byte{}, ContractCreationInfoPrefix...), addr[:]...)
+}
+
+// PointerRegistrationLogKey returns the key of the pointer registration log
+// entry of the pointer registered under pointerKey, one of the Pointer*Key
+// registry keys, at the given height and version. Entries are ordered by
+// height.
+func PointerRegistrationLogKey(height int64, pointerKey []byte, version uint16) []byte {
+	versionBz := make([]byte, 2)
+	binary.BigEndian.PutUint16(versionBz, version)
+	key := append(PointerRegistrationLogHeightPrefix(height), pointerKey[len(PointerRegistryPrefix):]...)
+	return append(key, versionBz...)
+}
+
+func PointerRegistrationLogHeightPrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, PointerRegistrationLogPrefix...), bz...)
+}
+
 func PointerReverseRegistryKey(addr common.Address) []byte {
 	return append(PointerReverseRegistryPrefix, addr[:]...)
 }
+
+// PointerReverseIndexKey returns the key holding the latest version and
+// pointee of the pointer at addr, so that it can be read without scanning the
+// versions in the reverse registry.
+func PointerReverseIndexKey(addr common.Address) []byte {
+	return append(append([]byte{}, PointerReverseIndexPrefix...), addr[:]...)
+}
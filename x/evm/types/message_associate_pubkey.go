@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAssociatePubkey = "evm_associate_pubkey"
+
+var (
+	_ sdk.Msg = &MsgAssociatePubkey{}
+)
+
+func NewMsgAssociatePubkey(sender sdk.AccAddress, pubkey []byte) *MsgAssociatePubkey {
+	return &MsgAssociatePubkey{Sender: sender.String(), Pubkey: pubkey}
+}
+
+func (msg *MsgAssociatePubkey) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAssociatePubkey) Type() string {
+	return TypeMsgAssociatePubkey
+}
+
+func (msg *MsgAssociatePubkey) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAssociatePubkey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgAssociatePubkey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	switch len(msg.Pubkey) {
+	case secp256k1.PubKeySize:
+	case ed25519.PubKeySize:
+		return ErrEd25519PubkeyAssociation
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "compressed secp256k1 public key must be %d bytes", secp256k1.PubKeySize)
+	}
+
+	return nil
+}
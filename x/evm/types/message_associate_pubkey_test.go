@@ -0,0 +1,20 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageAssociatePubkeyValidate(t *testing.T) {
+	fromAddr, err := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	require.Nil(t, err)
+	require.Nil(t, types.NewMsgAssociatePubkey(fromAddr, secp256k1.GenPrivKey().PubKey().Bytes()).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgAssociatePubkey(fromAddr, ed25519.GenPrivKey().PubKey().Bytes()).ValidateBasic(), types.ErrEd25519PubkeyAssociation)
+	require.ErrorIs(t, types.NewMsgAssociatePubkey(fromAddr, make([]byte, 65)).ValidateBasic(), sdkerrors.ErrInvalidPubKey)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const TypeMsgAssociateWithSignature = "evm_associate_with_signature"
+
+const (
+	AssociationEIP712DomainName    = "Sei address association"
+	AssociationEIP712DomainVersion = "1"
+)
+
+var (
+	associationEIP712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId)"))
+	associationEIP712TypeHash       = crypto.Keccak256Hash([]byte("Associate(address evmAddress,uint64 nonce)"))
+)
+
+var (
+	_ sdk.Msg = &MsgAssociateWithSignature{}
+)
+
+func NewMsgAssociateWithSignature(sender sdk.AccAddress, evmAddr common.Address, nonce uint64, signature []byte) *MsgAssociateWithSignature {
+	return &MsgAssociateWithSignature{Sender: sender.String(), EvmAddress: evmAddr.Hex(), Nonce: nonce, Signature: signature}
+}
+
+func (msg *MsgAssociateWithSignature) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAssociateWithSignature) Type() string {
+	return TypeMsgAssociateWithSignature
+}
+
+func (msg *MsgAssociateWithSignature) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAssociateWithSignature) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgAssociateWithSignature) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateSignedAssociation(msg.EvmAddress, msg.Signature)
+}
+
+func validateSignedAssociation(evmAddress string, signature []byte) error {
+	if !common.IsHexAddress(evmAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid EVM address (%s)", evmAddress)
+	}
+
+	if len(signature) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "signature must be %d bytes", crypto.SignatureLength)
+	}
+
+	return nil
+}
+
+// AssociationTypedDataHash returns the EIP-712 hash that the owner of evmAddr
+// signs to associate it with its Sei address, i.e. what eth_signTypedData_v4
+// signs for the domain {name: "Sei address association", version: "1",
+// chainId} and the message Associate{evmAddress, nonce}.
+func AssociationTypedDataHash(chainID *big.Int, evmAddr common.Address, nonce uint64) common.Hash {
+	domainSeparator := crypto.Keccak256(
+		associationEIP712DomainTypeHash[:],
+		crypto.Keccak256([]byte(AssociationEIP712DomainName)),
+		crypto.Keccak256([]byte(AssociationEIP712DomainVersion)),
+		math.U256Bytes(new(big.Int).Set(chainID)),
+	)
+	structHash := crypto.Keccak256(
+		associationEIP712TypeHash[:],
+		common.LeftPadBytes(evmAddr[:], 32),
+		math.U256Bytes(new(big.Int).SetUint64(nonce)),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssociationTypedDataHash(t *testing.T) {
+	evmAddr := common.HexToAddress("0x1df809C639027b465B931BD63Ce71c8E5834D9d6")
+	chainID := big.NewInt(713715)
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "version", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Associate":    {{Name: "evmAddress", Type: "address"}, {Name: "nonce", Type: "uint64"}},
+		},
+		PrimaryType: "Associate",
+		Domain: apitypes.TypedDataDomain{
+			Name:    types.AssociationEIP712DomainName,
+			Version: types.AssociationEIP712DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		Message: apitypes.TypedDataMessage{"evmAddress": evmAddr.Hex(), "nonce": "7"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, common.BytesToHash(hash), types.AssociationTypedDataHash(chainID, evmAddr, 7))
+	require.NotEqual(t, types.AssociationTypedDataHash(chainID, evmAddr, 7), types.AssociationTypedDataHash(chainID, evmAddr, 8))
+}
+
+func TestMessageAssociateWithSignatureValidate(t *testing.T) {
+	fromAddr, err := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	require.Nil(t, err)
+	evmAddr := common.HexToAddress("0x1df809C639027b465B931BD63Ce71c8E5834D9d6")
+	require.Nil(t, types.NewMsgAssociateWithSignature(fromAddr, evmAddr, 0, make([]byte, 65)).ValidateBasic())
+	require.Error(t, types.NewMsgAssociateWithSignature(fromAddr, evmAddr, 0, make([]byte, 64)).ValidateBasic())
+	msg := types.NewMsgAssociateWithSignature(fromAddr, evmAddr, 0, make([]byte, 65))
+	msg.EvmAddress = "not an address"
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMessageAssociateWithSignaturesValidate(t *testing.T) {
+	fromAddr, err := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	require.Nil(t, err)
+	association := &types.SignedAssociation{EvmAddress: "0x1df809C639027b465B931BD63Ce71c8E5834D9d6", Signature: make([]byte, 65)}
+	require.Nil(t, types.NewMsgAssociateWithSignatures(fromAddr, []*types.SignedAssociation{association}).ValidateBasic())
+	require.Error(t, types.NewMsgAssociateWithSignatures(fromAddr, nil).ValidateBasic())
+	require.Error(t, types.NewMsgAssociateWithSignatures(fromAddr, []*types.SignedAssociation{association, association}).ValidateBasic())
+	require.Error(t, types.NewMsgAssociateWithSignatures(fromAddr, []*types.SignedAssociation{{EvmAddress: association.EvmAddress}}).ValidateBasic())
+	tooMany := make([]*types.SignedAssociation, types.MaxAssociationsPerBatch+1)
+	for i := range tooMany {
+		tooMany[i] = &types.SignedAssociation{EvmAddress: common.BigToAddress(big.NewInt(int64(i + 1))).Hex(), Signature: make([]byte, 65)}
+	}
+	require.Error(t, types.NewMsgAssociateWithSignatures(fromAddr, tooMany).ValidateBasic())
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const TypeMsgAssociateWithSignatures = "evm_associate_with_signatures"
+
+// MaxAssociationsPerBatch bounds the number of payloads of a
+// MsgAssociateWithSignatures.
+const MaxAssociationsPerBatch = 100
+
+var (
+	_ sdk.Msg = &MsgAssociateWithSignatures{}
+)
+
+func NewMsgAssociateWithSignatures(sender sdk.AccAddress, associations []*SignedAssociation) *MsgAssociateWithSignatures {
+	return &MsgAssociateWithSignatures{Sender: sender.String(), Associations: associations}
+}
+
+func (msg *MsgAssociateWithSignatures) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAssociateWithSignatures) Type() string {
+	return TypeMsgAssociateWithSignatures
+}
+
+func (msg *MsgAssociateWithSignatures) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAssociateWithSignatures) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgAssociateWithSignatures) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.Associations) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no associations")
+	}
+	if len(msg.Associations) > MaxAssociationsPerBatch {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d associations exceed the maximum of %d", len(msg.Associations), MaxAssociationsPerBatch)
+	}
+
+	seen := make(map[common.Address]struct{}, len(msg.Associations))
+	for i, association := range msg.Associations {
+		if association == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "association %d is empty", i)
+		}
+		if err := validateSignedAssociation(association.EvmAddress, association.Signature); err != nil {
+			return sdkerrors.Wrapf(err, "association %d", i)
+		}
+		evmAddr := common.HexToAddress(association.EvmAddress)
+		if _, ok := seen[evmAddr]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "association %d: duplicate EVM address %s", i, association.EvmAddress)
+		}
+		seen[evmAddr] = struct{}{}
+	}
+
+	return nil
+}
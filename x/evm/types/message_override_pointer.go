@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgOverridePointer = "evm_override_pointer"
+
+var (
+	_ sdk.Msg = &MsgOverridePointer{}
+)
+
+func NewMsgOverridePointer(sender sdk.AccAddress, pointerType PointerType, pointee string, pointerAddress string) *MsgOverridePointer {
+	return &MsgOverridePointer{Sender: sender.String(), PointerType: pointerType, Pointee: pointee, PointerAddress: pointerAddress}
+}
+
+func (msg *MsgOverridePointer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOverridePointer) Type() string {
+	return TypeMsgOverridePointer
+}
+
+func (msg *MsgOverridePointer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgOverridePointer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgOverridePointer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if _, ok := PointerType_name[int32(msg.PointerType)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %d", msg.PointerType)
+	}
+
+	if msg.Pointee == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pointee must be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const TypeMsgRegisterPointers = "evm_register_pointers"
+
+// MaxPointersPerRegistration bounds the number of entries of a
+// MsgRegisterPointers.
+const MaxPointersPerRegistration = 100
+
+var (
+	_ sdk.Msg = &MsgRegisterPointers{}
+)
+
+func NewMsgRegisterPointers(sender sdk.AccAddress, entries []*PointerRegistrationRequest, continueOnError bool) *MsgRegisterPointers {
+	return &MsgRegisterPointers{Sender: sender.String(), Entries: entries, ContinueOnError: continueOnError}
+}
+
+func (msg *MsgRegisterPointers) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterPointers) Type() string {
+	return TypeMsgRegisterPointers
+}
+
+func (msg *MsgRegisterPointers) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRegisterPointers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRegisterPointers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.Entries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no entries")
+	}
+	if len(msg.Entries) > MaxPointersPerRegistration {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d entries exceed the maximum of %d", len(msg.Entries), MaxPointersPerRegistration)
+	}
+
+	// a pointee may only get one pointer type, as with MsgRegisterPointer
+	seen := make(map[common.Address]PointerType, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		if entry == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "entry %d is empty", i)
+		}
+		switch entry.PointerType {
+		case PointerType_ERC20, PointerType_ERC721, PointerType_ERC1155:
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "entry %d: unsupported pointer type %s", i, entry.PointerType)
+		}
+		if !common.IsHexAddress(entry.Pointee) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "entry %d: invalid pointee %s", i, entry.Pointee)
+		}
+		pointee := common.HexToAddress(entry.Pointee)
+		if pointerType, ok := seen[pointee]; ok {
+			if pointerType == entry.PointerType {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "entry %d: duplicate %s pointee %s", i, entry.PointerType, entry.Pointee)
+			}
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "entry %d: pointee %s already has a %s entry", i, entry.Pointee, pointerType)
+		}
+		seen[pointee] = entry.PointerType
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageRegisterPointersValidate(t *testing.T) {
+	sender, err := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	require.Nil(t, err)
+	pointee := common.HexToAddress("0x1").Hex()
+	entry := func(pointerType types.PointerType, pointee string) *types.PointerRegistrationRequest {
+		return &types.PointerRegistrationRequest{PointerType: pointerType, Pointee: pointee}
+	}
+
+	msg := types.NewMsgRegisterPointers(sender, []*types.PointerRegistrationRequest{
+		entry(types.PointerType_ERC20, pointee),
+		entry(types.PointerType_ERC721, common.HexToAddress("0x2").Hex()),
+	}, false)
+	require.Nil(t, msg.ValidateBasic())
+
+	// no entries
+	msg = types.NewMsgRegisterPointers(sender, nil, false)
+	require.Error(t, msg.ValidateBasic())
+
+	// too many entries
+	entries := make([]*types.PointerRegistrationRequest, types.MaxPointersPerRegistration+1)
+	for i := range entries {
+		entries[i] = entry(types.PointerType_ERC20, common.BigToAddress(big.NewInt(int64(i+1))).Hex())
+	}
+	msg = types.NewMsgRegisterPointers(sender, entries, false)
+	require.ErrorContains(t, msg.ValidateBasic(), "exceed the maximum")
+
+	// EVM pointers can't be registered through the message
+	msg = types.NewMsgRegisterPointers(sender, []*types.PointerRegistrationRequest{entry(types.PointerType_CW20, pointee)}, false)
+	require.ErrorContains(t, msg.ValidateBasic(), "unsupported pointer type")
+
+	// invalid pointee
+	msg = types.NewMsgRegisterPointers(sender, []*types.PointerRegistrationRequest{entry(types.PointerType_ERC20, "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")}, false)
+	require.Error(t, msg.ValidateBasic())
+
+	// duplicate entries, regardless of address casing
+	msg = types.NewMsgRegisterPointers(sender, []*types.PointerRegistrationRequest{
+		entry(types.PointerType_ERC20, pointee),
+		entry(types.PointerType_ERC20, common.HexToAddress("0x1").String()[2:]),
+	}, true)
+	require.ErrorContains(t, msg.ValidateBasic(), "duplicate")
+
+	// pointers of several types for the same pointee
+	msg = types.NewMsgRegisterPointers(sender, []*types.PointerRegistrationRequest{
+		entry(types.PointerType_ERC20, pointee),
+		entry(types.PointerType_ERC721, pointee),
+	}, true)
+	require.ErrorContains(t, msg.ValidateBasic(), "already has a ERC20 entry")
+}
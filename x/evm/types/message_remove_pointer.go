@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemovePointer = "evm_remove_pointer"
+
+var (
+	_ sdk.Msg = &MsgRemovePointer{}
+)
+
+func NewMsgRemovePointer(sender sdk.AccAddress, pointerType PointerType, pointee string, allowReregistration bool) *MsgRemovePointer {
+	return &MsgRemovePointer{Sender: sender.String(), PointerType: pointerType, Pointee: pointee, AllowReregistration: allowReregistration}
+}
+
+func (msg *MsgRemovePointer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemovePointer) Type() string {
+	return TypeMsgRemovePointer
+}
+
+func (msg *MsgRemovePointer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemovePointer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRemovePointer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if _, ok := PointerType_name[int32(msg.PointerType)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %d", msg.PointerType)
+	}
+
+	if msg.Pointee == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pointee must be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnpausePointer = "evm_unpause_pointer"
+
+var (
+	_ sdk.Msg = &MsgUnpausePointer{}
+)
+
+func NewMsgUnpausePointer(sender sdk.AccAddress, pointerType PointerType, pointee string) *MsgUnpausePointer {
+	return &MsgUnpausePointer{Sender: sender.String(), PointerType: pointerType, Pointee: pointee}
+}
+
+func (msg *MsgUnpausePointer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnpausePointer) Type() string {
+	return TypeMsgUnpausePointer
+}
+
+func (msg *MsgUnpausePointer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUnpausePointer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgUnpausePointer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if _, ok := PointerType_name[int32(msg.PointerType)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %d", msg.PointerType)
+	}
+
+	if msg.Pointee == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pointee must be set")
+	}
+
+	return nil
+}
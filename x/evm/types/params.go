@@ -17,6 +17,11 @@ var (
 	KeyMaxDynamicBaseFeeUpwardAdjustment   = []byte("KeyMaxDynamicBaseFeeUpwardAdjustment")
 	KeyMaxDynamicBaseFeeDownwardAdjustment = []byte("KeyMaxDynamicBaseFeeDownwardAdjustment")
 	KeyTargetGasUsedPerBlock               = []byte("KeyTargetGasUsedPerBlock")
+	KeyPointerRegistrationLogRetention     = []byte("KeyPointerRegistrationLogRetention")
+	KeyPointerRegistrationFee              = []byte("KeyPointerRegistrationFee")
+	KeyPointerRegistrationFeeRecipient     = []byte("KeyPointerRegistrationFeeRecipient")
+	KeyPointerRegistrationAllowlist        = []byte("KeyPointerRegistrationAllowlist")
+	KeyAutoCreateIBCDenomPointers          = []byte("KeyAutoCreateIBCDenomPointers")
 	// deprecated
 	KeyBaseFeePerGas                          = []byte("KeyBaseFeePerGas")
 	KeyWhitelistedCwCodeHashesForDelegateCall = []byte("KeyWhitelistedCwCodeHashesForDelegateCall")
@@ -37,6 +42,11 @@ var DefaultMaxDynamicBaseFeeUpwardAdjustment = sdk.NewDecWithPrec(189, 4)  // 1.
 var DefaultMaxDynamicBaseFeeDownwardAdjustment = sdk.NewDecWithPrec(39, 4) // .39%
 var DefaultTargetGasUsedPerBlock = uint64(250000)                          // 250k
 var DefaultMaxFeePerGas = sdk.NewDec(1000000000000)                        // 1,000gwei
+var DefaultPointerRegistrationLogRetention = uint64(0)                     // never pruned
+var DefaultPointerRegistrationFee = sdk.NewCoin("usei", sdk.ZeroInt())     // free
+var DefaultPointerRegistrationFeeRecipient = ""                            // community pool
+var DefaultPointerRegistrationAllowlist = []string{}                       // permissionless
+var DefaultAutoCreateIBCDenomPointers = false
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
@@ -55,6 +65,11 @@ func DefaultParams() Params {
 		WhitelistedCwCodeHashesForDelegateCall: DefaultWhitelistedCwCodeHashesForDelegateCall,
 		TargetGasUsedPerBlock:                  DefaultTargetGasUsedPerBlock,
 		MaximumFeePerGas:                       DefaultMaxFeePerGas,
+		PointerRegistrationLogRetention:        DefaultPointerRegistrationLogRetention,
+		PointerRegistrationFee:                 DefaultPointerRegistrationFee,
+		PointerRegistrationFeeRecipient:        DefaultPointerRegistrationFeeRecipient,
+		PointerRegistrationAllowlist:           DefaultPointerRegistrationAllowlist,
+		AutoCreateIbcDenomPointers:             DefaultAutoCreateIBCDenomPointers,
 	}
 }
 
@@ -69,6 +84,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDeliverTxHookWasmGasLimit, &p.DeliverTxHookWasmGasLimit, validateDeliverTxHookWasmGasLimit),
 		paramtypes.NewParamSetPair(KeyTargetGasUsedPerBlock, &p.TargetGasUsedPerBlock, func(i interface{}) error { return nil }),
 		paramtypes.NewParamSetPair(KeyMaxFeePerGas, &p.MaximumFeePerGas, validateMaxFeePerGas),
+		paramtypes.NewParamSetPair(KeyPointerRegistrationLogRetention, &p.PointerRegistrationLogRetention, validatePointerRegistrationLogRetention),
+		paramtypes.NewParamSetPair(KeyPointerRegistrationFee, &p.PointerRegistrationFee, validatePointerRegistrationFee),
+		paramtypes.NewParamSetPair(KeyPointerRegistrationFeeRecipient, &p.PointerRegistrationFeeRecipient, validatePointerRegistrationFeeRecipient),
+		paramtypes.NewParamSetPair(KeyPointerRegistrationAllowlist, &p.PointerRegistrationAllowlist, validatePointerRegistrationAllowlist),
+		paramtypes.NewParamSetPair(KeyAutoCreateIBCDenomPointers, &p.AutoCreateIbcDenomPointers, validateAutoCreateIBCDenomPointers),
 	}
 }
 
@@ -88,6 +108,15 @@ func (p Params) Validate() error {
 	if err := validateDeliverTxHookWasmGasLimit(p.DeliverTxHookWasmGasLimit); err != nil {
 		return err
 	}
+	if err := validatePointerRegistrationFee(p.PointerRegistrationFee); err != nil {
+		return err
+	}
+	if err := validatePointerRegistrationFeeRecipient(p.PointerRegistrationFeeRecipient); err != nil {
+		return err
+	}
+	if err := validatePointerRegistrationAllowlist(p.PointerRegistrationAllowlist); err != nil {
+		return err
+	}
 	if p.MinimumFeePerGas.LT(p.BaseFeePerGas) {
 		return errors.New("minimum fee cannot be lower than base fee")
 	}
@@ -181,6 +210,63 @@ func validateDeliverTxHookWasmGasLimit(i interface{}) error {
 	return nil
 }
 
+func validatePointerRegistrationLogRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePointerRegistrationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid pointer registration fee: %s", err)
+	}
+	return nil
+}
+
+func validatePointerRegistrationFeeRecipient(i interface{}) error {
+	recipient, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if recipient == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return fmt.Errorf("invalid pointer registration fee recipient: %s", err)
+	}
+	return nil
+}
+
+func validatePointerRegistrationAllowlist(i interface{}) error {
+	allowlist, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(allowlist))
+	for _, addr := range allowlist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid pointer registration allowlist entry %s: %s", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate pointer registration allowlist entry %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+func validateAutoCreateIBCDenomPointers(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateWhitelistedCwHashesForDelegateCall(i interface{}) error {
 	_, ok := i.([][]byte)
 	if !ok {
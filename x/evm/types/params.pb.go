@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -50,6 +51,21 @@ type Params struct {
 	MaxDynamicBaseFeeDownwardAdjustment    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_dynamic_base_fee_downward_adjustment,json=maxDynamicBaseFeeDownwardAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_dynamic_base_fee_downward_adjustment" yaml:"max_dynamic_base_fee_downward_adjustment"`
 	TargetGasUsedPerBlock                  uint64                                 `protobuf:"varint,12,opt,name=target_gas_used_per_block,json=targetGasUsedPerBlock,proto3" json:"target_gas_used_per_block,omitempty"`
 	MaximumFeePerGas                       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=maximum_fee_per_gas,json=maximumFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_fee_per_gas" yaml:"maximum_fee_per_gas"`
+	// number of blocks entries of the pointer registration log are kept for; 0
+	// keeps them indefinitely
+	PointerRegistrationLogRetention uint64 `protobuf:"varint,14,opt,name=pointer_registration_log_retention,json=pointerRegistrationLogRetention,proto3" json:"pointer_registration_log_retention,omitempty"`
+	// fee charged on top of gas for registering a new pointer through
+	// MsgRegisterPointer; a zero fee charges nothing
+	PointerRegistrationFee types.Coin `protobuf:"bytes,15,opt,name=pointer_registration_fee,json=pointerRegistrationFee,proto3" json:"pointer_registration_fee" yaml:"pointer_registration_fee"`
+	// bech32 address the pointer registration fee is sent to; the fee funds the
+	// community pool if empty
+	PointerRegistrationFeeRecipient string `protobuf:"bytes,16,opt,name=pointer_registration_fee_recipient,json=pointerRegistrationFeeRecipient,proto3" json:"pointer_registration_fee_recipient" yaml:"pointer_registration_fee_recipient"`
+	// bech32 addresses allowed to register pointers; registration is
+	// permissionless if empty
+	PointerRegistrationAllowlist []string `protobuf:"bytes,17,rep,name=pointer_registration_allowlist,json=pointerRegistrationAllowlist,proto3" json:"pointer_registration_allowlist" yaml:"pointer_registration_allowlist"`
+	// if set, an ERC20 native pointer is deployed for every ibc/ denom the
+	// first time a transfer mints it
+	AutoCreateIbcDenomPointers bool `protobuf:"varint,18,opt,name=auto_create_ibc_denom_pointers,json=autoCreateIbcDenomPointers,proto3" json:"auto_create_ibc_denom_pointers" yaml:"auto_create_ibc_denom_pointers"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -105,6 +121,41 @@ func (m *Params) GetTargetGasUsedPerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetPointerRegistrationLogRetention() uint64 {
+	if m != nil {
+		return m.PointerRegistrationLogRetention
+	}
+	return 0
+}
+
+func (m *Params) GetPointerRegistrationFee() types.Coin {
+	if m != nil {
+		return m.PointerRegistrationFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetPointerRegistrationFeeRecipient() string {
+	if m != nil {
+		return m.PointerRegistrationFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetPointerRegistrationAllowlist() []string {
+	if m != nil {
+		return m.PointerRegistrationAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetAutoCreateIbcDenomPointers() bool {
+	if m != nil {
+		return m.AutoCreateIbcDenomPointers
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.evm.Params")
 }
@@ -112,49 +163,62 @@ func init() {
 func init() { proto.RegisterFile("evm/params.proto", fileDescriptor_9272f3679901ea94) }
 
 var fileDescriptor_9272f3679901ea94 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6b, 0xdb, 0x4a,
-	0x10, 0xb6, 0x5e, 0xf2, 0xc2, 0xcb, 0xbe, 0x04, 0x82, 0xf2, 0x1e, 0x55, 0x72, 0x90, 0x52, 0x15,
-	0x82, 0x0f, 0xb5, 0x55, 0x08, 0x85, 0x90, 0x5b, 0x1c, 0x13, 0xe7, 0x50, 0x8a, 0x11, 0x4d, 0x0b,
-	0x85, 0xb2, 0xac, 0xa5, 0x89, 0xbc, 0xb5, 0xd6, 0x6b, 0x76, 0xd7, 0xb1, 0xdd, 0x1f, 0x50, 0xe8,
-	0xa1, 0x50, 0x42, 0x0f, 0x3d, 0xf6, 0xcf, 0x14, 0x72, 0xcc, 0xb1, 0x14, 0x2a, 0x4a, 0x42, 0x2e,
-	0x39, 0xfa, 0x17, 0x14, 0xad, 0x1c, 0xc7, 0x8d, 0x4d, 0x89, 0x73, 0xe9, 0x25, 0x27, 0xad, 0x66,
-	0xbe, 0x9d, 0x9d, 0x6f, 0x66, 0xbf, 0x61, 0xd1, 0x12, 0x1c, 0x32, 0xaf, 0x45, 0x04, 0x61, 0xb2,
-	0xd8, 0x12, 0x5c, 0x71, 0xd3, 0x92, 0x40, 0xf5, 0x2a, 0xe0, 0x71, 0x51, 0x02, 0x0d, 0xea, 0x84,
-	0x36, 0x8b, 0x70, 0xc8, 0x56, 0xff, 0x8b, 0x78, 0xc4, 0xb5, 0xcb, 0x4b, 0x57, 0x19, 0xde, 0x3d,
-	0x47, 0x68, 0xae, 0xaa, 0x03, 0x98, 0x1f, 0x0d, 0xb4, 0xdc, 0x12, 0x94, 0x0b, 0xaa, 0x7a, 0xb8,
-	0xc9, 0x05, 0x23, 0x31, 0x7d, 0x03, 0xc2, 0xfa, 0x6b, 0xcd, 0xc8, 0xcf, 0x97, 0x82, 0xe3, 0xc4,
-	0xc9, 0x7d, 0x4b, 0x9c, 0xf5, 0x88, 0xaa, 0x7a, 0xbb, 0x56, 0x0c, 0x38, 0xf3, 0x02, 0x2e, 0x19,
-	0x97, 0x83, 0x4f, 0x41, 0x86, 0x0d, 0x4f, 0xf5, 0x5a, 0x20, 0x8b, 0x65, 0x08, 0x2e, 0x12, 0x67,
-	0x52, 0xb0, 0x7e, 0xe2, 0xac, 0xf6, 0x08, 0x8b, 0xb7, 0xdc, 0x09, 0x4e, 0xd7, 0x37, 0x2f, 0xad,
-	0x4f, 0x87, 0x46, 0xf3, 0xad, 0x81, 0x96, 0x6a, 0x44, 0x02, 0x3e, 0x00, 0xc0, 0x2d, 0x10, 0x38,
-	0x22, 0xd2, 0x9a, 0xd1, 0x39, 0xbd, 0x9a, 0x3a, 0xa7, 0xb1, 0x48, 0xfd, 0xc4, 0xb9, 0x97, 0x25,
-	0x74, 0xdd, 0xe3, 0xfa, 0x8b, 0xa9, 0x69, 0x17, 0xa0, 0x0a, 0xa2, 0x42, 0xa4, 0x79, 0x64, 0xa0,
-	0x65, 0x46, 0x9b, 0x94, 0xb5, 0xd9, 0x2f, 0xb9, 0xcc, 0xde, 0xb6, 0x3e, 0x13, 0x82, 0x5d, 0xd5,
-	0x67, 0x82, 0xd3, 0xf5, 0x97, 0x06, 0xd6, 0xab, 0xa4, 0xbe, 0x18, 0xe8, 0x61, 0xa7, 0x4e, 0x15,
-	0xc4, 0x54, 0x2a, 0x08, 0x71, 0xd0, 0xc1, 0x01, 0x0f, 0x01, 0xd7, 0x89, 0xac, 0x83, 0xc4, 0x07,
-	0x5c, 0xe0, 0x10, 0x62, 0x88, 0x88, 0x02, 0x1c, 0x90, 0x38, 0xb6, 0xfe, 0x59, 0x9b, 0xc9, 0x2f,
-	0x94, 0xa2, 0x8b, 0xc4, 0x99, 0x6a, 0x5f, 0x3f, 0x71, 0x36, 0xb2, 0xc4, 0xa6, 0xd9, 0xe5, 0xfa,
-	0xeb, 0x23, 0xf0, 0x9d, 0xce, 0x0e, 0x0f, 0x61, 0x4f, 0x63, 0x77, 0xb9, 0x28, 0x0f, 0x90, 0x3b,
-	0x24, 0x8e, 0xcd, 0x6d, 0x64, 0x87, 0x10, 0xd3, 0x43, 0x10, 0x58, 0x75, 0x71, 0x9d, 0xf3, 0x06,
-	0xee, 0x10, 0xc9, 0x52, 0xda, 0x38, 0xa6, 0x8c, 0x2a, 0x6b, 0x7e, 0xcd, 0xc8, 0xcf, 0xfa, 0x2b,
-	0x03, 0xd4, 0xb3, 0xee, 0x1e, 0xe7, 0x8d, 0x17, 0x44, 0xb2, 0x0a, 0x91, 0x4f, 0x52, 0x80, 0xf9,
-	0xdd, 0x40, 0xeb, 0x8c, 0x74, 0x71, 0xd8, 0x6b, 0x12, 0x46, 0x03, 0x3c, 0x6c, 0x68, 0xbb, 0xd5,
-	0x21, 0x22, 0xc4, 0x24, 0x7c, 0xdd, 0x96, 0x8a, 0x41, 0x53, 0x59, 0x48, 0xb7, 0xec, 0x9d, 0x31,
-	0x75, 0xcf, 0x6e, 0x78, 0x40, 0x3f, 0x71, 0x0a, 0x83, 0x36, 0xde, 0x08, 0xef, 0xfa, 0xf7, 0x19,
-	0xe9, 0x96, 0x33, 0x5c, 0x29, 0xbb, 0x75, 0xfb, 0x1a, 0xb4, 0x3d, 0xc4, 0x98, 0xe7, 0x06, 0xca,
-	0x4f, 0x0c, 0x17, 0xf2, 0x4e, 0xf3, 0x3a, 0xc3, 0x7f, 0x35, 0xc3, 0xf7, 0xd3, 0x33, 0xbc, 0xf1,
-	0x11, 0xfd, 0xc4, 0xf1, 0x7e, 0xc3, 0x71, 0xc2, 0x0e, 0xd7, 0x7f, 0x30, 0xc6, 0xb2, 0x3c, 0x80,
-	0x8d, 0xf0, 0xdc, 0x44, 0x2b, 0x8a, 0x88, 0x08, 0x94, 0x6e, 0x7e, 0x5b, 0x42, 0xa8, 0x05, 0x50,
-	0x8b, 0x79, 0xd0, 0xb0, 0x16, 0xf4, 0x2d, 0xf8, 0x3f, 0x03, 0x54, 0x88, 0xdc, 0x97, 0x10, 0x56,
-	0x41, 0x94, 0x52, 0x67, 0xa6, 0x50, 0xd2, 0x1d, 0x53, 0xe8, 0xe2, 0xad, 0x15, 0x3a, 0x1e, 0x6c,
-	0x44, 0xa1, 0xe3, 0xce, 0x54, 0xa1, 0x99, 0x75, 0xa8, 0xd0, 0xad, 0xd9, 0x4f, 0x9f, 0x9d, 0x9c,
-	0x7b, 0xf4, 0x37, 0x5a, 0xcc, 0xe6, 0x6c, 0x55, 0xc0, 0xf3, 0xc7, 0x9b, 0x8f, 0xee, 0xc6, 0xed,
-	0xdd, 0xb8, 0xfd, 0x63, 0xe3, 0x36, 0xbb, 0x94, 0xa5, 0xca, 0xf1, 0xa9, 0x6d, 0x9c, 0x9c, 0xda,
-	0xc6, 0x8f, 0x53, 0xdb, 0xf8, 0x70, 0x66, 0xe7, 0x4e, 0xce, 0xec, 0xdc, 0xd7, 0x33, 0x3b, 0xf7,
-	0xb2, 0x30, 0x52, 0x56, 0x09, 0xb4, 0x70, 0xf9, 0xa4, 0xd0, 0x3f, 0xfa, 0x4d, 0xe1, 0x75, 0xbd,
-	0xf4, 0xf1, 0xa1, 0x2b, 0x5c, 0x9b, 0xd3, 0xfe, 0x8d, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x33, 0xf5, 0x29, 0x90, 0x08, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6b, 0x1b, 0x47,
+	0x14, 0xc7, 0x35, 0xb5, 0x09, 0xf1, 0x26, 0x69, 0xd5, 0x4d, 0x7f, 0xac, 0x4d, 0xd9, 0x51, 0xb7,
+	0xd4, 0xa8, 0x50, 0x6b, 0x49, 0x73, 0x29, 0xb9, 0x59, 0x12, 0x76, 0x4a, 0x42, 0x31, 0xdb, 0x86,
+	0x42, 0xa1, 0x0c, 0xa3, 0xdd, 0xe7, 0xd5, 0xd4, 0x3b, 0x3b, 0x62, 0x66, 0x64, 0xad, 0xfb, 0x07,
+	0x14, 0x7a, 0x28, 0xfd, 0x41, 0x29, 0x39, 0xe6, 0x9f, 0x29, 0xe4, 0x98, 0x63, 0x29, 0x74, 0x29,
+	0x36, 0xbd, 0xe8, 0xa8, 0xbf, 0xa0, 0xec, 0xec, 0xca, 0x76, 0xe2, 0xb5, 0x6a, 0xf9, 0x24, 0xed,
+	0x7c, 0x3f, 0xf3, 0xe6, 0xfb, 0xe6, 0xcd, 0x1b, 0xc6, 0x6a, 0xc2, 0x21, 0xf7, 0x47, 0x54, 0x52,
+	0xae, 0x3a, 0x23, 0x29, 0xb4, 0xb0, 0x1d, 0x05, 0xcc, 0xfc, 0x0b, 0x45, 0xd2, 0x51, 0xc0, 0xc2,
+	0x21, 0x65, 0x69, 0x07, 0x0e, 0xf9, 0xc6, 0x5b, 0xb1, 0x88, 0x85, 0x91, 0xfc, 0xe2, 0x5f, 0xc9,
+	0x6f, 0xb8, 0xa1, 0x50, 0x5c, 0x28, 0x7f, 0x40, 0x15, 0xf8, 0x87, 0xf7, 0x06, 0xa0, 0xe9, 0x3d,
+	0x3f, 0x14, 0x2c, 0x2d, 0x75, 0xef, 0xf7, 0xa6, 0x75, 0x63, 0xcf, 0x2c, 0x60, 0xff, 0x86, 0xac,
+	0xbb, 0x23, 0xc9, 0x84, 0x64, 0xfa, 0x88, 0xa4, 0x42, 0x72, 0x9a, 0xb0, 0xef, 0x40, 0x3a, 0xaf,
+	0xb5, 0x50, 0x7b, 0xad, 0x1b, 0x3e, 0xcf, 0x71, 0xe3, 0xaf, 0x1c, 0x6f, 0xc6, 0x4c, 0x0f, 0xc7,
+	0x83, 0x4e, 0x28, 0xb8, 0x5f, 0xc5, 0x2e, 0x7f, 0xb6, 0x54, 0x74, 0xe0, 0xeb, 0xa3, 0x11, 0xa8,
+	0x4e, 0x1f, 0xc2, 0x69, 0x8e, 0xeb, 0x82, 0xcd, 0x72, 0xbc, 0x71, 0x44, 0x79, 0xf2, 0xc0, 0xab,
+	0x11, 0xbd, 0xc0, 0x9e, 0x8f, 0x7e, 0x7e, 0x3a, 0x68, 0x7f, 0x8f, 0xac, 0x66, 0xe1, 0x9e, 0xec,
+	0x03, 0x90, 0x11, 0x48, 0x12, 0x53, 0xe5, 0xac, 0x18, 0x4f, 0xdf, 0x2c, 0xed, 0xe9, 0x42, 0xa4,
+	0x59, 0x8e, 0xdf, 0x2d, 0x0d, 0xbd, 0xaa, 0x78, 0xc1, 0x9d, 0x62, 0x68, 0x07, 0x60, 0x0f, 0xe4,
+	0x2e, 0x55, 0xf6, 0xaf, 0xc8, 0xba, 0xcb, 0x59, 0xca, 0xf8, 0x98, 0xbf, 0xe4, 0x65, 0xf5, 0xba,
+	0xfb, 0x53, 0x13, 0xec, 0x6c, 0x7f, 0x6a, 0x44, 0x2f, 0x68, 0x56, 0xa3, 0x67, 0xa6, 0xfe, 0x40,
+	0xd6, 0xc7, 0x93, 0x21, 0xd3, 0x90, 0x30, 0xa5, 0x21, 0x22, 0xe1, 0x84, 0x84, 0x22, 0x02, 0x32,
+	0xa4, 0x6a, 0x08, 0x8a, 0xec, 0x0b, 0x49, 0x22, 0x48, 0x20, 0xa6, 0x1a, 0x48, 0x48, 0x93, 0xc4,
+	0xb9, 0xd9, 0x5a, 0x69, 0xdf, 0xee, 0xc6, 0xd3, 0x1c, 0x2f, 0x35, 0x6f, 0x96, 0xe3, 0xfb, 0xa5,
+	0xb1, 0x65, 0x66, 0x79, 0xc1, 0xe6, 0x39, 0xbc, 0x37, 0xe9, 0x89, 0x08, 0x1e, 0x1a, 0x76, 0x47,
+	0xc8, 0x7e, 0x45, 0xf6, 0x68, 0x92, 0xd8, 0xdb, 0x96, 0x1b, 0x41, 0xc2, 0x0e, 0x41, 0x12, 0x9d,
+	0x91, 0xa1, 0x10, 0x07, 0x64, 0x42, 0x15, 0x2f, 0xd2, 0x26, 0x09, 0xe3, 0x4c, 0x3b, 0x6b, 0x2d,
+	0xd4, 0x5e, 0x0d, 0xd6, 0x2b, 0xea, 0xcb, 0xec, 0xa1, 0x10, 0x07, 0x5f, 0x51, 0xc5, 0x77, 0xa9,
+	0x7a, 0x5c, 0x00, 0xf6, 0xdf, 0xc8, 0xda, 0xe4, 0x34, 0x23, 0xd1, 0x51, 0x4a, 0x39, 0x0b, 0xc9,
+	0x69, 0x41, 0xc7, 0xa3, 0x09, 0x95, 0x11, 0xa1, 0xd1, 0xb7, 0x63, 0xa5, 0x39, 0xa4, 0xda, 0xb1,
+	0x4c, 0xc9, 0x7e, 0x40, 0x4b, 0xd7, 0xec, 0x8a, 0x0b, 0xcc, 0x72, 0xbc, 0x55, 0x95, 0xf1, 0x4a,
+	0xbc, 0x17, 0xbc, 0xcf, 0x69, 0xd6, 0x2f, 0xb9, 0x6e, 0x79, 0xea, 0x9e, 0x18, 0x68, 0xfb, 0x94,
+	0xb1, 0xff, 0x45, 0x56, 0xbb, 0x36, 0x5c, 0x24, 0x26, 0xe9, 0xab, 0x19, 0xde, 0x32, 0x19, 0xfe,
+	0xb8, 0x7c, 0x86, 0x57, 0x5e, 0x62, 0x96, 0x63, 0x7f, 0x41, 0x8e, 0x35, 0x33, 0xbc, 0xe0, 0x83,
+	0x0b, 0x59, 0xf6, 0x2b, 0xec, 0x5c, 0x9e, 0x9f, 0x5a, 0xeb, 0x9a, 0xca, 0x18, 0xb4, 0x29, 0xfe,
+	0x58, 0x41, 0x64, 0x1a, 0x60, 0x90, 0x88, 0xf0, 0xc0, 0xb9, 0x6d, 0x4e, 0xc1, 0xdb, 0x25, 0xb0,
+	0x4b, 0xd5, 0x13, 0x05, 0xd1, 0x1e, 0xc8, 0x6e, 0x21, 0x96, 0x1d, 0x4a, 0xb3, 0x0b, 0x1d, 0x7a,
+	0xe7, 0xda, 0x1d, 0x4a, 0xb3, 0x05, 0x1d, 0x4a, 0xb3, 0xba, 0x0e, 0xa5, 0xd9, 0xcb, 0x1d, 0xfa,
+	0xc8, 0xf2, 0x46, 0x82, 0xa5, 0x1a, 0x24, 0x91, 0x10, 0x33, 0xa5, 0x25, 0xd5, 0x4c, 0xa4, 0x24,
+	0x11, 0x31, 0x91, 0xa0, 0x21, 0x2d, 0xbe, 0x9c, 0xd7, 0x4d, 0x5e, 0xb8, 0x22, 0x83, 0x73, 0xe0,
+	0x63, 0x11, 0x07, 0x73, 0xcc, 0x7e, 0x8a, 0x2c, 0xa7, 0x36, 0xda, 0x3e, 0x80, 0xf3, 0x46, 0x0b,
+	0xb5, 0x6f, 0x7d, 0xb2, 0xde, 0x29, 0xb3, 0xe9, 0x14, 0xa5, 0xe8, 0x54, 0x57, 0x7e, 0xa7, 0x27,
+	0x58, 0xda, 0xed, 0x15, 0x3b, 0x30, 0xcd, 0xf1, 0xa5, 0x21, 0x66, 0x39, 0xc6, 0xd5, 0xf5, 0x7c,
+	0x09, 0xe1, 0x05, 0xef, 0xd4, 0x78, 0xdc, 0x01, 0xb0, 0x9f, 0x21, 0xeb, 0xd2, 0x59, 0x44, 0x42,
+	0xc8, 0x46, 0xac, 0x38, 0x98, 0x4d, 0x53, 0x8b, 0x2f, 0xa6, 0x39, 0xbe, 0x02, 0x3d, 0xcb, 0xf1,
+	0x47, 0x8b, 0xfd, 0x9c, 0xb1, 0x5e, 0xed, 0xee, 0xed, 0x00, 0x04, 0x73, 0xc2, 0xfe, 0x05, 0x59,
+	0x6e, 0x6d, 0x20, 0x9a, 0x24, 0x62, 0x52, 0x5c, 0x52, 0xce, 0x9b, 0xad, 0x95, 0xf6, 0x5a, 0xf7,
+	0xd1, 0x34, 0xc7, 0xff, 0x43, 0xce, 0x72, 0xfc, 0xe1, 0x02, 0x6b, 0xa7, 0x9c, 0x17, 0xbc, 0x57,
+	0x63, 0x6b, 0x7b, 0x2e, 0xdb, 0x3f, 0x21, 0xcb, 0xa5, 0x63, 0x2d, 0x48, 0x28, 0x81, 0x6a, 0x20,
+	0x6c, 0x10, 0x92, 0x08, 0x52, 0xc1, 0x49, 0x35, 0x4d, 0x39, 0x76, 0x0b, 0xb5, 0x6f, 0x96, 0x9e,
+	0x16, 0x93, 0x67, 0x9e, 0x16, 0x73, 0x5e, 0xb0, 0x51, 0x00, 0x3d, 0xa3, 0x7f, 0x36, 0x08, 0xfb,
+	0x85, 0xba, 0x57, 0x89, 0x0f, 0x56, 0x9f, 0x3e, 0xc3, 0x8d, 0xee, 0xee, 0xf3, 0x63, 0x17, 0xbd,
+	0x38, 0x76, 0xd1, 0x3f, 0xc7, 0x2e, 0xfa, 0xf9, 0xc4, 0x6d, 0xbc, 0x38, 0x71, 0x1b, 0x7f, 0x9e,
+	0xb8, 0x8d, 0xaf, 0xb7, 0xce, 0xf5, 0x8f, 0x02, 0xb6, 0x35, 0x7f, 0x8e, 0x98, 0x0f, 0xf3, 0x1e,
+	0xf1, 0x33, 0xbf, 0x78, 0xb8, 0x98, 0x56, 0x1a, 0xdc, 0x30, 0xfa, 0xfd, 0xff, 0x06, 0x00, 0xf5,
+	0xba, 0x2f, 0x2d, 0xcc, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -177,6 +241,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCreateIbcDenomPointers {
+		i--
+		if m.AutoCreateIbcDenomPointers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.PointerRegistrationAllowlist) > 0 {
+		for iNdEx := len(m.PointerRegistrationAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PointerRegistrationAllowlist[iNdEx])
+			copy(dAtA[i:], m.PointerRegistrationAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PointerRegistrationAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PointerRegistrationFeeRecipient) > 0 {
+		i -= len(m.PointerRegistrationFeeRecipient)
+		copy(dAtA[i:], m.PointerRegistrationFeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PointerRegistrationFeeRecipient)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	{
+		size, err := m.PointerRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.PointerRegistrationLogRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PointerRegistrationLogRetention))
+		i--
+		dAtA[i] = 0x70
+	}
 	{
 		size := m.MaximumFeePerGas.Size()
 		i -= size
@@ -300,6 +411,24 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaximumFeePerGas.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.PointerRegistrationLogRetention != 0 {
+		n += 1 + sovParams(uint64(m.PointerRegistrationLogRetention))
+	}
+	l = m.PointerRegistrationFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.PointerRegistrationFeeRecipient)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if len(m.PointerRegistrationAllowlist) > 0 {
+		for _, s := range m.PointerRegistrationAllowlist {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AutoCreateIbcDenomPointers {
+		n += 3
+	}
 	return n
 }
 
@@ -612,6 +741,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerRegistrationLogRetention", wireType)
+			}
+			m.PointerRegistrationLogRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerRegistrationLogRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerRegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PointerRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerRegistrationFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointerRegistrationFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerRegistrationAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointerRegistrationAllowlist = append(m.PointerRegistrationAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCreateIbcDenomPointers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCreateIbcDenomPointers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -20,6 +20,11 @@ func TestDefaultParams(t *testing.T) {
 		MaxDynamicBaseFeeUpwardAdjustment:      types.DefaultMaxDynamicBaseFeeUpwardAdjustment,
 		MaxDynamicBaseFeeDownwardAdjustment:    types.DefaultMaxDynamicBaseFeeDownwardAdjustment,
 		TargetGasUsedPerBlock:                  types.DefaultTargetGasUsedPerBlock,
+		PointerRegistrationLogRetention:        types.DefaultPointerRegistrationLogRetention,
+		PointerRegistrationFee:                 types.DefaultPointerRegistrationFee,
+		PointerRegistrationFeeRecipient:        types.DefaultPointerRegistrationFeeRecipient,
+		PointerRegistrationAllowlist:           types.DefaultPointerRegistrationAllowlist,
+		AutoCreateIbcDenomPointers:             types.DefaultAutoCreateIBCDenomPointers,
 	}, types.DefaultParams())
 	require.Nil(t, types.DefaultParams().Validate())
 }
@@ -107,3 +112,26 @@ func TestValidateParamsValidDeliverTxHookWasmGasLimit(t *testing.T) {
 	err := params.Validate()
 	require.NoError(t, err)
 }
+
+func TestValidateParamsInvalidPointerRegistrationFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.PointerRegistrationFee = sdk.Coin{Denom: "usei", Amount: sdk.NewInt(-1)}
+	require.ErrorContains(t, params.Validate(), "invalid pointer registration fee")
+
+	params = types.DefaultParams()
+	params.PointerRegistrationFeeRecipient = "not an address"
+	require.ErrorContains(t, params.Validate(), "invalid pointer registration fee recipient")
+}
+
+func TestValidateParamsInvalidPointerRegistrationAllowlist(t *testing.T) {
+	params := types.DefaultParams()
+	params.PointerRegistrationAllowlist = []string{"not an address"}
+	require.ErrorContains(t, params.Validate(), "invalid pointer registration allowlist entry")
+
+	addr := sdk.AccAddress([]byte("allowlisted_address_")).String()
+	params.PointerRegistrationAllowlist = []string{addr, addr}
+	require.ErrorContains(t, params.Validate(), "duplicate pointer registration allowlist entry")
+
+	params.PointerRegistrationAllowlist = []string{addr}
+	require.Nil(t, params.Validate())
+}
@@ -137,36 +137,338 @@ func (m *DeferredInfo) GetError() string {
 	return ""
 }
 
+// PointerCreationInfo records how a pointer was first registered. Upgrading
+// the pointer to a newer version does not change it.
+type PointerCreationInfo struct {
+	// Sei address of the account that registered the pointer
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Height  int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// hash of the Cosmos transaction that registered the pointer, empty if it
+	// was registered outside of a transaction, e.g. in an upgrade
+	TxHash         string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	InitialVersion uint32 `protobuf:"varint,4,opt,name=initial_version,json=initialVersion,proto3" json:"initial_version,omitempty"`
+	// set for pointers the chain deployed on its own, e.g. for new IBC denoms,
+	// whose metadata may have been derived rather than taken from the pointee;
+	// governance can replace such pointers with corrected metadata
+	AutoCreated bool `protobuf:"varint,5,opt,name=auto_created,json=autoCreated,proto3" json:"auto_created,omitempty"`
+}
+
+func (m *PointerCreationInfo) Reset()         { *m = PointerCreationInfo{} }
+func (m *PointerCreationInfo) String() string { return proto.CompactTextString(m) }
+func (*PointerCreationInfo) ProtoMessage()    {}
+func (*PointerCreationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eba926c274d8fd0, []int{2}
+}
+func (m *PointerCreationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointerCreationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointerCreationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointerCreationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointerCreationInfo.Merge(m, src)
+}
+func (m *PointerCreationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointerCreationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointerCreationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointerCreationInfo proto.InternalMessageInfo
+
+func (m *PointerCreationInfo) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PointerCreationInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PointerCreationInfo) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *PointerCreationInfo) GetInitialVersion() uint32 {
+	if m != nil {
+		return m.InitialVersion
+	}
+	return 0
+}
+
+func (m *PointerCreationInfo) GetAutoCreated() bool {
+	if m != nil {
+		return m.AutoCreated
+	}
+	return false
+}
+
+// ContractCreationInfo records how an EVM contract was deployed.
+type ContractCreationInfo struct {
+	// hex address of the account that initiated the deploying transaction; for
+	// contracts deployed by a factory this is the transaction sender rather than
+	// the factory
+	Deployer string `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// hash of the EVM transaction that deployed the contract, or of the Cosmos
+	// transaction if it was deployed through one; empty if it was deployed
+	// outside of a transaction, e.g. in an upgrade
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ContractCreationInfo) Reset()         { *m = ContractCreationInfo{} }
+func (m *ContractCreationInfo) String() string { return proto.CompactTextString(m) }
+func (*ContractCreationInfo) ProtoMessage()    {}
+func (*ContractCreationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eba926c274d8fd0, []int{3}
+}
+func (m *ContractCreationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCreationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCreationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCreationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCreationInfo.Merge(m, src)
+}
+func (m *ContractCreationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCreationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCreationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCreationInfo proto.InternalMessageInfo
+
+func (m *ContractCreationInfo) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *ContractCreationInfo) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ContractCreationInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// AssociationInfo records when a Sei address was associated with its EVM
+// address. Associations made before it was recorded have none.
+type AssociationInfo struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hash of the Cosmos transaction that made the association, empty if it was
+	// made outside of a transaction
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *AssociationInfo) Reset()         { *m = AssociationInfo{} }
+func (m *AssociationInfo) String() string { return proto.CompactTextString(m) }
+func (*AssociationInfo) ProtoMessage()    {}
+func (*AssociationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eba926c274d8fd0, []int{4}
+}
+func (m *AssociationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssociationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssociationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssociationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssociationInfo.Merge(m, src)
+}
+func (m *AssociationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssociationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssociationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssociationInfo proto.InternalMessageInfo
+
+func (m *AssociationInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AssociationInfo) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// PointerRegistration is an entry of the pointer registration log, written
+// each time a pointer is registered, including at a new version.
+type PointerRegistration struct {
+	PointerType PointerType `protobuf:"varint,1,opt,name=pointer_type,json=pointerType,proto3,enum=seiprotocol.seichain.evm.PointerType" json:"pointer_type,omitempty"`
+	Pointee     string      `protobuf:"bytes,2,opt,name=pointee,proto3" json:"pointee,omitempty"`
+	Pointer     string      `protobuf:"bytes,3,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	Version     uint32      `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Height      int64       `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PointerRegistration) Reset()         { *m = PointerRegistration{} }
+func (m *PointerRegistration) String() string { return proto.CompactTextString(m) }
+func (*PointerRegistration) ProtoMessage()    {}
+func (*PointerRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eba926c274d8fd0, []int{5}
+}
+func (m *PointerRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointerRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointerRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointerRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointerRegistration.Merge(m, src)
+}
+func (m *PointerRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointerRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointerRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointerRegistration proto.InternalMessageInfo
+
+func (m *PointerRegistration) GetPointerType() PointerType {
+	if m != nil {
+		return m.PointerType
+	}
+	return PointerType_ERC20
+}
+
+func (m *PointerRegistration) GetPointee() string {
+	if m != nil {
+		return m.Pointee
+	}
+	return ""
+}
+
+func (m *PointerRegistration) GetPointer() string {
+	if m != nil {
+		return m.Pointer
+	}
+	return ""
+}
+
+func (m *PointerRegistration) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PointerRegistration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Whitelist)(nil), "seiprotocol.seichain.evm.Whitelist")
 	proto.RegisterType((*DeferredInfo)(nil), "seiprotocol.seichain.evm.DeferredInfo")
+	proto.RegisterType((*PointerCreationInfo)(nil), "seiprotocol.seichain.evm.PointerCreationInfo")
+	proto.RegisterType((*ContractCreationInfo)(nil), "seiprotocol.seichain.evm.ContractCreationInfo")
+	proto.RegisterType((*AssociationInfo)(nil), "seiprotocol.seichain.evm.AssociationInfo")
+	proto.RegisterType((*PointerRegistration)(nil), "seiprotocol.seichain.evm.PointerRegistration")
 }
 
 func init() { proto.RegisterFile("evm/types.proto", fileDescriptor_6eba926c274d8fd0) }
 
 var fileDescriptor_6eba926c274d8fd0 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x6b, 0xf2, 0x40,
-	0x18, 0xc7, 0x73, 0xaf, 0xaf, 0xa6, 0x1e, 0x4a, 0x69, 0x10, 0x7a, 0xed, 0x10, 0x43, 0x86, 0x92,
-	0x0e, 0x26, 0x43, 0xa1, 0x43, 0xc7, 0x50, 0xa8, 0xae, 0x59, 0x0a, 0x5d, 0x24, 0xc6, 0x47, 0x73,
-	0x34, 0xc9, 0xc9, 0x3d, 0xa7, 0x9c, 0xdf, 0xa2, 0x5f, 0xa8, 0xbb, 0xa3, 0x63, 0xe9, 0x20, 0x45,
-	0xbf, 0x41, 0x3f, 0x41, 0x49, 0x8c, 0xa5, 0xd3, 0x3d, 0xbf, 0xfb, 0x3f, 0xff, 0x3b, 0xf8, 0xd1,
-	0x73, 0x58, 0xe5, 0x81, 0x5a, 0x2f, 0x00, 0xfd, 0x85, 0x14, 0x4a, 0x58, 0x0c, 0x81, 0x57, 0x53,
-	0x22, 0x32, 0x1f, 0x81, 0x27, 0x69, 0xcc, 0x0b, 0x1f, 0x56, 0xf9, 0x75, 0x6f, 0x2e, 0xe6, 0xa2,
-	0x8a, 0x82, 0x72, 0x3a, 0xee, 0xbb, 0xf7, 0xb4, 0xfd, 0x9c, 0x72, 0x05, 0x19, 0x47, 0x65, 0xdd,
-	0xd2, 0x56, 0x1a, 0x63, 0x0a, 0xc8, 0x88, 0xd3, 0xf0, 0xda, 0xe1, 0xc5, 0xf7, 0xae, 0xdf, 0x5d,
-	0xc7, 0x79, 0xf6, 0xe0, 0x1e, 0xef, 0xdd, 0xa8, 0x5e, 0x70, 0xdf, 0x09, 0xed, 0x3c, 0xc2, 0x0c,
-	0xa4, 0x84, 0xe9, 0xa8, 0x98, 0x09, 0xeb, 0x8a, 0x9e, 0x29, 0x3d, 0xe6, 0xc5, 0x14, 0x34, 0x23,
-	0x0e, 0xf1, 0xba, 0x91, 0xa9, 0xf4, 0xa8, 0x44, 0xeb, 0x92, 0x9a, 0x4a, 0x8f, 0xcb, 0x22, 0xfb,
-	0xe7, 0x10, 0xaf, 0x13, 0xb5, 0x94, 0x1e, 0xc6, 0x98, 0xd6, 0x9d, 0x49, 0x26, 0x44, 0xce, 0x1a,
-	0x55, 0x62, 0x2a, 0x1d, 0x96, 0x68, 0x0d, 0xa9, 0x89, 0x4b, 0xb9, 0xc8, 0x96, 0xc8, 0xfe, 0x3b,
-	0xc4, 0x6b, 0x87, 0xfe, 0x66, 0xd7, 0x37, 0x3e, 0x77, 0xfd, 0x9b, 0x39, 0x57, 0xe9, 0x72, 0xe2,
-	0x27, 0x22, 0x0f, 0x12, 0x81, 0xb9, 0xc0, 0xfa, 0x18, 0xe0, 0xf4, 0xb5, 0x56, 0x31, 0x2a, 0x54,
-	0x74, 0xaa, 0x5b, 0x3d, 0xda, 0x04, 0x29, 0x85, 0x64, 0xcd, 0xf2, 0x9d, 0xe8, 0x08, 0xe1, 0xd3,
-	0x66, 0x6f, 0x93, 0xed, 0xde, 0x26, 0x5f, 0x7b, 0x9b, 0xbc, 0x1d, 0x6c, 0x63, 0x7b, 0xb0, 0x8d,
-	0x8f, 0x83, 0x6d, 0xbc, 0x0c, 0xfe, 0x7c, 0x80, 0xc0, 0x07, 0x27, 0x9b, 0x15, 0x54, 0x3a, 0x03,
-	0x1d, 0xfc, 0x6a, 0x9f, 0xb4, 0xaa, 0xfc, 0xee, 0x27, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xbe, 0x17,
-	0x45, 0x8a, 0x01, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0xae, 0xd2, 0x4e,
+	0x14, 0xc7, 0x99, 0x1f, 0x3f, 0xfe, 0xcd, 0x85, 0x4b, 0xac, 0x44, 0x2b, 0x8b, 0x82, 0x4d, 0x54,
+	0x5c, 0x50, 0x12, 0x4d, 0x5c, 0xb8, 0x13, 0x4c, 0x84, 0x9d, 0x99, 0x18, 0x4d, 0xdc, 0x90, 0x52,
+	0xce, 0xa5, 0x13, 0xdb, 0x4e, 0x33, 0x33, 0x90, 0xf2, 0x16, 0x3e, 0x88, 0xaf, 0xe0, 0xd2, 0xe4,
+	0x2e, 0xef, 0xd2, 0xb8, 0x20, 0x06, 0xde, 0xc0, 0x27, 0x30, 0x9d, 0x4e, 0x6f, 0x8b, 0xc9, 0x5d,
+	0x75, 0x3e, 0xe7, 0xcc, 0xf9, 0xce, 0xf9, 0xce, 0x99, 0xe2, 0x2e, 0xec, 0xc2, 0x89, 0xdc, 0xc7,
+	0x20, 0x9c, 0x98, 0x33, 0xc9, 0x0c, 0x53, 0x00, 0x55, 0x2b, 0x8f, 0x05, 0x8e, 0x00, 0xea, 0xf9,
+	0x2e, 0x8d, 0x1c, 0xd8, 0x85, 0xfd, 0xde, 0x86, 0x6d, 0x98, 0x4a, 0x4d, 0xd2, 0x55, 0xb6, 0xbf,
+	0xaf, 0x04, 0x20, 0xda, 0x86, 0x5a, 0xc0, 0x7e, 0x85, 0x5b, 0x9f, 0x7c, 0x2a, 0x21, 0xa0, 0x42,
+	0x1a, 0xcf, 0x71, 0xdd, 0x77, 0x85, 0x0f, 0xc2, 0x44, 0xc3, 0xea, 0xa8, 0x35, 0xbd, 0xf7, 0xe7,
+	0x30, 0xe8, 0xec, 0xdd, 0x30, 0x78, 0x6d, 0x67, 0x71, 0x9b, 0xe8, 0x0d, 0xf6, 0x77, 0x84, 0xdb,
+	0x6f, 0xe1, 0x0a, 0x38, 0x87, 0xf5, 0x22, 0xba, 0x62, 0xc6, 0x23, 0xdc, 0x94, 0xc9, 0x92, 0x46,
+	0x6b, 0x48, 0x4c, 0x34, 0x44, 0xa3, 0x0e, 0x69, 0xc8, 0x64, 0x91, 0xa2, 0xf1, 0x10, 0x37, 0x64,
+	0xb2, 0x4c, 0x0b, 0xcd, 0xff, 0x86, 0x68, 0xd4, 0x26, 0x75, 0x99, 0xcc, 0x5d, 0xe1, 0xeb, 0x9a,
+	0x55, 0xc0, 0x58, 0x68, 0x56, 0x55, 0xa6, 0x21, 0x93, 0x69, 0x8a, 0xc6, 0x1c, 0x37, 0xc4, 0x96,
+	0xc7, 0xc1, 0x56, 0x98, 0xff, 0x0f, 0xd1, 0xa8, 0x35, 0x75, 0xae, 0x0f, 0x83, 0xca, 0xaf, 0xc3,
+	0xe0, 0xe9, 0x86, 0x4a, 0x7f, 0xbb, 0x72, 0x3c, 0x16, 0x4e, 0x3c, 0x26, 0x42, 0x26, 0xf4, 0x67,
+	0x2c, 0xd6, 0x5f, 0xf4, 0xdd, 0x2c, 0x22, 0x49, 0xf2, 0x72, 0xa3, 0x87, 0x6b, 0xc0, 0x39, 0xe3,
+	0x66, 0x2d, 0xd5, 0x21, 0x19, 0xd8, 0xdf, 0x10, 0xbe, 0xff, 0x9e, 0xd1, 0x48, 0x02, 0x9f, 0x71,
+	0x70, 0x25, 0x65, 0x91, 0xb2, 0x61, 0xe2, 0x86, 0x97, 0x32, 0xe3, 0xca, 0x45, 0x8b, 0xe4, 0x68,
+	0x3c, 0xc0, 0x75, 0x1f, 0xe8, 0xc6, 0x97, 0xca, 0x44, 0x95, 0x68, 0x2a, 0xbb, 0xab, 0xaa, 0x8a,
+	0xdc, 0xdd, 0x33, 0xdc, 0xa5, 0x11, 0x95, 0xd4, 0x0d, 0x96, 0x3b, 0xe0, 0x82, 0xb2, 0x48, 0x59,
+	0xe9, 0x90, 0x4b, 0x1d, 0xfe, 0x98, 0x45, 0x8d, 0xc7, 0xb8, 0xed, 0x6e, 0x25, 0x5b, 0xaa, 0x93,
+	0x60, 0xad, 0x1a, 0x6d, 0x92, 0x8b, 0x34, 0x36, 0xcb, 0x42, 0xb6, 0x87, 0x7b, 0x33, 0x16, 0x49,
+	0xee, 0x7a, 0xf2, 0xac, 0xdd, 0x3e, 0x6e, 0xae, 0x21, 0x0e, 0xd8, 0x1e, 0xf2, 0x7e, 0x6f, 0xf9,
+	0xdf, 0x6b, 0x2f, 0x1a, 0x2b, 0x9c, 0x54, 0xcb, 0x4e, 0xec, 0x29, 0xee, 0xbe, 0x11, 0x82, 0x79,
+	0xb4, 0xd0, 0x2f, 0xb6, 0xa2, 0xbb, 0x4c, 0x9f, 0x69, 0xdb, 0x3f, 0x8a, 0x7b, 0x25, 0xb0, 0xa1,
+	0x42, 0x72, 0x25, 0x66, 0xcc, 0x71, 0x3b, 0xce, 0xc2, 0xcb, 0x74, 0x46, 0x4a, 0xee, 0xf2, 0xc5,
+	0x13, 0xe7, 0xae, 0xf7, 0xeb, 0x68, 0x91, 0x0f, 0xfb, 0x18, 0xc8, 0x45, 0x5c, 0x40, 0x3a, 0xa1,
+	0x0c, 0x41, 0x1f, 0x9d, 0x63, 0x91, 0xe1, 0x7a, 0x12, 0x39, 0xa6, 0x99, 0xf3, 0x11, 0xe4, 0x58,
+	0x32, 0x58, 0x2b, 0x1b, 0x9c, 0xbe, 0xbb, 0x3e, 0x5a, 0xe8, 0xe6, 0x68, 0xa1, 0xdf, 0x47, 0x0b,
+	0x7d, 0x3d, 0x59, 0x95, 0x9b, 0x93, 0x55, 0xf9, 0x79, 0xb2, 0x2a, 0x9f, 0xc7, 0xa5, 0x07, 0x28,
+	0x80, 0x8e, 0xf3, 0xf6, 0x15, 0xa8, 0xfe, 0x27, 0xc9, 0xe4, 0xf6, 0x3f, 0x5d, 0xd5, 0x55, 0xfe,
+	0xe5, 0xdf, 0x01, 0x00, 0x43, 0x45, 0x48, 0x7d, 0xbb, 0x03, 0x00, 0x00,
 }
 
 func (m *Whitelist) Marshal() (dAtA []byte, err error) {
@@ -260,65 +562,796 @@ func (m *DeferredInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PointerCreationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Whitelist) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PointerCreationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointerCreationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCreated {
+		i--
+		if m.AutoCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitialVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InitialVersion))
+		i--
+		dAtA[i] = 0x20
 	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCreationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCreationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCreationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hashes) > 0 {
-		for _, s := range m.Hashes {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssociationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssociationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssociationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PointerRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointerRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointerRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pointer) > 0 {
+		i -= len(m.Pointer)
+		copy(dAtA[i:], m.Pointer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Pointer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pointee) > 0 {
+		i -= len(m.Pointee)
+		copy(dAtA[i:], m.Pointee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Pointee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PointerType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PointerType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Whitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, s := range m.Hashes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeferredInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TxBloom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Surplus.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PointerCreationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.InitialVersion != 0 {
+		n += 1 + sovTypes(uint64(m.InitialVersion))
+	}
+	if m.AutoCreated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractCreationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AssociationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PointerRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PointerType != 0 {
+		n += 1 + sovTypes(uint64(m.PointerType))
+	}
+	l = len(m.Pointee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Pointer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Whitelist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Whitelist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Whitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBloom = append(m.TxBloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBloom == nil {
+				m.TxBloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Surplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointerCreationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointerCreationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointerCreationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialVersion", wireType)
+			}
+			m.InitialVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCreated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *DeferredInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.TxBloom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Surplus.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Whitelist) Unmarshal(dAtA []byte) error {
+func (m *ContractCreationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -341,15 +1374,15 @@ func (m *Whitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Whitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCreationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Whitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCreationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -377,8 +1410,59 @@ func (m *Whitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hashes = append(m.Hashes, string(dAtA[iNdEx:postIndex]))
+			m.Deployer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -400,7 +1484,7 @@ func (m *Whitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
+func (m *AssociationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,17 +1507,17 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeferredInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssociationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeferredInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssociationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.TxIndex = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -443,7 +1527,7 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -452,7 +1536,7 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -462,31 +1546,79 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxHash == nil {
-				m.TxHash = []byte{}
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBloom", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointerRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointerRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointerRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -496,29 +1628,14 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PointerType |= PointerType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxBloom = append(m.TxBloom[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBloom == nil {
-				m.TxBloom = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -546,13 +1663,11 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Surplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -580,8 +1695,46 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Pointer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -27,6 +27,8 @@ const (
 	FlagAllowListDescription = "Path to the allow list JSON file with an array of addresses " +
 		"that are allowed to send/receive the token. The file should have the following format: {\"addresses\": " +
 		"[\"addr1\", \"addr2\"]}, where addr1 and addr2 are bech32 Sei native addresses or EVM addresses."
+	FlagCreateEVMPointer            = "create-evm-pointer"
+	FlagCreateEVMPointerDescription = "Also deploy the ERC20 pointer of the denom from its metadata, unless it already has one"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -79,6 +81,10 @@ func NewCreateDenomCmd() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				args[0],
 			)
+			msg.CreateEvmPointer, err = cmd.Flags().GetBool(FlagCreateEVMPointer)
+			if err != nil {
+				return err
+			}
 
 			// only parse allow list if it is provided
 			if allowListFilePath != "" {
@@ -96,6 +102,7 @@ func NewCreateDenomCmd() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagAllowList, "", FlagAllowListDescription)
+	cmd.Flags().Bool(FlagCreateEVMPointer, false, FlagCreateEVMPointerDescription)
 	return cmd
 }
 
@@ -288,12 +295,17 @@ Where metadata.json contains:
 				clientCtx.GetFromAddress().String(),
 				metadata,
 			)
+			msg.CreateEvmPointer, err = cmd.Flags().GetBool(FlagCreateEVMPointer)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagCreateEVMPointer, false, FlagCreateEVMPointerDescription)
 	return cmd
 }
 
@@ -294,3 +294,40 @@ func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetDenomMetaDataCreateEVMPointer() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	msg := types.NewMsgSetDenomMetadata(suite.TestAccs[0].String(), banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    suite.defaultDenom,
+				Exponent: 0,
+			},
+			{
+				Denom:    "bitcoin",
+				Exponent: 8,
+			},
+		},
+		Base:    suite.defaultDenom,
+		Display: "bitcoin",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	})
+	msg.CreateEvmPointer = true
+	res, err := suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	pointer, _, exists := suite.App.EvmKeeper.GetERC20NativePointer(suite.Ctx, suite.defaultDenom)
+	suite.Require().True(exists)
+	suite.Require().Equal(pointer.Hex(), res.EvmPointer)
+	symbol, err := suite.App.EvmKeeper.QueryERCSingleOutput(suite.Ctx, "native", pointer, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("BTC", symbol)
+
+	// a second update leaves the existing pointer in place
+	res, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pointer.Hex(), res.EvmPointer)
+}
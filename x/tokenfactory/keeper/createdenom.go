@@ -49,6 +49,24 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 	return nil
 }
 
+// createDenomPointer deploys the ERC20 pointer of denom, recording the denom's
+// creator, rather than its current admin, as the pointer creator. A denom that
+// already has a pointer is skipped.
+func (k Keeper) createDenomPointer(ctx sdk.Context, denom string) (pointer string, created bool, err error) {
+	if k.evmKeeper == nil {
+		return "", false, types.ErrEVMPointersUnsupported
+	}
+	creatorAddr, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return "", false, err
+	}
+	creator, err := sdk.AccAddressFromBech32(creatorAddr)
+	if err != nil {
+		return "", false, err
+	}
+	return k.evmKeeper.CreateDenomPointer(ctx, denom, creator)
+}
+
 func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
 	// Temporary check until IBC bug is sorted out
 	if k.bankKeeper.HasSupply(ctx, subdenom) {
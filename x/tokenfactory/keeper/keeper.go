@@ -24,6 +24,7 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+		evmKeeper     types.EVMKeeper
 	}
 )
 
@@ -50,6 +51,16 @@ func NewKeeper(
 	}
 }
 
+// SetEVMKeeper sets the keeper used to deploy ERC20 pointers for denoms. It
+// must be set before the keeper is copied into the module.
+func (k *Keeper) SetEVMKeeper(evmKeeper types.EVMKeeper) *Keeper {
+	if k.evmKeeper != nil {
+		panic("cannot set EVM keeper twice")
+	}
+	k.evmKeeper = evmKeeper
+	return k
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -45,12 +45,24 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		)
 	}
 
+	var pointer string
+	if msg.CreateEvmPointer {
+		pointer, _, err = server.Keeper.createDenomPointer(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		createDenomEvent = createDenomEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeEVMPointer, pointer),
+		)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		createDenomEvent,
 	})
 
 	return &types.MsgCreateDenomResponse{
 		NewTokenDenom: denom,
+		EvmPointer:    pointer,
 	}, nil
 }
 
@@ -205,13 +217,26 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
+	setMetadataEvent := sdk.NewEvent(
+		types.TypeMsgSetDenomMetadata,
+		sdk.NewAttribute(types.AttributeDenom, msg.Metadata.Base),
+		sdk.NewAttribute(types.AttributeDenomMetadata, msg.Metadata.String()),
+	)
+
+	var pointer string
+	if msg.CreateEvmPointer {
+		pointer, _, err = server.Keeper.createDenomPointer(ctx, msg.Metadata.Base)
+		if err != nil {
+			return nil, err
+		}
+		setMetadataEvent = setMetadataEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeEVMPointer, pointer),
+		)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.TypeMsgSetDenomMetadata,
-			sdk.NewAttribute(types.AttributeDenom, msg.Metadata.Base),
-			sdk.NewAttribute(types.AttributeDenomMetadata, msg.Metadata.String()),
-		),
+		setMetadataEvent,
 	})
 
-	return &types.MsgSetDenomMetadataResponse{}, nil
+	return &types.MsgSetDenomMetadataResponse{EvmPointer: pointer}, nil
 }
@@ -31,4 +31,5 @@ var (
 	ErrUnknownSeiTokenFactoryQuery    = sdkerrors.Register(ModuleName, 23, "Error unknown sei token factory query")
 	ErrAllowListTooLarge              = sdkerrors.Register(ModuleName, 24, "allowlist too large")
 	ErrAllowListUndefined             = sdkerrors.Register(ModuleName, 25, "allowlist undefined")
+	ErrEVMPointersUnsupported         = sdkerrors.Register(ModuleName, 26, "EVM pointers are not supported")
 )
@@ -18,4 +18,5 @@ const (
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
 	AttributeAllowList           = "denom_allow_list"
+	AttributeEVMPointer          = "evm_pointer"
 )
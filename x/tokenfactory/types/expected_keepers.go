@@ -36,6 +36,14 @@ type AccountKeeper interface {
 	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
 }
 
+// EVMKeeper deploys ERC20 pointers for denoms on request of their creator.
+type EVMKeeper interface {
+	// CreateDenomPointer deploys the ERC20 pointer of denom with creator
+	// recorded as its creator, and returns the existing pointer with created
+	// unset if denom already has one.
+	CreateDenomPointer(ctx sdk.Context, denom string, creator sdk.AccAddress) (pointer string, created bool, err error)
+}
+
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper.
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
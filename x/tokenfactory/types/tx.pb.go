@@ -44,6 +44,8 @@ type MsgCreateDenom struct {
 	// subdenom can be up to 44 "alphanumeric" characters long.
 	Subdenom  string           `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty" yaml:"subdenom"`
 	AllowList *types.AllowList `protobuf:"bytes,3,opt,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty" yaml:"allow_list"`
+	// deploys an ERC20 pointer for the new denom in the same transaction
+	CreateEvmPointer bool `protobuf:"varint,4,opt,name=create_evm_pointer,json=createEvmPointer,proto3" json:"create_evm_pointer,omitempty" yaml:"create_evm_pointer"`
 }
 
 func (m *MsgCreateDenom) Reset()         { *m = MsgCreateDenom{} }
@@ -100,10 +102,19 @@ func (m *MsgCreateDenom) GetAllowList() *types.AllowList {
 	return nil
 }
 
+func (m *MsgCreateDenom) GetCreateEvmPointer() bool {
+	if m != nil {
+		return m.CreateEvmPointer
+	}
+	return false
+}
+
 // MsgCreateDenomResponse is the return value of MsgCreateDenom
 // It returns the full string of the newly created denom
 type MsgCreateDenomResponse struct {
 	NewTokenDenom string `protobuf:"bytes,1,opt,name=new_token_denom,json=newTokenDenom,proto3" json:"new_token_denom,omitempty" yaml:"new_token_denom"`
+	// address of the ERC20 pointer, if one was requested
+	EvmPointer string `protobuf:"bytes,2,opt,name=evm_pointer,json=evmPointer,proto3" json:"evm_pointer,omitempty" yaml:"evm_pointer"`
 }
 
 func (m *MsgCreateDenomResponse) Reset()         { *m = MsgCreateDenomResponse{} }
@@ -146,6 +157,13 @@ func (m *MsgCreateDenomResponse) GetNewTokenDenom() string {
 	return ""
 }
 
+func (m *MsgCreateDenomResponse) GetEvmPointer() string {
+	if m != nil {
+		return m.EvmPointer
+	}
+	return ""
+}
+
 // MsgMint is the sdk.Msg type for allowing an admin account to mint
 // more of a token.  For now, we only support minting to the sender account
 type MsgMint struct {
@@ -431,6 +449,9 @@ var xxx_messageInfo_MsgChangeAdminResponse proto.InternalMessageInfo
 type MsgSetDenomMetadata struct {
 	Sender   string         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Metadata types.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
+	// deploys an ERC20 pointer using the new metadata in the same transaction,
+	// unless the denom already has one
+	CreateEvmPointer bool `protobuf:"varint,3,opt,name=create_evm_pointer,json=createEvmPointer,proto3" json:"create_evm_pointer,omitempty" yaml:"create_evm_pointer"`
 }
 
 func (m *MsgSetDenomMetadata) Reset()         { *m = MsgSetDenomMetadata{} }
@@ -480,9 +501,18 @@ func (m *MsgSetDenomMetadata) GetMetadata() types.Metadata {
 	return types.Metadata{}
 }
 
+func (m *MsgSetDenomMetadata) GetCreateEvmPointer() bool {
+	if m != nil {
+		return m.CreateEvmPointer
+	}
+	return false
+}
+
 // MsgSetDenomMetadataResponse defines the response structure for an executed
 // MsgSetDenomMetadata message.
 type MsgSetDenomMetadataResponse struct {
+	// address of the denom's ERC20 pointer, if one was requested
+	EvmPointer string `protobuf:"bytes,1,opt,name=evm_pointer,json=evmPointer,proto3" json:"evm_pointer,omitempty" yaml:"evm_pointer"`
 }
 
 func (m *MsgSetDenomMetadataResponse) Reset()         { *m = MsgSetDenomMetadataResponse{} }
@@ -518,6 +548,13 @@ func (m *MsgSetDenomMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetDenomMetadataResponse proto.InternalMessageInfo
 
+func (m *MsgSetDenomMetadataResponse) GetEvmPointer() string {
+	if m != nil {
+		return m.EvmPointer
+	}
+	return ""
+}
+
 // MsgUpdateDenom is the sdk.Msg allowing an admin to update the denom
 type MsgUpdateDenom struct {
 	Sender    string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -634,50 +671,54 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/tx.proto", fileDescriptor_51ab120c97d57038) }
 
 var fileDescriptor_51ab120c97d57038 = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6b, 0xd4, 0x4e,
-	0x18, 0xdf, 0xfc, 0xfb, 0x6f, 0xed, 0xce, 0x5a, 0xdb, 0xa6, 0x2f, 0x6e, 0x57, 0x9a, 0xd4, 0x39,
-	0x48, 0x15, 0x4c, 0xd8, 0x15, 0x0a, 0x7a, 0x10, 0x9a, 0x7a, 0xf0, 0xe0, 0x82, 0x44, 0x05, 0x11,
-	0xa1, 0xcc, 0x66, 0xa7, 0x69, 0xe8, 0x66, 0x66, 0xd9, 0x99, 0x75, 0x5b, 0x0a, 0x7e, 0x00, 0x4f,
-	0x1e, 0xc4, 0x6f, 0xe3, 0x55, 0x7a, 0xec, 0x51, 0x2f, 0x41, 0xda, 0x6f, 0x90, 0x4f, 0x20, 0x99,
-	0x99, 0xbc, 0xb4, 0x5d, 0x30, 0x5b, 0x10, 0x6f, 0x49, 0x9e, 0xdf, 0xef, 0x79, 0x7e, 0xcf, 0x2b,
-	0x01, 0x2b, 0x9c, 0x1e, 0x60, 0xb2, 0x87, 0x3c, 0x4e, 0x07, 0x47, 0x36, 0x3f, 0xb4, 0xfa, 0x03,
-	0xca, 0xa9, 0x7e, 0x97, 0xe1, 0x40, 0x3c, 0x79, 0xb4, 0x67, 0x31, 0x1c, 0x78, 0xfb, 0x28, 0x20,
-	0x56, 0x11, 0xdb, 0x58, 0xf6, 0xa9, 0x4f, 0x05, 0xc6, 0x4e, 0x9e, 0x24, 0xb1, 0x61, 0x78, 0x94,
-	0x85, 0x94, 0xd9, 0x1d, 0xc4, 0xb0, 0xfd, 0xa1, 0xd9, 0xc1, 0x1c, 0x35, 0x6d, 0x8f, 0x06, 0xe4,
-	0x8a, 0x9d, 0x1c, 0x64, 0xf6, 0xe4, 0x45, 0xda, 0xe1, 0x77, 0x0d, 0xdc, 0x6a, 0x33, 0x7f, 0x67,
-	0x80, 0x11, 0xc7, 0xcf, 0x30, 0xa1, 0xa1, 0x7e, 0x1f, 0xcc, 0x30, 0x4c, 0xba, 0x78, 0x50, 0xd7,
-	0x36, 0xb4, 0xcd, 0xaa, 0xb3, 0x18, 0x47, 0xe6, 0xdc, 0x11, 0x0a, 0x7b, 0x4f, 0xa0, 0xfc, 0x0e,
-	0x5d, 0x05, 0xd0, 0x6d, 0x30, 0xcb, 0x86, 0x9d, 0x6e, 0x42, 0xab, 0xff, 0x27, 0xc0, 0x4b, 0x71,
-	0x64, 0xce, 0x2b, 0xb0, 0xb2, 0x40, 0x37, 0x03, 0xe9, 0x6f, 0x01, 0x40, 0xbd, 0x1e, 0x1d, 0xed,
-	0xf6, 0x02, 0xc6, 0xeb, 0x53, 0x1b, 0xda, 0x66, 0xad, 0x65, 0x58, 0x52, 0xa3, 0x25, 0x64, 0x29,
-	0x8d, 0xd6, 0x76, 0x02, 0x7b, 0x11, 0x30, 0xee, 0xac, 0x9d, 0x44, 0xa6, 0x16, 0x47, 0xe6, 0xa2,
-	0x74, 0x9b, 0xf3, 0xa1, 0x5b, 0x45, 0x29, 0x0a, 0xbe, 0x07, 0xab, 0x17, 0xf3, 0x70, 0x31, 0xeb,
-	0x53, 0xc2, 0xb0, 0xee, 0x80, 0x79, 0x82, 0x47, 0xbb, 0xa2, 0x98, 0xbb, 0x52, 0xab, 0x4c, 0xac,
-	0x11, 0x47, 0xe6, 0xaa, 0x74, 0x7a, 0x09, 0x00, 0xdd, 0x39, 0x82, 0x47, 0xaf, 0x93, 0x0f, 0xc2,
-	0x17, 0xfc, 0x08, 0x6e, 0xb4, 0x99, 0xdf, 0x0e, 0x08, 0x9f, 0xa4, 0x3c, 0xcf, 0xc1, 0x0c, 0x0a,
-	0xe9, 0x90, 0x70, 0x51, 0x9c, 0x5a, 0x6b, 0x2d, 0xcf, 0x94, 0xe1, 0x2c, 0xd3, 0x1d, 0x1a, 0x10,
-	0x67, 0xe5, 0x24, 0x32, 0x2b, 0xb9, 0x27, 0x49, 0x83, 0xae, 0xe2, 0xc3, 0x45, 0x30, 0xaf, 0xe2,
-	0xa7, 0x69, 0x29, 0x49, 0xce, 0x70, 0x40, 0xfe, 0xa5, 0xa4, 0x24, 0x7e, 0x26, 0xe9, 0xab, 0x1a,
-	0xa6, 0x7d, 0x44, 0x7c, 0xbc, 0xdd, 0x0d, 0x83, 0x89, 0xa4, 0xdd, 0x03, 0xd3, 0xc5, 0x49, 0x5a,
-	0x88, 0x23, 0xf3, 0xa6, 0x44, 0xaa, 0x9e, 0x48, 0xb3, 0xde, 0x04, 0xd5, 0xa4, 0x5d, 0x28, 0xf1,
-	0x2f, 0x46, 0xa8, 0xea, 0x2c, 0xc7, 0x91, 0xb9, 0x90, 0x77, 0x52, 0x98, 0xa0, 0x3b, 0x4b, 0xf0,
-	0x48, 0xa8, 0x80, 0x75, 0x39, 0x1c, 0xb9, 0xae, 0x4c, 0xf2, 0x17, 0x0d, 0x2c, 0xb5, 0x99, 0xff,
-	0x0a, 0x73, 0xd1, 0xe8, 0x36, 0xe6, 0xa8, 0x8b, 0x38, 0x9a, 0x44, 0xb7, 0x0b, 0x66, 0x43, 0x45,
-	0x53, 0x45, 0x5d, 0x1f, 0x3b, 0xd1, 0xa9, 0x6f, 0xe7, 0xb6, 0x2a, 0xac, 0xda, 0x93, 0x94, 0x0c,
-	0xdd, 0xcc, 0x0f, 0x5c, 0x07, 0x77, 0xc6, 0xa8, 0xca, 0x54, 0x7f, 0x93, 0x85, 0x7e, 0xd3, 0xef,
-	0x5e, 0x67, 0x6b, 0xcb, 0x16, 0xfa, 0xef, 0x2d, 0xab, 0xec, 0x47, 0x41, 0x7e, 0x9a, 0x59, 0xeb,
-	0xe7, 0x34, 0x98, 0x6a, 0x33, 0x5f, 0x3f, 0x06, 0xb5, 0xe2, 0x4d, 0x6a, 0x5a, 0x7f, 0x3c, 0x90,
-	0xd6, 0xc5, 0xf5, 0x6f, 0x3c, 0x9e, 0x98, 0x92, 0x5d, 0x8c, 0x63, 0x50, 0x2b, 0x96, 0xb6, 0x64,
-	0xf0, 0x02, 0xa5, 0x6c, 0xf0, 0x31, 0x15, 0xd0, 0xf7, 0xc0, 0xff, 0xe2, 0xce, 0x3c, 0x28, 0xe7,
-	0x22, 0xc1, 0x36, 0x5a, 0xe5, 0xb1, 0xc5, 0x38, 0xe2, 0x78, 0x94, 0x8c, 0x93, 0x60, 0xcb, 0xc6,
-	0x29, 0x1e, 0x05, 0xd1, 0xc9, 0xc2, 0x41, 0x28, 0xdb, 0xc9, 0x9c, 0x52, 0xba, 0x93, 0x57, 0xd7,
-	0x5b, 0xff, 0xa4, 0x81, 0x85, 0x2b, 0xbb, 0xbd, 0x55, 0xce, 0xdf, 0x65, 0x5e, 0xe3, 0xe9, 0xf5,
-	0x78, 0xa9, 0x18, 0xe7, 0xe5, 0xc9, 0x99, 0xa1, 0x9d, 0x9e, 0x19, 0xda, 0xaf, 0x33, 0x43, 0xfb,
-	0x7c, 0x6e, 0x54, 0x4e, 0xcf, 0x8d, 0xca, 0x8f, 0x73, 0xa3, 0xf2, 0x6e, 0xcb, 0x0f, 0xf8, 0xfe,
-	0xb0, 0x63, 0x79, 0x34, 0xb4, 0x19, 0x0e, 0x1e, 0xa6, 0x41, 0xc4, 0x8b, 0x88, 0x62, 0x1f, 0xda,
-	0x17, 0xff, 0x1c, 0x8e, 0xfa, 0x98, 0x75, 0x66, 0x04, 0xf0, 0xd1, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x43, 0x9e, 0x99, 0x56, 0x08, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6a, 0xdb, 0x4a,
+	0x18, 0xb5, 0xf2, 0x77, 0xed, 0xf1, 0xcd, 0x8d, 0xa3, 0x24, 0xbe, 0x8e, 0x2e, 0x91, 0x72, 0xb5,
+	0x28, 0x69, 0xa1, 0x12, 0x76, 0x21, 0x25, 0x5d, 0x14, 0xa2, 0xb4, 0x50, 0x68, 0x0d, 0x41, 0xfd,
+	0xa1, 0x74, 0x63, 0xc6, 0xf6, 0x44, 0x11, 0xb1, 0x66, 0x8c, 0x67, 0x1c, 0x27, 0x04, 0xfa, 0x00,
+	0x5d, 0x15, 0x4a, 0xfb, 0x36, 0xdd, 0x67, 0x99, 0x65, 0xbb, 0x11, 0x6d, 0xf2, 0x06, 0x7a, 0x82,
+	0xa2, 0x99, 0xb1, 0x2c, 0x27, 0x29, 0x95, 0x03, 0xa5, 0x3b, 0x69, 0xe6, 0x9c, 0xef, 0x3b, 0x3a,
+	0xdf, 0x8f, 0x0d, 0x56, 0x18, 0x39, 0x40, 0x78, 0x0f, 0xb6, 0x18, 0xe9, 0x1d, 0xdb, 0xec, 0xc8,
+	0xea, 0xf6, 0x08, 0x23, 0xea, 0xff, 0x14, 0xf9, 0xfc, 0xa9, 0x45, 0x3a, 0x16, 0x45, 0x7e, 0x6b,
+	0x1f, 0xfa, 0xd8, 0x4a, 0x63, 0xb5, 0x65, 0x8f, 0x78, 0x84, 0x63, 0xec, 0xf8, 0x49, 0x10, 0x35,
+	0xbd, 0x45, 0x68, 0x40, 0xa8, 0xdd, 0x84, 0x14, 0xd9, 0x87, 0xd5, 0x26, 0x62, 0xb0, 0x6a, 0xb7,
+	0x88, 0x8f, 0xaf, 0xdc, 0xe3, 0x83, 0xe4, 0x3e, 0x7e, 0x11, 0xf7, 0xe6, 0x87, 0x29, 0xf0, 0x4f,
+	0x9d, 0x7a, 0x3b, 0x3d, 0x04, 0x19, 0x7a, 0x84, 0x30, 0x09, 0xd4, 0xdb, 0x60, 0x8e, 0x22, 0xdc,
+	0x46, 0xbd, 0x8a, 0xb2, 0xae, 0x6c, 0x14, 0x9c, 0xc5, 0x28, 0x34, 0xe6, 0x8f, 0x61, 0xd0, 0x79,
+	0x60, 0x8a, 0x73, 0xd3, 0x95, 0x00, 0xd5, 0x06, 0x79, 0xda, 0x6f, 0xb6, 0x63, 0x5a, 0x65, 0x8a,
+	0x83, 0x97, 0xa2, 0xd0, 0x58, 0x90, 0x60, 0x79, 0x63, 0xba, 0x09, 0x48, 0x7d, 0x0d, 0x00, 0xec,
+	0x74, 0xc8, 0xa0, 0xd1, 0xf1, 0x29, 0xab, 0x4c, 0xaf, 0x2b, 0x1b, 0xc5, 0x9a, 0x6e, 0x09, 0x8d,
+	0x16, 0x97, 0x25, 0x35, 0x5a, 0xdb, 0x31, 0xec, 0x99, 0x4f, 0x99, 0xb3, 0x7a, 0x1a, 0x1a, 0x4a,
+	0x14, 0x1a, 0x8b, 0x22, 0xec, 0x88, 0x6f, 0xba, 0x05, 0x38, 0x44, 0xa9, 0x4f, 0x81, 0xda, 0xe2,
+	0x1f, 0xd1, 0x40, 0x87, 0x41, 0xa3, 0x4b, 0x7c, 0xcc, 0x50, 0xaf, 0x32, 0xb3, 0xae, 0x6c, 0xe4,
+	0x9d, 0xb5, 0x28, 0x34, 0x56, 0x05, 0xfb, 0x2a, 0xc6, 0x74, 0x4b, 0xe2, 0xf0, 0xf1, 0x61, 0xb0,
+	0x2b, 0x8f, 0x3e, 0x2a, 0xa0, 0x3c, 0xee, 0x8a, 0x8b, 0x68, 0x97, 0x60, 0x8a, 0x54, 0x07, 0x2c,
+	0x60, 0x34, 0x68, 0xf0, 0xd2, 0x34, 0xc4, 0x97, 0x0b, 0x9b, 0xb4, 0x28, 0x34, 0xca, 0x22, 0xc9,
+	0x25, 0x80, 0xe9, 0xce, 0x63, 0x34, 0x78, 0x11, 0x1f, 0x08, 0x87, 0xef, 0x83, 0x62, 0x5a, 0xa4,
+	0x70, 0xae, 0x1c, 0x85, 0x86, 0x2a, 0xf8, 0x63, 0xea, 0x00, 0x1a, 0xe9, 0x7a, 0x0b, 0xfe, 0xaa,
+	0x53, 0xaf, 0xee, 0x63, 0x36, 0x49, 0x95, 0x9e, 0x80, 0x39, 0x18, 0x90, 0x3e, 0x66, 0x3c, 0x53,
+	0xb1, 0xb6, 0x3a, 0x32, 0x9c, 0xa2, 0xc4, 0xf0, 0x1d, 0xe2, 0x63, 0x67, 0xe5, 0x34, 0x34, 0x72,
+	0xa3, 0x48, 0x82, 0x66, 0xba, 0x92, 0x6f, 0x2e, 0x82, 0x05, 0x99, 0x7f, 0xe8, 0x87, 0x94, 0xe4,
+	0xf4, 0x7b, 0xf8, 0x4f, 0x4a, 0x8a, 0xf3, 0x27, 0x92, 0x3e, 0x29, 0xa2, 0xa7, 0xf7, 0x21, 0xf6,
+	0xd0, 0x76, 0x3b, 0xf0, 0x27, 0x92, 0x76, 0x0b, 0xcc, 0xa6, 0x1b, 0xba, 0x14, 0x85, 0xc6, 0xdf,
+	0x02, 0x29, 0x8b, 0x29, 0xae, 0xd5, 0x2a, 0x28, 0xc4, 0x75, 0x86, 0x71, 0x7c, 0xde, 0xc9, 0x05,
+	0x67, 0x39, 0x0a, 0x8d, 0xd2, 0xa8, 0x05, 0xf8, 0x95, 0xe9, 0xe6, 0x31, 0x1a, 0x70, 0x15, 0x66,
+	0x05, 0x94, 0xc7, 0x75, 0x25, 0x92, 0xbf, 0x2b, 0x60, 0xa9, 0x4e, 0xbd, 0xe7, 0x88, 0xf1, 0x0e,
+	0xa9, 0x23, 0x06, 0xdb, 0x90, 0xc1, 0x49, 0x74, 0xbb, 0x20, 0x1f, 0x48, 0x9a, 0x34, 0x75, 0xed,
+	0xda, 0xc1, 0x1a, 0xc6, 0x76, 0xfe, 0x95, 0xc6, 0xca, 0x71, 0x1d, 0x92, 0x4d, 0x37, 0x89, 0xf3,
+	0x93, 0xa1, 0x9a, 0xbe, 0xd9, 0x50, 0xbd, 0x02, 0xff, 0x5d, 0xf3, 0x89, 0xc9, 0x60, 0x5d, 0x1a,
+	0x0a, 0x25, 0xf3, 0x50, 0x7c, 0x16, 0xe5, 0x7e, 0xd9, 0x6d, 0xdf, 0x64, 0x85, 0x65, 0x2d, 0xf7,
+	0x6f, 0xdb, 0x5c, 0xb2, 0x2b, 0x52, 0xf2, 0x87, 0x96, 0xd4, 0xbe, 0xce, 0x82, 0xe9, 0x3a, 0xf5,
+	0xd4, 0x13, 0x50, 0x4c, 0x2f, 0xe8, 0xaa, 0xf5, 0xcb, 0x5f, 0x0b, 0x6b, 0x7c, 0x7b, 0x69, 0x5b,
+	0x13, 0x53, 0x92, 0xba, 0x9c, 0x80, 0x62, 0xda, 0xda, 0x8c, 0xc9, 0x53, 0x14, 0x6d, 0x6b, 0x62,
+	0x4a, 0x92, 0x7c, 0x0f, 0xcc, 0xf0, 0x6d, 0x77, 0x27, 0x5b, 0x88, 0x18, 0xab, 0xd5, 0xb2, 0x63,
+	0xd3, 0x79, 0xf8, 0x0a, 0xcb, 0x98, 0x27, 0xc6, 0x6a, 0xb5, 0xec, 0xd8, 0xb4, 0x99, 0xe9, 0xb5,
+	0x94, 0xb5, 0x92, 0x23, 0x8a, 0xb6, 0x35, 0x31, 0x25, 0x49, 0xfe, 0x4e, 0x01, 0xa5, 0x2b, 0x1b,
+	0x66, 0x33, 0x5b, 0xbc, 0xcb, 0x3c, 0xed, 0xe1, 0xcd, 0x78, 0x43, 0x31, 0xce, 0xee, 0xe9, 0xb9,
+	0xae, 0x9c, 0x9d, 0xeb, 0xca, 0xb7, 0x73, 0x5d, 0x79, 0x7f, 0xa1, 0xe7, 0xce, 0x2e, 0xf4, 0xdc,
+	0x97, 0x0b, 0x3d, 0xf7, 0x66, 0xd3, 0xf3, 0xd9, 0x7e, 0xbf, 0x69, 0xb5, 0x48, 0x60, 0x53, 0xe4,
+	0xdf, 0x1d, 0x26, 0xe1, 0x2f, 0x3c, 0x8b, 0x7d, 0x64, 0x8f, 0xff, 0x8d, 0x3a, 0xee, 0x22, 0xda,
+	0x9c, 0xe3, 0xc0, 0x7b, 0x3f, 0x06, 0x00, 0x8c, 0x66, 0xea, 0xa1, 0x63, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -960,6 +1001,16 @@ func (m *MsgCreateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreateEvmPointer {
+		i--
+		if m.CreateEvmPointer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AllowList != nil {
 		{
 			size, err := m.AllowList.MarshalToSizedBuffer(dAtA[:i])
@@ -1009,6 +1060,13 @@ func (m *MsgCreateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmPointer) > 0 {
+		i -= len(m.EvmPointer)
+		copy(dAtA[i:], m.EvmPointer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmPointer)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.NewTokenDenom) > 0 {
 		i -= len(m.NewTokenDenom)
 		copy(dAtA[i:], m.NewTokenDenom)
@@ -1232,6 +1290,16 @@ func (m *MsgSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreateEvmPointer {
+		i--
+		if m.CreateEvmPointer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1272,6 +1340,13 @@ func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmPointer) > 0 {
+		i -= len(m.EvmPointer)
+		copy(dAtA[i:], m.EvmPointer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmPointer)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1376,6 +1451,9 @@ func (m *MsgCreateDenom) Size() (n int) {
 		l = m.AllowList.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CreateEvmPointer {
+		n += 2
+	}
 	return n
 }
 
@@ -1389,6 +1467,10 @@ func (m *MsgCreateDenomResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.EvmPointer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1482,6 +1564,9 @@ func (m *MsgSetDenomMetadata) Size() (n int) {
 	}
 	l = m.Metadata.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.CreateEvmPointer {
+		n += 2
+	}
 	return n
 }
 
@@ -1491,6 +1576,10 @@ func (m *MsgSetDenomMetadataResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.EvmPointer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1659,6 +1748,26 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateEvmPointer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateEvmPointer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1741,6 +1850,38 @@ func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmPointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmPointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2382,6 +2523,26 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateEvmPointer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateEvmPointer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2432,6 +2593,38 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmPointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmPointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])